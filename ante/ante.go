@@ -8,6 +8,13 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
+const (
+	// EthereumTxExtensionOption is the extension option of the EVM transactions
+	EthereumTxExtensionOption = "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx"
+	// DynamicFeeTxExtensionOption is the extension option of the cosmos-sdk transactions with dynamic fees
+	DynamicFeeTxExtensionOption = "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx"
+)
+
 // NewAnteHandler returns an ante handler responsible for attempting to route an
 // Ethereum or SDK transaction to an internal ante handler for performing
 // transaction-level processing (e.g. fee payment, signature verification) before
@@ -25,10 +32,10 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			if len(opts) > 0 {
 				// Route based on the extensions
 				switch typeURL := opts[0].GetTypeUrl(); typeURL {
-				case "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx":
+				case EthereumTxExtensionOption:
 					// handle as *evmtypes.MsgEthereumTx
 					anteHandler = newMonoEVMAnteHandler(options)
-				case "/cosmos.evm.types.v1.ExtensionOptionDynamicFeeTx":
+				case DynamicFeeTxExtensionOption:
 					// cosmos-sdk tx with dynamic fee extension
 					anteHandler = NewCosmosAnteHandler(options)
 				default:
@@ -53,3 +60,13 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		return anteHandler(ctx, tx, sim)
 	}
 }
+
+// IsEthereumTx checks if a transaction is routed to the EVM ante handler
+func IsEthereumTx(tx sdk.Tx) bool {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	opts := txWithExtensions.GetExtensionOptions()
+	return len(opts) > 0 && opts[0].GetTypeUrl() == EthereumTxExtensionOption
+}
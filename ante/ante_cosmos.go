@@ -33,15 +33,24 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
-		NewGovVoteDecorator(options.Cdc, options.StakingKeeper),
-		NewGovExpeditedProposalsDecorator(options.Cdc),
-		evmcosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+		NewAuthzPolicyDecorator(options.Cdc, options.AnteParamsKeeper), // on top of the limiter, blocked msg types on authz exec and grants are kept on the anteparams state
+		NewGovVoteDecorator(options.Cdc, options.StakingKeeper, options.AccountKeeper, options.AnteParamsKeeper),
+		NewGovExpeditedProposalsDecorator(options.Cdc, options.AnteParamsKeeper),
+		NewMinCommissionDecorator(options.Cdc, options.AnteParamsKeeper),
+		NewFeeAbstractionMinGasPriceDecorator( // fees in whitelisted denoms are checked on their native equivalent
+			evmcosmosante.NewMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper),
+			options.FeeAbsKeeper,
+		),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		// SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
-		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		NewSmartAccountDecorator( // smart accounts are verified by their authenticator contract instead of a key
+			options.SmartAccountKeeper,
+			options.SignModeHandler,
+			// SetPubKeyDecorator must be called before all signature verification decorators
+			ante.NewSetPubKeyDecorator(options.AccountKeeper),
+			ante.NewValidateSigCountDecorator(options.AccountKeeper),
+			ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+			ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 		evmante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
@@ -51,13 +60,21 @@ func NewCosmosAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	if UseFeeMarketDecorator {
 		// This wraps using the gasless decorator
 		gasLessFeeDecorator := NewFeelessDecorator(
-			ante.NewDeductFeeDecorator(
-				options.AccountKeeper,
-				options.BankKeeper,
-				options.FeegrantKeeper,
+			NewSponsoredFeeDecorator( // fee granters sponsoring the called contract pay through the paymaster
+				NewFeeAbstractionDeductFeeDecorator(
+					options.AccountKeeper,
+					options.BankKeeper,
+					options.FeegrantKeeper,
+					options.TxFeeChecker,
+					options.FeeAbsKeeper,
+				),
+				options.PaymasterKeeper,
 				options.TxFeeChecker,
+				options.EvmKeeper,
 			),
+			options.FeelessKeeper,
 			options.OracleKeeper,
+			options.StakingKeeper,
 		)
 
 		// Add to the ante decorators
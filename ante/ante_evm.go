@@ -9,6 +9,8 @@ import (
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
+		NewSmartAccountEVMTxDecorator(options.SmartAccountKeeper),              // smart accounts are verified by their authenticator contract only
+		NewSponsoredEVMTxDecorator(options.PaymasterKeeper, options.EvmKeeper), // sponsored fees are moved to the sender before the fee deduction
 		evmante.NewEVMMonoDecorator(
 			options.AccountKeeper,
 			options.FeeMarketKeeper,
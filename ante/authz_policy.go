@@ -0,0 +1,88 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// AuthzPolicyDecorator enforces the authz policy kept on the anteparams module state.
+// Message types can be blocked inside MsgExec or on MsgGrant, and the MsgExec nesting is limited
+type AuthzPolicyDecorator struct {
+	cdc              codec.BinaryCodec
+	anteParamsKeeper *anteparamskeeper.Keeper
+}
+
+func NewAuthzPolicyDecorator(cdc codec.BinaryCodec, anteParamsKeeper *anteparamskeeper.Keeper) AuthzPolicyDecorator {
+	return AuthzPolicyDecorator{
+		cdc:              cdc,
+		anteParamsKeeper: anteParamsKeeper,
+	}
+}
+
+// AnteHandle rejects transactions with messages that break the authz policy
+func (a AuthzPolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params, err := a.anteParamsKeeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := a.validateMsg(ctx, msg, 0, params.MaxAuthzExecDepth); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsg checks a message against the authz policy, depth is the number of MsgExec wrapping the message
+func (a AuthzPolicyDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg, depth, maxDepth uint32) error {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		if depth+1 > maxDepth {
+			return errorsmod.Wrapf(xerrors.ErrAuthzPolicy, "authz exec nested %d times, max allowed is %d", depth+1, maxDepth)
+		}
+
+		for _, v := range msg.Msgs {
+			var innerMsg sdk.Msg
+			if err := a.cdc.UnpackAny(v, &innerMsg); err != nil {
+				return errorsmod.Wrap(xerrors.ErrAuthzPolicy, "cannot unmarshal authz exec msgs")
+			}
+
+			msgTypeURL := sdk.MsgTypeURL(innerMsg)
+			blocked, err := a.anteParamsKeeper.IsAuthzBlocked(ctx, msgTypeURL)
+			if err != nil {
+				return err
+			}
+			if blocked {
+				return errorsmod.Wrapf(xerrors.ErrAuthzPolicy, "%s cannot be executed through authz", msgTypeURL)
+			}
+
+			if err := a.validateMsg(ctx, innerMsg, depth+1, maxDepth); err != nil {
+				return err
+			}
+		}
+	case *authz.MsgGrant:
+		authorization, err := msg.GetAuthorization()
+		if err != nil {
+			return errorsmod.Wrapf(xerrors.ErrAuthzPolicy, "invalid authorization: %s", err)
+		}
+
+		msgTypeURL := authorization.MsgTypeURL()
+		blocked, err := a.anteParamsKeeper.IsGrantBlocked(ctx, msgTypeURL)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return errorsmod.Wrapf(xerrors.ErrAuthzPolicy, "%s cannot be granted through authz", msgTypeURL)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/ante"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// TestAuthzPolicyDecorator tests the authz policy kept on the anteparams state
+func TestAuthzPolicyDecorator(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewAuthzPolicyDecorator(kiiApp.AppCodec(), &kiiApp.AnteParamsKeeper)
+	msgServer := anteparamskeeper.NewMsgServerImpl(kiiApp.AnteParamsKeeper)
+	authority := kiiApp.AnteParamsKeeper.GetAuthority()
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	send := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(100))))
+	sendTypeURL := sdk.MsgTypeURL(send)
+
+	newExec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		exec := authz.NewMsgExec(grantee, msgs)
+		return &exec
+	}
+	newGrant := func(msgTypeURL string) *authz.MsgGrant {
+		grant, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(msgTypeURL), nil)
+		require.NoError(t, err)
+		return grant
+	}
+
+	anteHandle := func(msgs ...sdk.Msg) error {
+		txBuilder := kiiApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		errMsg string
+	}{
+		{
+			name: "plain message",
+			msgs: []sdk.Msg{send},
+		},
+		{
+			name: "exec with allowed message",
+			msgs: []sdk.Msg{newExec(send)},
+		},
+		{
+			name: "nested exec up to the max depth",
+			msgs: []sdk.Msg{newExec(newExec(send))},
+		},
+		{
+			name:   "nested exec above the max depth",
+			msgs:   []sdk.Msg{newExec(newExec(newExec(send)))},
+			errMsg: "authz exec nested 3 times, max allowed is 2",
+		},
+		{
+			name:   "exec with ethereum tx",
+			msgs:   []sdk.Msg{newExec(&evmtypes.MsgEthereumTx{})},
+			errMsg: "/cosmos.evm.vm.v1.MsgEthereumTx cannot be executed through authz",
+		},
+		{
+			name:   "nested exec with vesting account creation",
+			msgs:   []sdk.Msg{newExec(newExec(&sdkvesting.MsgCreateVestingAccount{}))},
+			errMsg: "/cosmos.vesting.v1beta1.MsgCreateVestingAccount cannot be executed through authz",
+		},
+		{
+			name: "grant of an allowed message",
+			msgs: []sdk.Msg{newGrant(sendTypeURL)},
+		},
+		{
+			name:   "grant of a force transfer",
+			msgs:   []sdk.Msg{newGrant(sdk.MsgTypeURL(&tokenfactorytypes.MsgForceTransfer{}))},
+			errMsg: "/kiichain.tokenfactory.v1beta1.MsgForceTransfer cannot be granted through authz",
+		},
+		{
+			name:   "grant of a message blocked inside exec",
+			msgs:   []sdk.Msg{newGrant(sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}))},
+			errMsg: "/cosmos.evm.vm.v1.MsgEthereumTx cannot be granted through authz",
+		},
+		{
+			name:   "grant wrapped in exec",
+			msgs:   []sdk.Msg{newExec(newGrant(sdk.MsgTypeURL(&tokenfactorytypes.MsgForceTransfer{})))},
+			errMsg: "/kiichain.tokenfactory.v1beta1.MsgForceTransfer cannot be granted through authz",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := anteHandle(tc.msgs...)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, xerrors.ErrAuthzPolicy)
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+
+	// Governance can block a message type inside exec, which also blocks its grants
+	_, err := msgServer.AddAuthzBlockedMsgType(ctx, anteparamstypes.NewMsgAddAuthzBlockedMsgType(authority, sendTypeURL))
+	require.NoError(t, err)
+	require.ErrorContains(t, anteHandle(newExec(send)), "cannot be executed through authz")
+	require.ErrorContains(t, anteHandle(newGrant(sendTypeURL)), "cannot be granted through authz")
+	require.NoError(t, anteHandle(send))
+
+	// Governance can only block grants, existing grants can still be executed
+	_, err = msgServer.RemoveAuthzBlockedMsgType(ctx, anteparamstypes.NewMsgRemoveAuthzBlockedMsgType(authority, sendTypeURL))
+	require.NoError(t, err)
+	_, err = msgServer.AddGrantBlockedMsgType(ctx, anteparamstypes.NewMsgAddGrantBlockedMsgType(authority, sendTypeURL))
+	require.NoError(t, err)
+	require.ErrorContains(t, anteHandle(newGrant(sendTypeURL)), "cannot be granted through authz")
+	require.NoError(t, anteHandle(newExec(send)))
+
+	// The max depth is a governance param
+	params, err := kiiApp.AnteParamsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxAuthzExecDepth = 1
+	_, err = msgServer.UpdateParams(ctx, anteparamstypes.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.NoError(t, anteHandle(newExec(send)))
+	require.ErrorContains(t, anteHandle(newExec(newExec(send))), "authz exec nested 2 times, max allowed is 1")
+}
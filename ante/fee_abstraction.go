@@ -0,0 +1,223 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	feeabskeeper "github.com/kiichain/kiichain/v3/x/feeabs/keeper"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+)
+
+// nativeFeeTx wraps a fee tx paid in an alternative denom
+// It reports the native equivalent of the fee so the native fee checks can be reused
+type nativeFeeTx struct {
+	sdk.FeeTx
+	// nativeFee is the fee converted to the native denom
+	nativeFee sdk.Coins
+	// rate is the conversion rate used for the native fee
+	rate feeabstypes.ConversionRate
+}
+
+// Type assertion for the nativeFeeTx
+var _ ante.HasExtensionOptionsTx = nativeFeeTx{}
+
+// GetFee returns the native equivalent of the fee
+func (tx nativeFeeTx) GetFee() sdk.Coins {
+	return tx.nativeFee
+}
+
+// GetExtensionOptions returns the extension options of the wrapped tx
+func (tx nativeFeeTx) GetExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(ante.HasExtensionOptionsTx); ok {
+		return extTx.GetExtensionOptions()
+	}
+	return nil
+}
+
+// GetNonCriticalExtensionOptions returns the non critical extension options of the wrapped tx
+func (tx nativeFeeTx) GetNonCriticalExtensionOptions() []*codectypes.Any {
+	if extTx, ok := tx.FeeTx.(ante.HasExtensionOptionsTx); ok {
+		return extTx.GetNonCriticalExtensionOptions()
+	}
+	return nil
+}
+
+// nativeFeeTxKey is the context key of the fee conversion of the tx
+type nativeFeeTxKey struct{}
+
+// cachedNativeFeeTx is the fee conversion of the tx, stored in the context so the oracle
+// prices are looked up once per tx
+type cachedNativeFeeTx struct {
+	tx nativeFeeTx
+	ok bool
+}
+
+// toNativeFeeTx converts a tx paying fees in a whitelisted alternative denom into a nativeFeeTx
+// It returns false if the tx fee should be handled by the native fee path. The conversion is
+// cached in the returned context
+func toNativeFeeTx(ctx sdk.Context, feeabsKeeper *feeabskeeper.Keeper, tx sdk.Tx) (sdk.Context, nativeFeeTx, bool, error) {
+	if cached, found := ctx.Value(nativeFeeTxKey{}).(cachedNativeFeeTx); found {
+		return ctx, cached.tx, cached.ok, nil
+	}
+
+	nativeTx, ok, err := convertFeeTx(ctx, feeabsKeeper, tx)
+	if err != nil {
+		return ctx, nativeFeeTx{}, false, err
+	}
+
+	ctx = ctx.WithValue(nativeFeeTxKey{}, cachedNativeFeeTx{tx: nativeTx, ok: ok})
+	return ctx, nativeTx, ok, nil
+}
+
+// convertFeeTx converts the fee of the tx using the oracle prices
+func convertFeeTx(ctx sdk.Context, feeabsKeeper *feeabskeeper.Keeper, tx sdk.Tx) (nativeFeeTx, bool, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nativeFeeTx{}, false, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// Only a single fee coin in a whitelisted denom is converted
+	fee := feeTx.GetFee()
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if len(fee) != 1 || fee[0].Denom == evmDenom {
+		return nativeFeeTx{}, false, nil
+	}
+	isFeeDenom, err := feeabsKeeper.IsFeeDenom(ctx, fee[0].Denom)
+	if err != nil || !isFeeDenom {
+		return nativeFeeTx{}, false, err
+	}
+
+	// Convert the fee using the oracle prices, stale prices reject the tx
+	rate, err := feeabsKeeper.GetConversionRate(ctx, fee[0].Denom)
+	if err != nil {
+		return nativeFeeTx{}, false, err
+	}
+
+	return nativeFeeTx{
+		FeeTx:     feeTx,
+		nativeFee: sdk.NewCoins(sdk.NewCoin(evmDenom, rate.ToNative(fee[0].Amount))),
+		rate:      rate,
+	}, true, nil
+}
+
+// FeeAbstractionMinGasPriceDecorator runs the min gas price checks against the native
+// equivalent of fees paid in a whitelisted alternative denom
+type FeeAbstractionMinGasPriceDecorator struct {
+	// minGasPriceDecorator is the decorator enforcing the native min gas price
+	minGasPriceDecorator sdk.AnteDecorator
+	// feeabsKeeper holds the fee denoms whitelist and the price conversion
+	feeabsKeeper *feeabskeeper.Keeper
+}
+
+// Type assertion for the FeeAbstractionMinGasPriceDecorator
+var _ sdk.AnteDecorator = FeeAbstractionMinGasPriceDecorator{}
+
+// NewFeeAbstractionMinGasPriceDecorator creates a new FeeAbstractionMinGasPriceDecorator
+func NewFeeAbstractionMinGasPriceDecorator(
+	minGasPriceDecorator sdk.AnteDecorator,
+	feeabsKeeper *feeabskeeper.Keeper,
+) FeeAbstractionMinGasPriceDecorator {
+	return FeeAbstractionMinGasPriceDecorator{
+		minGasPriceDecorator: minGasPriceDecorator,
+		feeabsKeeper:         feeabsKeeper,
+	}
+}
+
+// AnteHandle checks the min gas price using the native equivalent of the fee
+func (d FeeAbstractionMinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx, nativeTx, ok, err := toNativeFeeTx(ctx, d.feeabsKeeper, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		return d.minGasPriceDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// The wrapped tx is only seen by the min gas price check, the next decorators get the original tx
+	return d.minGasPriceDecorator.AnteHandle(ctx, nativeTx, simulate, func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+		return next(ctx, tx, simulate)
+	})
+}
+
+// FeeAbstractionDeductFeeDecorator deducts fees paid in a whitelisted alternative denom
+// The fee checker runs on the native equivalent and the effective fee is converted back
+type FeeAbstractionDeductFeeDecorator struct {
+	// deductFeeDecorator deducts native fees
+	deductFeeDecorator sdk.AnteDecorator
+	// altDeductFeeDecorator deducts alternative denom fees
+	altDeductFeeDecorator sdk.AnteDecorator
+	// txFeeChecker is the native fee checker
+	txFeeChecker ante.TxFeeChecker
+	// feeabsKeeper holds the fee denoms whitelist and the price conversion
+	feeabsKeeper *feeabskeeper.Keeper
+}
+
+// Type assertion for the FeeAbstractionDeductFeeDecorator
+var _ sdk.AnteDecorator = FeeAbstractionDeductFeeDecorator{}
+
+// NewFeeAbstractionDeductFeeDecorator creates a new FeeAbstractionDeductFeeDecorator
+func NewFeeAbstractionDeductFeeDecorator(
+	accountKeeper ante.AccountKeeper,
+	bankKeeper authtypes.BankKeeper,
+	feegrantKeeper ante.FeegrantKeeper,
+	txFeeChecker ante.TxFeeChecker,
+	feeabsKeeper *feeabskeeper.Keeper,
+) FeeAbstractionDeductFeeDecorator {
+	d := FeeAbstractionDeductFeeDecorator{
+		deductFeeDecorator: ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, txFeeChecker),
+		txFeeChecker:       txFeeChecker,
+		feeabsKeeper:       feeabsKeeper,
+	}
+	// The alternative path reuses the SDK deduction, feegrants and events with its own fee checker
+	d.altDeductFeeDecorator = ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, d.checkAlternativeFee)
+	return d
+}
+
+// AnteHandle deducts the fee either in the native denom or in the alternative denom
+func (d FeeAbstractionDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx, _, ok, err := toNativeFeeTx(ctx, d.feeabsKeeper, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		return d.deductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	return d.altDeductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// checkAlternativeFee runs the native fee checker on the converted fee
+// It returns the effective fee in the alternative denom, never above the provided fee
+func (d FeeAbstractionDeductFeeDecorator) checkAlternativeFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	_, nativeTx, ok, err := toNativeFeeTx(ctx, d.feeabsKeeper, tx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !ok {
+		return nil, 0, errorsmod.Wrap(errortypes.ErrInvalidRequest, "tx fee is not in an alternative fee denom")
+	}
+
+	fee := nativeTx.FeeTx.GetFee()[0]
+	if d.txFeeChecker == nil {
+		return sdk.NewCoins(fee), 0, nil
+	}
+
+	effectiveFee, priority, err := d.txFeeChecker(ctx, nativeTx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Dynamic fees can charge less than the provided fee
+	altFee := nativeTx.rate.FromNative(effectiveFee.AmountOf(evmtypes.GetEVMCoinDenom()))
+	if altFee.Amount.LT(fee.Amount) {
+		fee = altFee
+	}
+
+	return sdk.NewCoins(fee), priority, nil
+}
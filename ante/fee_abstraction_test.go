@@ -0,0 +1,199 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tenderminttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/ante"
+	kiichain "github.com/kiichain/kiichain/v3/app"
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	kiiparams "github.com/kiichain/kiichain/v3/app/params"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	oracleutils "github.com/kiichain/kiichain/v3/x/oracle/utils"
+)
+
+// TestFeeAbstractionDeductFeeDecorator tests fees paid in a whitelisted alternative denom
+func TestFeeAbstractionDeductFeeDecorator(t *testing.T) {
+	// Start the app
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.BaseApp.NewUncachedContext(false, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+	usdcDenom := "ibc/usdc"
+
+	// Whitelist usdc, kii is worth 0.5 and usdc 1
+	params := feeabstypes.DefaultParams()
+	params.FeeDenoms = []feeabstypes.FeeDenom{feeabstypes.NewFeeDenom(usdcDenom, oracleutils.MicroUsdcDenom, 6)}
+	require.NoError(t, kiiApp.FeeAbsKeeper.Params.Set(ctx, params))
+	setOraclePrices(t, kiiApp, ctx, map[string]math.LegacyDec{
+		oracleutils.MicroKiiDenom:  math.LegacyNewDecWithPrec(5, 1),
+		oracleutils.MicroUsdcDenom: math.LegacyOneDec(),
+	})
+
+	// Fund the fee payer
+	feePayer := apptesting.RandomAccountAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 10_000_000))
+	require.NoError(t, kiiApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+	require.NoError(t, kiiApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feePayer, funds))
+	feeCollector := kiiApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// The fee checker charges half of the native fee
+	nativeFee := sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewIntWithDecimal(1, 18))
+	halfFeeChecker := func(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		fee := tx.(sdk.FeeTx).GetFee()
+		require.Equal(t, sdk.NewCoins(nativeFee), fee)
+		return sdk.NewCoins(sdk.NewCoin(nativeFee.Denom, nativeFee.Amount.QuoRaw(2))), 10, nil
+	}
+
+	testCases := []struct {
+		name         string
+		txFeeChecker func(sdk.Context, sdk.Tx) (sdk.Coins, int64, error)
+		blockTime    time.Duration
+		expectedFee  sdk.Coin
+		errorIs      error
+	}{
+		{
+			name:        "full fee without fee checker",
+			expectedFee: sdk.NewInt64Coin(usdcDenom, 500_000),
+		},
+		{
+			name:         "effective fee from the fee checker",
+			txFeeChecker: halfFeeChecker,
+			expectedFee:  sdk.NewInt64Coin(usdcDenom, 250_000),
+		},
+		{
+			name:      "stale prices are refused",
+			blockTime: time.Duration(params.MaxPriceAgeSeconds+1) * time.Second,
+			errorIs:   feeabstypes.ErrStalePrice,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cachedCtx, _ := ctx.CacheContext()
+			cachedCtx = cachedCtx.WithBlockTime(ctx.BlockTime().Add(tc.blockTime))
+
+			anteHandler := sdk.ChainAnteDecorators(ante.NewFeeAbstractionDeductFeeDecorator(
+				kiiApp.AccountKeeper,
+				kiiApp.BankKeeper,
+				nil,
+				tc.txFeeChecker,
+				&kiiApp.FeeAbsKeeper,
+			))
+
+			// Pay 0.5 usdc, worth 1 kii
+			tx, err := buildTxWithFee(feePayer, sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 500_000)))
+			require.NoError(t, err)
+
+			collectorBefore := kiiApp.BankKeeper.GetBalance(cachedCtx, feeCollector, usdcDenom)
+			newCtx, err := anteHandler(cachedCtx, tx, false)
+			if tc.errorIs != nil {
+				require.ErrorIs(t, err, tc.errorIs)
+				return
+			}
+			require.NoError(t, err)
+
+			// The fee is deducted in the alternative denom
+			collectorAfter := kiiApp.BankKeeper.GetBalance(cachedCtx, feeCollector, usdcDenom)
+			require.Equal(t, tc.expectedFee, collectorAfter.Sub(collectorBefore))
+			if tc.txFeeChecker != nil {
+				require.Equal(t, int64(10), newCtx.Priority())
+			}
+		})
+	}
+}
+
+// TestFeeAbstractionMinGasPriceDecorator tests the min gas price check on the native equivalent
+func TestFeeAbstractionMinGasPriceDecorator(t *testing.T) {
+	// Start the app
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.BaseApp.NewUncachedContext(false, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+	usdcDenom := "ibc/usdc"
+
+	params := feeabstypes.DefaultParams()
+	params.FeeDenoms = []feeabstypes.FeeDenom{feeabstypes.NewFeeDenom(usdcDenom, oracleutils.MicroUsdcDenom, 6)}
+	require.NoError(t, kiiApp.FeeAbsKeeper.Params.Set(ctx, params))
+	setOraclePrices(t, kiiApp, ctx, map[string]math.LegacyDec{
+		oracleutils.MicroKiiDenom:  math.LegacyNewDecWithPrec(5, 1),
+		oracleutils.MicroUsdcDenom: math.LegacyOneDec(),
+	})
+
+	// The inner decorator records the fee it sees
+	var seenFee sdk.Coins
+	inner := recordFeeDecorator{seenFee: &seenFee}
+	anteHandler := sdk.ChainAnteDecorators(ante.NewFeeAbstractionMinGasPriceDecorator(inner, &kiiApp.FeeAbsKeeper))
+
+	// Alternative fees are seen as native
+	tx, err := buildTxWithFee(apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewInt64Coin(usdcDenom, 500_000)))
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewIntWithDecimal(1, 18))), seenFee)
+
+	// Other denoms are untouched
+	otherFee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))
+	tx, err = buildTxWithFee(apptesting.RandomAccountAddress(), otherFee)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, otherFee, seenFee)
+}
+
+// recordFeeDecorator records the fee of the tx it handles
+type recordFeeDecorator struct {
+	seenFee *sdk.Coins
+}
+
+// AnteHandle records the tx fee
+func (d recordFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.seenFee = tx.(sdk.FeeTx).GetFee()
+	return next(ctx, tx, simulate)
+}
+
+// setOraclePrices sets the oracle prices and a single snapshot for the TWAPs
+func setOraclePrices(t *testing.T, kiiApp *kiichain.KiichainApp, ctx sdk.Context, prices map[string]math.LegacyDec) {
+	t.Helper()
+
+	snapshot := oracletypes.PriceSnapshot{SnapshotTimestamp: ctx.BlockTime().Unix() - 60}
+	for denom, price := range prices {
+		require.NoError(t, kiiApp.OracleKeeper.VoteTarget.Set(ctx, denom, oracletypes.Denom{Name: denom}))
+		require.NoError(t, kiiApp.OracleKeeper.SetBaseExchangeRateWithDefault(ctx, denom, price))
+
+		snapshot.PriceSnapshotItems = append(snapshot.PriceSnapshotItems, oracletypes.PriceSnapshotItem{
+			Denom: denom,
+			OracleExchangeRate: oracletypes.OracleExchangeRate{
+				ExchangeRate:        price,
+				LastUpdate:          math.NewInt(ctx.BlockHeight()),
+				LastUpdateTimestamp: ctx.BlockTime().UnixMilli(),
+			},
+		})
+	}
+	require.NoError(t, kiiApp.OracleKeeper.AddPriceSnapshot(ctx, snapshot))
+}
+
+// buildTxWithFee builds a bank send tx paying the given fee
+func buildTxWithFee(feePayer sdk.AccAddress, fee sdk.Coins) (sdk.Tx, error) {
+	txBuilder := kiiparams.MakeEncodingConfig().TxConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(banktypes.NewMsgSend(feePayer, feePayer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder.SetFeePayer(feePayer)
+	txBuilder.SetGasLimit(200_000)
+	txBuilder.SetFeeAmount(fee)
+
+	return txBuilder.GetTx(), nil
+}
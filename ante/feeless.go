@@ -7,27 +7,44 @@ import (
 	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	feelesskeeper "github.com/kiichain/kiichain/v3/x/feeless/keeper"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
 	oraclekeeper "github.com/kiichain/kiichain/v3/x/oracle/keeper"
 	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
 )
 
 // FeelessDecorator defines a decorator that allows feeless transaction based on conditions
+// The conditions are the feeless policies managed by governance on the feeless module
 type FeelessDecorator struct {
 	// feeDecorator is the SDK fee decorator that deducts fees from the fee payer
 	feeDecorator sdk.AnteDecorator
-	// oracleKeeper is one of the modules that has feeless transactions
+	// feelessKeeper holds the feeless policies, relayers and block quotas
+	feelessKeeper *feelesskeeper.Keeper
+	// oracleKeeper is used to check the oracle feeder eligibility
 	oracleKeeper *oraclekeeper.Keeper
+	// stakingKeeper is used to check the validator eligibilities
+	stakingKeeper *stakingkeeper.Keeper
 }
 
 // Type assertion for the FeelessDecorator
 var _ sdk.AnteDecorator = FeelessDecorator{}
 
 // NewFeelessDecorator creates a new FeelessDecorator
-func NewFeelessDecorator(feeDecorator sdk.AnteDecorator, oracleKeeper *oraclekeeper.Keeper) FeelessDecorator {
+func NewFeelessDecorator(
+	feeDecorator sdk.AnteDecorator,
+	feelessKeeper *feelesskeeper.Keeper,
+	oracleKeeper *oraclekeeper.Keeper,
+	stakingKeeper *stakingkeeper.Keeper,
+) FeelessDecorator {
 	return FeelessDecorator{
-		feeDecorator: feeDecorator,
-		oracleKeeper: oracleKeeper,
+		feeDecorator:  feeDecorator,
+		feelessKeeper: feelessKeeper,
+		oracleKeeper:  oracleKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
@@ -52,27 +69,134 @@ func (gd FeelessDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 }
 
 // IsTxFeeless checks if the transaction is feeless
+// A transaction is feeless if its message type has a feeless policy, the
+// signers pass the policy eligibility and still have quota on the block
 func (gd FeelessDecorator) IsTxFeeless(ctx sdk.Context, tx sdk.Tx) (bool, error) {
 	// Check if the transaction has exactly one message
 	// If it has any amount different than one, we can return that its not gasless
 	// This protects against DDoS attacks where a transaction has multiple messages
-	if len(tx.GetMsgs()) != 1 {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
 		return false, nil
 	}
+	msg := msgs[0]
 
-	// Iterate all the msgs on the tx
-	for _, msg := range tx.GetMsgs() {
-		switch m := msg.(type) {
-		case *oracletypes.MsgAggregateExchangeRateVote:
-			// Check if the message is feeless
-			return gd.MsgAggregateExchangeRateVoteIsFeeless(ctx, m)
-		default:
-			// We can return that its not gasless
+	// Check the message type policy
+	policy, found, err := gd.feelessKeeper.GetPolicy(ctx, sdk.MsgTypeURL(msg))
+	if err != nil || !found {
+		return false, err
+	}
+
+	// Transactions above the max gas always pay fees
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return false, nil
+	}
+	params, err := gd.feelessKeeper.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsGasAllowed(feeTx.GetGas()) {
+		return false, nil
+	}
+
+	// Get the signers, this includes the fee payer
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false, nil
+	}
+	rawSigners, err := sigTx.GetSigners()
+	if err != nil {
+		return false, err
+	}
+	signers := make([]sdk.AccAddress, len(rawSigners))
+	for i, signer := range rawSigners {
+		signers[i] = sdk.AccAddress(signer)
+	}
+
+	// Check the policy eligibility
+	eligible, err := gd.IsEligible(ctx, policy.Eligibility, msg, signers)
+	if err != nil || !eligible {
+		return false, err
+	}
+
+	// Oracle feeders are also limited on each vote period
+	if voteMsg, ok := msg.(*oracletypes.MsgAggregateExchangeRateVote); ok && policy.Eligibility == feelesstypes.EligibilityOracleFeeder {
+		withinQuota, err := gd.consumeFeederQuota(ctx, voteMsg)
+		if err != nil || !withinQuota {
+			return false, err
+		}
+	}
+
+	// Finally consume the signers quota on the block
+	return gd.feelessKeeper.ConsumeBlockQuota(ctx, signers, policy)
+}
+
+// IsEligible checks if a message and its signers pass a feeless eligibility
+func (gd FeelessDecorator) IsEligible(ctx sdk.Context, eligibility feelesstypes.Eligibility, msg sdk.Msg, signers []sdk.AccAddress) (bool, error) {
+	switch eligibility {
+	case feelesstypes.EligibilityOracleFeeder:
+		voteMsg, ok := msg.(*oracletypes.MsgAggregateExchangeRateVote)
+		if !ok {
 			return false, nil
 		}
+		return gd.MsgAggregateExchangeRateVoteIsFeeless(ctx, voteMsg)
+	case feelesstypes.EligibilityValidatorOperator:
+		return gd.signersAreValidators(ctx, signers, false)
+	case feelesstypes.EligibilityBondedValidator:
+		return gd.signersAreValidators(ctx, signers, true)
+	case feelesstypes.EligibilityRegisteredRelayer:
+		for _, signer := range signers {
+			isRelayer, err := gd.feelessKeeper.IsRelayer(ctx, signer)
+			if err != nil || !isRelayer {
+				return false, err
+			}
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// signersAreValidators checks if all the signers are validator operators
+// If bonded is set the validators must also be bonded
+func (gd FeelessDecorator) signersAreValidators(ctx sdk.Context, signers []sdk.AccAddress, bonded bool) (bool, error) {
+	for _, signer := range signers {
+		validator, err := gd.stakingKeeper.GetValidator(ctx, sdk.ValAddress(signer))
+		if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if bonded && !validator.IsBonded() {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// consumeFeederQuota registers the feeless vote on the feeder counters of the current vote period
+func (gd FeelessDecorator) consumeFeederQuota(ctx sdk.Context, msg *oracletypes.MsgAggregateExchangeRateVote) (bool, error) {
+	feederAddr, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return false, err
+	}
+
+	oracleParams, err := gd.oracleKeeper.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	votePeriod := uint64(ctx.BlockHeight()) / oracleParams.VotePeriod
+
+	// A feeder votes once per vote period for each of its validators
+	feederValidators, err := gd.oracleKeeper.CountFeederValidators(ctx, feederAddr)
+	if err != nil {
+		return false, err
 	}
 
-	return false, nil
+	return gd.feelessKeeper.ConsumeFeederQuota(ctx, feederAddr, votePeriod, feederValidators)
 }
 
 // MsgAggregateExchangeRateVoteIsFeeless checks if the MsgAggregateExchangeRateVote is feeless
@@ -14,6 +14,7 @@ import (
 	authate "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/kiichain/kiichain/v3/app/apptesting"
 	"github.com/kiichain/kiichain/v3/app/helpers"
 	kiiparams "github.com/kiichain/kiichain/v3/app/params"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
 	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
 )
 
@@ -59,7 +61,9 @@ func TestFeelessDecorator(t *testing.T) {
 			nil,
 			nil,
 		),
+		&app.FeelessKeeper,
 		&app.OracleKeeper,
+		app.StakingKeeper,
 	)
 
 	// Wrap into the sdk ante decorator
@@ -214,6 +218,252 @@ func TestFeelessDecorator(t *testing.T) {
 	}
 }
 
+// TestFeelessDecoratorPolicies tests the FeelessDecorator with the governance policies
+func TestFeelessDecoratorPolicies(t *testing.T) {
+	// Start the app
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(true, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+
+	// Use the chain validator operator as the fee payer
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	valAcc := sdk.AccAddress(valAddr)
+
+	// A regular account
+	user := apptesting.RandomAccountAddress()
+
+	// Fund the fee payer account
+	err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)))
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, valAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)))
+	require.NoError(t, err)
+
+	// Start a new FeelessDecorator with the wrapped fee handler
+	feelessDecorator := ante.NewFeelessDecorator(
+		authate.NewDeductFeeDecorator(
+			app.AccountKeeper,
+			app.BankKeeper,
+			nil,
+			nil,
+		),
+		&app.FeelessKeeper,
+		&app.OracleKeeper,
+		app.StakingKeeper,
+	)
+	anteHandler := sdk.ChainAnteDecorators(feelessDecorator)
+
+	// Messages used on the tests
+	unjailMsg := &slashingtypes.MsgUnjail{ValidatorAddr: valAddr.String()}
+	sendMsg := banktypes.NewMsgSend(valAcc, valAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	userSendMsg := banktypes.NewMsgSend(user, user, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	// setPolicy is a helper to store a policy
+	setPolicy := func(t *testing.T, ctx sdk.Context, msg sdk.Msg, eligibility feelesstypes.Eligibility, maxTxsPerBlock uint64) feelesstypes.FeelessPolicy {
+		t.Helper()
+		policy := feelesstypes.NewFeelessPolicy(sdk.MsgTypeURL(msg), eligibility, maxTxsPerBlock)
+		err := app.FeelessKeeper.Policies.Set(ctx, policy.MsgTypeUrl, policy)
+		require.NoError(t, err)
+		return policy
+	}
+
+	// Write the test cases
+	testCases := []struct {
+		name        string
+		msg         sdk.Msg
+		malleate    func(*testing.T, sdk.Context)
+		balanceDiff math.Int
+	}{
+		{
+			name:        "No policy for the message - deduct fee",
+			msg:         unjailMsg,
+			balanceDiff: feeCoin.Amount,
+		},
+		{
+			name: "Validator operator policy - no fee deduction",
+			msg:  unjailMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, unjailMsg, feelesstypes.EligibilityValidatorOperator, 1)
+			},
+			balanceDiff: math.ZeroInt(),
+		},
+		{
+			name: "Bonded validator policy - no fee deduction",
+			msg:  sendMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, sendMsg, feelesstypes.EligibilityBondedValidator, 0)
+			},
+			balanceDiff: math.ZeroInt(),
+		},
+		{
+			name: "Validator policy with a non validator signer - deduct fee",
+			msg:  userSendMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, userSendMsg, feelesstypes.EligibilityValidatorOperator, 0)
+			},
+			balanceDiff: feeCoin.Amount,
+		},
+		{
+			name: "Relayer policy with an unregistered signer - deduct fee",
+			msg:  sendMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, sendMsg, feelesstypes.EligibilityRegisteredRelayer, 0)
+			},
+			balanceDiff: feeCoin.Amount,
+		},
+		{
+			name: "Relayer policy with a registered signer - no fee deduction",
+			msg:  sendMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, sendMsg, feelesstypes.EligibilityRegisteredRelayer, 0)
+				err := app.FeelessKeeper.Relayers.Set(ctx, valAcc)
+				require.NoError(t, err)
+			},
+			balanceDiff: math.ZeroInt(),
+		},
+		{
+			name: "Oracle feeder policy on another message - deduct fee",
+			msg:  sendMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, sendMsg, feelesstypes.EligibilityOracleFeeder, 0)
+			},
+			balanceDiff: feeCoin.Amount,
+		},
+		{
+			name: "Gas above the max gas per tx - deduct fee",
+			msg:  unjailMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				setPolicy(t, ctx, unjailMsg, feelesstypes.EligibilityValidatorOperator, 0)
+				err := app.FeelessKeeper.Params.Set(ctx, feelesstypes.Params{MaxGasPerTx: 1000})
+				require.NoError(t, err)
+			},
+			balanceDiff: feeCoin.Amount,
+		},
+		{
+			name: "Block quota exhausted - deduct fee",
+			msg:  unjailMsg,
+			malleate: func(t *testing.T, ctx sdk.Context) {
+				t.Helper()
+				policy := setPolicy(t, ctx, unjailMsg, feelesstypes.EligibilityValidatorOperator, 1)
+				ok, err := app.FeelessKeeper.ConsumeBlockQuota(ctx, []sdk.AccAddress{valAcc}, policy)
+				require.NoError(t, err)
+				require.True(t, ok)
+			},
+			balanceDiff: feeCoin.Amount,
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Get a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// If a malleate function is provided, call it to modify the context
+			if tc.malleate != nil {
+				tc.malleate(t, cachedCtx)
+			}
+
+			// Build a tx from the message
+			tx, err := buildTxFromMsgs(valAcc, tc.msg)
+			require.NoError(t, err)
+
+			// Execute the ante handler and check the fee payer balance
+			balanceBefore := app.BankKeeper.GetBalance(cachedCtx, valAcc, "stake")
+			_, err = anteHandler(cachedCtx, tx, false)
+			require.NoError(t, err)
+			balanceAfter := app.BankKeeper.GetBalance(cachedCtx, valAcc, "stake")
+
+			require.Equal(t, tc.balanceDiff.Int64(), balanceBefore.Amount.Sub(balanceAfter.Amount).Int64(), "Balance difference should match expected value")
+		})
+	}
+}
+
+// TestFeelessVoteRateLimit tests the feeless oracle votes limits per feeder
+func TestFeelessVoteRateLimit(t *testing.T) {
+	// Start the app
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(false, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+
+	// Use the chain validator with a funded feeder
+	feeder := apptesting.RandomAccountAddress()
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	require.NoError(t, err)
+	err = app.OracleKeeper.FeederDelegation.Set(ctx, valAddr, feeder.String())
+	require.NoError(t, err)
+	err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)))
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feeder, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000)))
+	require.NoError(t, err)
+
+	// The feeder votes for a single validator, so a single feeless vote is allowed per period. Penalize after two failures
+	err = app.FeelessKeeper.Params.Set(ctx, feelesstypes.Params{MaxFailedFeelessVotes: 2, PenaltyVotePeriods: 10})
+	require.NoError(t, err)
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewFeelessDecorator(
+		authate.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil, nil),
+		&app.FeelessKeeper,
+		&app.OracleKeeper,
+		app.StakingKeeper,
+	))
+	postHandler := sdk.ChainPostDecorators(ante.NewFeelessVotePostDecorator(&app.FeelessKeeper))
+
+	tx, err := buildTxFromMsgs(feeder, &oracletypes.MsgAggregateExchangeRateVote{
+		ExchangeRates: "0.1stake",
+		Feeder:        feeder.String(),
+		Validator:     valAddr.String(),
+	})
+	require.NoError(t, err)
+
+	// sendVote runs the ante handler on the given height and returns the paid fee
+	sendVote := func(height int64) int64 {
+		t.Helper()
+		voteCtx := ctx.WithBlockHeight(height)
+		balanceBefore := app.BankKeeper.GetBalance(voteCtx, feeder, "stake")
+		_, err := anteHandler(voteCtx, tx, false)
+		require.NoError(t, err)
+		return balanceBefore.Amount.Sub(app.BankKeeper.GetBalance(voteCtx, feeder, "stake").Amount).Int64()
+	}
+
+	// The first vote is feeless and the second on the same period pays fees
+	votePeriod := int64(oracletypes.DefaultVotePeriod)
+	require.Zero(t, sendVote(votePeriod))
+	require.Equal(t, feeCoin.Amount.Int64(), sendVote(votePeriod+1))
+
+	// A successful vote clears the failure counted by the ante handler
+	stats, err := app.FeelessKeeper.GetFeederStats(ctx, feeder)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.FailedVotes)
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	stats, err = app.FeelessKeeper.GetFeederStats(ctx, feeder)
+	require.NoError(t, err)
+	require.Zero(t, stats.FailedVotes)
+
+	// Failed votes are kept, so the feeder is penalized after two of them
+	require.Zero(t, sendVote(2*votePeriod))
+	_, err = postHandler(ctx, tx, false, false)
+	require.NoError(t, err)
+	require.Zero(t, sendVote(3*votePeriod))
+	require.Equal(t, feeCoin.Amount.Int64(), sendVote(4*votePeriod))
+	require.Equal(t, feeCoin.Amount.Int64(), sendVote(13*votePeriod))
+	require.Zero(t, sendVote(14*votePeriod))
+
+	stats, err = app.FeelessKeeper.GetFeederStats(ctx, feeder)
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), stats.PenalizedUntil)
+}
+
 // buildTxFromMsgs builds a tx from a set of messages
 func buildTxFromMsgs(feePayer sdk.AccAddress, msgs ...sdk.Msg) (xauthsigning.Tx, error) {
 	// Start the tx builder
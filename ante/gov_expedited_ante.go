@@ -7,30 +7,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
 	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
 )
 
-var expeditedPropDecoratorEnabled = true
-
-// SetExpeditedProposalsEnabled toggles the expedited proposals decorator on/off.
-// Should only be used in testing - this is to allow simtests to pass.
-func SetExpeditedProposalsEnabled(val bool) {
-	expeditedPropDecoratorEnabled = val
-}
-
-var expeditedPropsWhitelist = map[string]struct{}{
-	"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade": {},
-	"/cosmos.upgrade.v1beta1.MsgCancelUpgrade":   {},
-}
-
 // Check if the proposal is whitelisted for expedited voting.
+// The whitelist and its enforcement are kept on the anteparams module state
 type GovExpeditedProposalsDecorator struct {
-	cdc codec.BinaryCodec
+	cdc              codec.BinaryCodec
+	anteParamsKeeper *anteparamskeeper.Keeper
 }
 
-func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec) GovExpeditedProposalsDecorator {
+func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec, anteParamsKeeper *anteparamskeeper.Keeper) GovExpeditedProposalsDecorator {
 	return GovExpeditedProposalsDecorator{
-		cdc: cdc,
+		cdc:              cdc,
+		anteParamsKeeper: anteParamsKeeper,
 	}
 }
 
@@ -38,28 +29,29 @@ func NewGovExpeditedProposalsDecorator(cdc codec.BinaryCodec) GovExpeditedPropos
 // Only proposals submitted using "kiichaind tx gov submit-proposal" can be expedited.
 // Legacy proposals submitted using "kiichaind tx gov submit-legacy-proposal" cannot be marked as expedited.
 func (g GovExpeditedProposalsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-	if expeditedPropDecoratorEnabled {
-		for _, msg := range tx.GetMsgs() {
-			prop, ok := msg.(*govv1.MsgSubmitProposal)
-			if !ok {
-				continue
-			}
-			if prop.Expedited {
-				if err := g.validateExpeditedGovProp(prop); err != nil {
-					return ctx, err
-				}
+	for _, msg := range tx.GetMsgs() {
+		prop, ok := msg.(*govv1.MsgSubmitProposal)
+		if !ok {
+			continue
+		}
+		if prop.Expedited {
+			if err := g.validateExpeditedGovProp(ctx, prop); err != nil {
+				return ctx, err
 			}
 		}
 	}
 	return next(ctx, tx, simulate)
 }
 
-func (g GovExpeditedProposalsDecorator) isWhitelisted(msgType string) bool {
-	_, ok := expeditedPropsWhitelist[msgType]
-	return ok
-}
+func (g GovExpeditedProposalsDecorator) validateExpeditedGovProp(ctx sdk.Context, prop *govv1.MsgSubmitProposal) error {
+	params, err := g.anteParamsKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.EnforceExpeditedWhitelist {
+		return nil
+	}
 
-func (g GovExpeditedProposalsDecorator) validateExpeditedGovProp(prop *govv1.MsgSubmitProposal) error {
 	msgs := prop.GetMessages()
 	if len(msgs) == 0 {
 		return xerrors.ErrInvalidExpeditedProposal
@@ -67,12 +59,20 @@ func (g GovExpeditedProposalsDecorator) validateExpeditedGovProp(prop *govv1.Msg
 	for _, message := range msgs {
 		// in case of legacy content submitted using govv1.MsgSubmitProposal
 		if sdkMsg, isLegacy := message.GetCachedValue().(*govv1.MsgExecLegacyContent); isLegacy {
-			if !g.isWhitelisted(sdkMsg.Content.TypeUrl) {
+			whitelisted, err := g.anteParamsKeeper.IsExpeditedAllowed(ctx, sdkMsg.Content.TypeUrl)
+			if err != nil {
+				return err
+			}
+			if !whitelisted {
 				return errorsmod.Wrapf(xerrors.ErrInvalidExpeditedProposal, "invalid Msg type: %s", sdkMsg.Content.TypeUrl)
 			}
 			continue
 		}
-		if !g.isWhitelisted(message.TypeUrl) {
+		whitelisted, err := g.anteParamsKeeper.IsExpeditedAllowed(ctx, message.TypeUrl)
+		if err != nil {
+			return err
+		}
+		if !whitelisted {
 			return errorsmod.Wrapf(xerrors.ErrInvalidExpeditedProposal, "invalid Msg type: %s", message.TypeUrl)
 		}
 	}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"cosmossdk.io/math"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -16,21 +18,23 @@ import (
 
 	"github.com/kiichain/kiichain/v3/ante"
 	"github.com/kiichain/kiichain/v3/app/helpers"
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
 )
 
 func TestGovExpeditedProposalsDecorator(t *testing.T) {
 	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
 
 	testCases := []struct {
 		name      string
-		ctx       sdk.Context
 		msgs      []sdk.Msg
 		expectErr bool
 	}{
 		// these cases should pass
 		{
 			name: "expedited - govv1.MsgSubmitProposal - MsgSoftwareUpgrade",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&upgradetypes.MsgSoftwareUpgrade{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -45,7 +49,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "expedited - govv1.MsgSubmitProposal - MsgCancelUpgrade",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&upgradetypes.MsgCancelUpgrade{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -55,7 +58,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - TextProposal",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyTextProp(false), // normal
 			},
@@ -63,7 +65,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgCommunityPoolSpend",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -75,7 +76,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgTransfer",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgSend{
 					FromAddress: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -87,7 +87,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "normal - govv1.MsgSubmitProposal - MsgUpdateParams",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgUpdateParams{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -99,13 +98,11 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		// submitted using "kiichaind tx gov submit-legacy-proposal"
 		{
 			name:      "normal - govv1beta.MsgSubmitProposal - LegacySoftwareUpgrade",
-			ctx:       sdk.Context{},
 			msgs:      []sdk.Msg{newGovV1BETA1LegacyUpgradeProp()},
 			expectErr: false,
 		},
 		{
 			name:      "normal - govv1beta.MsgSubmitProposal - LegacyCancelSoftwareUpgrade",
-			ctx:       sdk.Context{},
 			msgs:      []sdk.Msg{newGovV1BETA1LegacyCancelUpgradeProp()},
 			expectErr: false,
 		},
@@ -113,7 +110,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		// these are normal proposals, not whitelisted for expedited voting
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - Empty",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{}, true),
 			},
@@ -121,7 +117,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - TextProposal",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyTextProp(true), // expedite
 			},
@@ -129,7 +124,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgCommunityPoolSpend",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -141,7 +135,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgTransfer",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgSend{
 					FromAddress: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -153,7 +146,6 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "fail - expedited - govv1.MsgSubmitProposal - MsgUpdateParams",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newGovProp([]sdk.Msg{&banktypes.MsgUpdateParams{
 					Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
@@ -168,12 +160,12 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 
 		t.Run(tc.name, func(t *testing.T) {
 			txCfg := kiiApp.GetTxConfig()
-			decorator := ante.NewGovExpeditedProposalsDecorator(kiiApp.AppCodec())
+			decorator := ante.NewGovExpeditedProposalsDecorator(kiiApp.AppCodec(), &kiiApp.AnteParamsKeeper)
 
 			txBuilder := txCfg.NewTxBuilder()
 			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
 
-			_, err := decorator.AnteHandle(tc.ctx, txBuilder.GetTx(), false,
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
 				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
 			if tc.expectErr {
 				require.Error(t, err)
@@ -184,6 +176,50 @@ func TestGovExpeditedProposalsDecorator(t *testing.T) {
 	}
 }
 
+// TestGovExpeditedProposalsDecoratorState tests that the whitelist is read from the anteparams state
+func TestGovExpeditedProposalsDecoratorState(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovExpeditedProposalsDecorator(kiiApp.AppCodec(), &kiiApp.AnteParamsKeeper)
+	msgServer := anteparamskeeper.NewMsgServerImpl(kiiApp.AnteParamsKeeper)
+	authority := kiiApp.AnteParamsKeeper.GetAuthority()
+
+	spendProp := newGovProp([]sdk.Msg{&distrtypes.MsgCommunityPoolSpend{
+		Authority: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
+		Recipient: "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
+		Amount:    sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(100))),
+	}}, true)
+	spendTypeURL := sdk.MsgTypeURL(&distrtypes.MsgCommunityPoolSpend{})
+
+	anteHandle := func(msgs ...sdk.Msg) error {
+		txBuilder := kiiApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	// Not whitelisted by default
+	require.ErrorIs(t, anteHandle(spendProp), xerrors.ErrInvalidExpeditedProposal)
+
+	// Governance allows the msg type
+	_, err := msgServer.AddExpeditedMsgType(ctx, anteparamstypes.NewMsgAddExpeditedMsgType(authority, spendTypeURL))
+	require.NoError(t, err)
+	require.NoError(t, anteHandle(spendProp))
+
+	// And removes it
+	_, err = msgServer.RemoveExpeditedMsgType(ctx, anteparamstypes.NewMsgRemoveExpeditedMsgType(authority, spendTypeURL))
+	require.NoError(t, err)
+	require.ErrorIs(t, anteHandle(spendProp), xerrors.ErrInvalidExpeditedProposal)
+
+	// Any proposal can be expedited when the whitelist is not enforced
+	params := anteparamstypes.DefaultParams()
+	params.EnforceExpeditedWhitelist = false
+	require.NoError(t, kiiApp.AnteParamsKeeper.Params.Set(ctx, params))
+	require.NoError(t, anteHandle(spendProp))
+	require.NoError(t, anteHandle(newLegacyTextProp(true)))
+}
+
 func newLegacyTextProp(expedite bool) *govv1.MsgSubmitProposal {
 	testProposal := govv1beta1.NewTextProposal("Proposal", "Test as normal proposal")
 	msgContent, err := govv1.NewLegacyContent(testProposal, "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv")
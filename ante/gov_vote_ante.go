@@ -1,40 +1,46 @@
 package ante
 
 import (
+	stdmath "math"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
 	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
 )
 
-var (
-	minStakedTokens       = math.LegacyNewDec(1000000) // 1_000_000 akii (or 1 atom)
-	maxDelegationsChecked = 100                        // number of delegation to check for the minStakedTokens
-)
-
-// SetMinStakedTokens sets the minimum amount of staked tokens required to vote
-// Should only be used in testing
-func SetMinStakedTokens(tokens math.LegacyDec) {
-	minStakedTokens = tokens
-}
-
+// GovVoteDecorator rejects votes from accounts without the minimum stake
+// The minimum stake and the counted tokens are defined by the anteparams params
 type GovVoteDecorator struct {
-	stakingKeeper *stakingkeeper.Keeper
-	cdc           codec.BinaryCodec
+	stakingKeeper    *stakingkeeper.Keeper
+	accountKeeper    ante.AccountKeeper
+	anteparamsKeeper *anteparamskeeper.Keeper
+	cdc              codec.BinaryCodec
 }
 
-func NewGovVoteDecorator(cdc codec.BinaryCodec, stakingKeeper *stakingkeeper.Keeper) GovVoteDecorator {
+func NewGovVoteDecorator(
+	cdc codec.BinaryCodec,
+	stakingKeeper *stakingkeeper.Keeper,
+	accountKeeper ante.AccountKeeper,
+	anteparamsKeeper *anteparamskeeper.Keeper,
+) GovVoteDecorator {
 	return GovVoteDecorator{
-		stakingKeeper: stakingKeeper,
-		cdc:           cdc,
+		stakingKeeper:    stakingKeeper,
+		accountKeeper:    accountKeeper,
+		anteparamsKeeper: anteparamsKeeper,
+		cdc:              cdc,
 	}
 }
 
@@ -77,49 +83,40 @@ func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) erro
 			return nil
 		}
 
-		if minStakedTokens.IsZero() {
+		params, err := g.anteparamsKeeper.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if params.MinStakedTokens.IsZero() {
 			return nil
 		}
 
-		enoughStake := false
-		delegationCount := 0
-		stakedTokens := math.LegacyNewDec(0)
-		err = g.stakingKeeper.IterateDelegatorDelegations(ctx, accAddr, func(delegation stakingtypes.Delegation) bool {
-			validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
-			if err != nil {
-				panic(err) // shouldn't happen
-			}
-			validator, err := g.stakingKeeper.GetValidator(ctx, validatorAddr)
-			if err == nil {
-				shares := delegation.Shares
-				tokens := validator.TokensFromSharesTruncated(shares)
-				stakedTokens = stakedTokens.Add(tokens)
-				if stakedTokens.GTE(minStakedTokens) {
-					enoughStake = true
-					return true // break the iteration
-				}
-			}
-			delegationCount++
-			// break the iteration if maxDelegationsChecked were already checked
-			return delegationCount >= maxDelegationsChecked
-		})
+		enoughStake, err := g.hasMinStake(ctx, accAddr, params)
 		if err != nil {
 			return err
 		}
 
 		if !enoughStake {
-			return errorsmod.Wrapf(xerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", minStakedTokens)
+			return errorsmod.Wrapf(xerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", params.MinStakedTokens)
 		}
 
 		return nil
 	}
 
-	validAuthz := func(execMsg *authz.MsgExec) error {
+	// nested MsgExec are unwrapped, their depth is limited by the AuthzPolicyDecorator
+	var validAuthz func(execMsg *authz.MsgExec) error
+	validAuthz = func(execMsg *authz.MsgExec) error {
 		for _, v := range execMsg.Msgs {
 			var innerMsg sdk.Msg
 			if err := g.cdc.UnpackAny(v, &innerMsg); err != nil {
 				return errorsmod.Wrap(xerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
 			}
+			if nestedExec, ok := innerMsg.(*authz.MsgExec); ok {
+				if err := validAuthz(nestedExec); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := validMsg(innerMsg); err != nil {
 				return err
 			}
@@ -143,3 +140,67 @@ func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) erro
 	}
 	return nil
 }
+
+// hasMinStake checks if the voter has the minimum stake
+// Bonded delegations are always counted, unbonding and vesting tokens only if enabled by the params
+func (g GovVoteDecorator) hasMinStake(ctx sdk.Context, voter sdk.AccAddress, params anteparamstypes.Params) (bool, error) {
+	minStakedTokens := math.LegacyNewDecFromInt(params.MinStakedTokens)
+	stakedTokens := math.LegacyZeroDec()
+
+	delegationCount := uint32(0)
+	err := g.stakingKeeper.IterateDelegatorDelegations(ctx, voter, func(delegation stakingtypes.Delegation) bool {
+		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err) // shouldn't happen
+		}
+		validator, err := g.stakingKeeper.GetValidator(ctx, validatorAddr)
+		if err == nil {
+			shares := delegation.Shares
+			tokens := validator.TokensFromSharesTruncated(shares)
+			stakedTokens = stakedTokens.Add(tokens)
+			if stakedTokens.GTE(minStakedTokens) {
+				return true // break the iteration
+			}
+		}
+		delegationCount++
+		// break the iteration if maxDelegationsChecked were already checked
+		return delegationCount >= params.MaxDelegationsChecked
+	})
+	if err != nil {
+		return false, err
+	}
+	if stakedTokens.GTE(minStakedTokens) {
+		return true, nil
+	}
+
+	// Tokens being unbonded are still at stake until the unbonding completes
+	if params.CountUnbonding {
+		maxRetrieve := uint16(min(params.MaxDelegationsChecked, stdmath.MaxUint16))
+		unbondings, err := g.stakingKeeper.GetUnbondingDelegations(ctx, voter, maxRetrieve)
+		if err != nil {
+			return false, err
+		}
+		for _, unbonding := range unbondings {
+			for _, entry := range unbonding.Entries {
+				stakedTokens = stakedTokens.Add(math.LegacyNewDecFromInt(entry.Balance))
+			}
+		}
+		if stakedTokens.GTE(minStakedTokens) {
+			return true, nil
+		}
+	}
+
+	// Locked vesting tokens are counted, the delegated vesting tokens were already counted as delegations
+	if params.CountVesting {
+		if vestingAcc, ok := g.accountKeeper.GetAccount(ctx, voter).(vestingexported.VestingAccount); ok {
+			bondDenom, err := g.stakingKeeper.BondDenom(ctx)
+			if err != nil {
+				return false, err
+			}
+			locked := vestingAcc.LockedCoins(ctx.BlockTime()).AmountOf(bondDenom)
+			stakedTokens = stakedTokens.Add(math.LegacyNewDecFromInt(locked))
+		}
+	}
+
+	return stakedTokens.GTE(minStakedTokens), nil
+}
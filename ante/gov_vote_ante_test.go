@@ -2,6 +2,7 @@ package ante_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,12 +12,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	"github.com/kiichain/kiichain/v3/ante"
+	"github.com/kiichain/kiichain/v3/app/apptesting"
 	"github.com/kiichain/kiichain/v3/app/helpers"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
 )
 
 // Test that the GovVoteDecorator rejects v1beta1 vote messages from accounts with less than 1 atom staked
@@ -24,7 +33,7 @@ import (
 func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 	kiiApp := helpers.Setup(t)
 	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper, kiiApp.AccountKeeper, &kiiApp.AnteParamsKeeper)
 	stakingKeeper := kiiApp.StakingKeeper
 
 	// Get validator
@@ -150,7 +159,7 @@ func TestVoteSpamDecoratorGovV1Beta1(t *testing.T) {
 func TestVoteSpamDecoratorGovV1(t *testing.T) {
 	kiiApp := helpers.Setup(t)
 	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
-	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper)
+	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper, kiiApp.AccountKeeper, &kiiApp.AnteParamsKeeper)
 	stakingKeeper := kiiApp.StakingKeeper
 
 	// Get validator
@@ -271,3 +280,115 @@ func TestVoteSpamDecoratorGovV1(t *testing.T) {
 		}
 	}
 }
+
+// Test that the GovVoteDecorator follows the anteparams params, including the unbonding and vesting opt-ins
+func TestVoteSpamDecoratorParams(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{Time: time.Now().UTC()})
+	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper, kiiApp.AccountKeeper, &kiiApp.AnteParamsKeeper)
+	stakingKeeper := kiiApp.StakingKeeper
+
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	validator := validators[0]
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	require.NoError(t, err)
+
+	// The delegator has 400_000 bonded and 600_000 unbonding
+	delegator := apptesting.RandomAccountAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))
+	require.NoError(t, kiiApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+	require.NoError(t, kiiApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, delegator, funds))
+	shares, err := stakingKeeper.Delegate(ctx, delegator, math.NewInt(1_000_000), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	_, _, err = stakingKeeper.Undelegate(ctx, delegator, valAddr, shares.MulInt64(6).QuoInt64(10))
+	require.NoError(t, err)
+
+	// The vesting account has 2_000_000 locked and nothing delegated
+	vestingAddr := apptesting.RandomAccountAddress()
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2_000_000))
+	baseAcc := authtypes.NewBaseAccountWithAddress(vestingAddr)
+	vestingAcc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, vestingCoins, ctx.BlockTime().Unix(), ctx.BlockTime().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccount(ctx, vestingAcc))
+	require.NoError(t, kiiApp.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, vestingCoins))
+	require.NoError(t, kiiApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, vestingAddr, vestingCoins))
+
+	tests := []struct {
+		name       string
+		params     anteparamstypes.Params
+		voter      sdk.AccAddress
+		expectPass bool
+	}{
+		{
+			name:       "default params - bonded stake under the minimum",
+			params:     anteparamstypes.DefaultParams(),
+			voter:      delegator,
+			expectPass: false,
+		},
+		{
+			name:       "lower minimum stake",
+			params:     anteparamstypes.NewParams(math.NewInt(400_000), 100, false, false, true, 2, anteparamstypes.DefaultMinCommissionRate),
+			voter:      delegator,
+			expectPass: true,
+		},
+		{
+			name:       "minimum stake disabled",
+			params:     anteparamstypes.NewParams(math.ZeroInt(), 100, false, false, true, 2, anteparamstypes.DefaultMinCommissionRate),
+			voter:      vestingAddr,
+			expectPass: true,
+		},
+		{
+			name:       "unbonding stake counted",
+			params:     anteparamstypes.NewParams(math.NewInt(1_000_000), 100, true, false, true, 2, anteparamstypes.DefaultMinCommissionRate),
+			voter:      delegator,
+			expectPass: true,
+		},
+		{
+			name:       "vesting stake not counted",
+			params:     anteparamstypes.DefaultParams(),
+			voter:      vestingAddr,
+			expectPass: false,
+		},
+		{
+			name:       "vesting stake counted",
+			params:     anteparamstypes.NewParams(math.NewInt(1_000_000), 100, false, true, true, 2, anteparamstypes.DefaultMinCommissionRate),
+			voter:      vestingAddr,
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tests {
+		require.NoError(t, kiiApp.AnteParamsKeeper.Params.Set(ctx, tc.params))
+
+		msg := govv1.NewMsgVote(tc.voter, 0, govv1.VoteOption_VOTE_OPTION_YES, "")
+		err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{msg})
+		if tc.expectPass {
+			require.NoError(t, err, "expected %v to pass", tc.name)
+		} else {
+			require.ErrorIs(t, err, xerrors.ErrInsufficientStake, "expected %v to fail", tc.name)
+		}
+	}
+}
+
+// Test that the GovVoteDecorator checks votes wrapped in nested authz MsgExec
+func TestVoteSpamDecoratorNestedExec(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovVoteDecorator(kiiApp.AppCodec(), kiiApp.StakingKeeper, kiiApp.AccountKeeper, &kiiApp.AnteParamsKeeper)
+
+	// The voter has nothing staked
+	voter := apptesting.RandomAccountAddress()
+	grantee := apptesting.RandomAccountAddress()
+	vote := govv1.NewMsgVote(voter, 0, govv1.VoteOption_VOTE_OPTION_YES, "")
+
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{vote})
+	err := decorator.ValidateVoteMsgs(ctx, []sdk.Msg{&exec})
+	require.ErrorIs(t, err, xerrors.ErrInsufficientStake)
+
+	nestedExec := authz.NewMsgExec(grantee, []sdk.Msg{&exec})
+	err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{&nestedExec})
+	require.ErrorIs(t, err, xerrors.ErrInsufficientStake)
+}
@@ -19,7 +19,12 @@ import (
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	feeabskeeper "github.com/kiichain/kiichain/v3/x/feeabs/keeper"
+	feelesskeeper "github.com/kiichain/kiichain/v3/x/feeless/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v3/x/oracle/keeper"
+	paymasterkeeper "github.com/kiichain/kiichain/v3/x/paymaster/keeper"
+	smartaccountkeeper "github.com/kiichain/kiichain/v3/x/smartaccount/keeper"
 )
 
 // HandlerOptions defines the list of module keepers required to run the Cosmos EVM
@@ -42,7 +47,13 @@ type HandlerOptions struct {
 	TXCounterStoreService corestoretypes.KVStoreService
 	WasmConfig            *wasmtypes.WasmConfig
 
-	OracleKeeper *oraclekeeper.Keeper
+	OracleKeeper     *oraclekeeper.Keeper
+	FeelessKeeper    *feelesskeeper.Keeper
+	FeeAbsKeeper     *feeabskeeper.Keeper
+	AnteParamsKeeper *anteparamskeeper.Keeper
+	PaymasterKeeper  *paymasterkeeper.Keeper
+
+	SmartAccountKeeper *smartaccountkeeper.Keeper
 }
 
 // Validate checks if the keepers are defined
@@ -80,5 +91,20 @@ func (options HandlerOptions) Validate() error {
 	if options.OracleKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "oracle keeper is required for AnteHandler")
 	}
+	if options.FeelessKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "feeless keeper is required for AnteHandler")
+	}
+	if options.FeeAbsKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "fee abstraction keeper is required for AnteHandler")
+	}
+	if options.AnteParamsKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "ante params keeper is required for AnteHandler")
+	}
+	if options.PaymasterKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "paymaster keeper is required for AnteHandler")
+	}
+	if options.SmartAccountKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "smart account keeper is required for AnteHandler")
+	}
 	return nil
 }
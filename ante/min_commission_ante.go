@@ -0,0 +1,65 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// MinCommissionDecorator rejects validators created or edited with a commission rate below the minimum
+// The minimum commission rate is defined by the anteparams params
+type MinCommissionDecorator struct {
+	cdc              codec.BinaryCodec
+	anteParamsKeeper *anteparamskeeper.Keeper
+}
+
+func NewMinCommissionDecorator(cdc codec.BinaryCodec, anteParamsKeeper *anteparamskeeper.Keeper) MinCommissionDecorator {
+	return MinCommissionDecorator{
+		cdc:              cdc,
+		anteParamsKeeper: anteParamsKeeper,
+	}
+}
+
+// AnteHandle rejects transactions with validator commissions below the minimum
+func (m MinCommissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params, err := m.anteParamsKeeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := m.validateMsg(msg, params.MinCommissionRate); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateMsg checks the commission rates of a message, nested MsgExec are unwrapped
+// as their depth is limited by the AuthzPolicyDecorator
+func (m MinCommissionDecorator) validateMsg(msg sdk.Msg, minRate math.LegacyDec) error {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		for _, v := range msg.Msgs {
+			var innerMsg sdk.Msg
+			if err := m.cdc.UnpackAny(v, &innerMsg); err != nil {
+				return errorsmod.Wrap(xerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+			if err := m.validateMsg(innerMsg, minRate); err != nil {
+				return err
+			}
+		}
+	default:
+		return anteparamstypes.ValidateMinCommission(msg, minRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/kiichain/kiichain/v3/ante"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// TestMinCommissionDecorator tests the minimum commission rate on validator creation and edition
+func TestMinCommissionDecorator(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewMinCommissionDecorator(kiiApp.AppCodec(), &kiiApp.AnteParamsKeeper)
+
+	pk := ed25519.GenPrivKeyFromSecret([]byte{uint8(13)}).PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	grantee := sdk.AccAddress("grantee_____________")
+
+	newCreate := func(rate, maxRate math.LegacyDec) sdk.Msg {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr.String(),
+			pk,
+			sdk.NewCoin("akii", math.NewInt(1_000_000)),
+			stakingtypes.Description{Moniker: "validator"},
+			stakingtypes.NewCommissionRates(rate, maxRate, math.LegacyNewDecWithPrec(1, 2)),
+			math.OneInt(),
+		)
+		require.NoError(t, err)
+		return msg
+	}
+	newEdit := func(rate *math.LegacyDec) sdk.Msg {
+		return stakingtypes.NewMsgEditValidator(valAddr.String(), stakingtypes.Description{}, rate, nil)
+	}
+	newExec := func(msgs ...sdk.Msg) sdk.Msg {
+		exec := authz.NewMsgExec(grantee, msgs)
+		return &exec
+	}
+
+	anteHandle := func(msgs ...sdk.Msg) error {
+		txBuilder := kiiApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	lowRate := math.LegacyNewDecWithPrec(1, 2)
+	minRate := anteparamstypes.DefaultMinCommissionRate
+	highRate := math.LegacyNewDecWithPrec(10, 2)
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		errMsg string
+	}{
+		{
+			name: "create validator at the minimum",
+			msgs: []sdk.Msg{newCreate(minRate, highRate)},
+		},
+		{
+			name:   "create validator below the minimum",
+			msgs:   []sdk.Msg{newCreate(lowRate, highRate)},
+			errMsg: "commission rate 0.010000000000000000 is below the minimum 0.050000000000000000",
+		},
+		{
+			name:   "create validator with max rate below the minimum",
+			msgs:   []sdk.Msg{newCreate(lowRate, lowRate)},
+			errMsg: "max commission rate 0.010000000000000000 is below the minimum",
+		},
+		{
+			name: "edit validator above the minimum",
+			msgs: []sdk.Msg{newEdit(&highRate)},
+		},
+		{
+			name: "edit validator without commission",
+			msgs: []sdk.Msg{newEdit(nil)},
+		},
+		{
+			name:   "edit validator below the minimum",
+			msgs:   []sdk.Msg{newEdit(&lowRate)},
+			errMsg: "commission rate 0.010000000000000000 is below the minimum",
+		},
+		{
+			name:   "edit validator below the minimum through authz",
+			msgs:   []sdk.Msg{newExec(newExec(newEdit(&lowRate)))},
+			errMsg: "commission rate 0.010000000000000000 is below the minimum",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := anteHandle(tc.msgs...)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, xerrors.ErrCommissionTooLow)
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+
+	// The minimum is a governance param, zero disables it
+	params, err := kiiApp.AnteParamsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MinCommissionRate = math.LegacyZeroDec()
+	msgServer := anteparamskeeper.NewMsgServerImpl(kiiApp.AnteParamsKeeper)
+	_, err = msgServer.UpdateParams(ctx, anteparamstypes.NewMsgUpdateParams(kiiApp.AnteParamsKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+	require.NoError(t, anteHandle(newCreate(math.LegacyZeroDec(), lowRate)))
+	require.NoError(t, anteHandle(newEdit(&lowRate)))
+}
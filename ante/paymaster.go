@@ -0,0 +1,296 @@
+package ante
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	paymasterkeeper "github.com/kiichain/kiichain/v3/x/paymaster/keeper"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// SponsoredFeeDecorator deducts the fees of Cosmos transactions from a sponsor
+// A transaction is sponsored when its fee granter sponsors the called contract
+// All the messages must execute the same CosmWasm contract on sponsored methods
+type SponsoredFeeDecorator struct {
+	// feeDecorator deducts the fees of the transactions that are not sponsored
+	feeDecorator sdk.AnteDecorator
+	// paymasterKeeper holds the sponsorships
+	paymasterKeeper *paymasterkeeper.Keeper
+	// txFeeChecker is the native fee checker
+	txFeeChecker ante.TxFeeChecker
+	// evmKeeper provides the base fee and the min gas price capping the sponsored fees
+	evmKeeper anteinterfaces.EVMKeeper
+}
+
+// Type assertion for the SponsoredFeeDecorator
+var _ sdk.AnteDecorator = SponsoredFeeDecorator{}
+
+// NewSponsoredFeeDecorator creates a new SponsoredFeeDecorator
+func NewSponsoredFeeDecorator(
+	feeDecorator sdk.AnteDecorator,
+	paymasterKeeper *paymasterkeeper.Keeper,
+	txFeeChecker ante.TxFeeChecker,
+	evmKeeper anteinterfaces.EVMKeeper,
+) SponsoredFeeDecorator {
+	return SponsoredFeeDecorator{
+		feeDecorator:    feeDecorator,
+		paymasterKeeper: paymasterKeeper,
+		txFeeChecker:    txFeeChecker,
+		evmKeeper:       evmKeeper,
+	}
+}
+
+// AnteHandle deducts the fee from the sponsor or goes through the regular fee deduction
+func (d SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sponsorship, sponsored, err := d.GetSponsorship(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if !sponsored {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	fee := feeTx.GetFee()
+	priority := int64(0)
+	if !simulate && d.txFeeChecker != nil {
+		fee, priority, err = d.txFeeChecker(ctx, tx)
+		if err != nil {
+			return ctx, err
+		}
+	}
+
+	// Sponsors never pay above the chain gas price, so senders can't raise their priority at the sponsor expense
+	maxGasPrice := maxSponsoredGasPrice(ctx, d.evmKeeper)
+	maxFee := maxGasPrice.MulInt64(int64(feeTx.GetGas())).Ceil().TruncateInt()
+	if fee.AmountOf(evmtypes.GetEVMCoinDenom()).GT(maxFee) {
+		return ctx, paymastertypes.ErrInvalidSponsoredFee.Wrapf(
+			"sponsored fee %s is above %s%s, the gas limit at the gas price of %s", fee, maxFee, evmtypes.GetEVMCoinDenom(), maxGasPrice,
+		)
+	}
+
+	if err := d.paymasterKeeper.PayFee(ctx, sponsorship, fee); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, sponsorship.Sponsor),
+		),
+	})
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// GetSponsorship returns the sponsorship paying the fees of a Cosmos transaction
+// The boolean is false if the fee granter is not a sponsor of the called contract
+func (d SponsoredFeeDecorator) GetSponsorship(ctx sdk.Context, tx sdk.Tx) (paymastertypes.Sponsorship, bool, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || len(feeTx.FeeGranter()) == 0 {
+		return paymastertypes.Sponsorship{}, false, nil
+	}
+
+	// All the messages must call the same contract
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return paymastertypes.Sponsorship{}, false, nil
+	}
+	methods := make([]string, len(msgs))
+	var contract string
+	for i, msg := range msgs {
+		executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok || (contract != "" && executeMsg.Contract != contract) {
+			return paymastertypes.Sponsorship{}, false, nil
+		}
+		contract = executeMsg.Contract
+		methods[i] = paymastertypes.WasmMethod(executeMsg.Msg)
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return paymastertypes.Sponsorship{}, false, nil
+	}
+
+	sponsorship, found, err := d.paymasterKeeper.GetSponsorship(ctx, contractAddr, sdk.AccAddress(feeTx.FeeGranter()))
+	if err != nil || !found {
+		return paymastertypes.Sponsorship{}, false, err
+	}
+
+	// From here the sponsor was picked by the sender, so mismatches are reported
+	params, err := d.paymasterKeeper.Params.Get(ctx)
+	if err != nil {
+		return paymastertypes.Sponsorship{}, false, err
+	}
+	if !params.IsGasAllowed(feeTx.GetGas()) {
+		return paymastertypes.Sponsorship{}, false, errorsmod.Wrapf(
+			errortypes.ErrOutOfGas, "sponsored txs can request up to %d gas, got %d", params.MaxGasPerTx, feeTx.GetGas(),
+		)
+	}
+	for _, method := range methods {
+		if !sponsorship.IsMethodSponsored(method) {
+			return paymastertypes.Sponsorship{}, false, paymastertypes.ErrMethodNotSponsored.Wrapf(
+				"method %q of contract %s by sponsor %s", method, sponsorship.Contract, sponsorship.Sponsor,
+			)
+		}
+	}
+
+	return sponsorship, true, nil
+}
+
+// SponsoredEVMTxDecorator moves the fees of sponsored EVM transactions from the sponsor to the sender
+// It runs before the EVM mono decorator, which deducts the fees from the sender balance
+// Transactions calling a sponsored method of a contract without value are sponsored by the
+// first sponsor able to pay the whole fee. Transactions paying above the chain gas price are never sponsored
+type SponsoredEVMTxDecorator struct {
+	// paymasterKeeper holds the sponsorships
+	paymasterKeeper *paymasterkeeper.Keeper
+	// evmKeeper provides the base fee and the min gas price capping the sponsored fees
+	evmKeeper anteinterfaces.EVMKeeper
+}
+
+// Type assertion for the SponsoredEVMTxDecorator
+var _ sdk.AnteDecorator = SponsoredEVMTxDecorator{}
+
+// NewSponsoredEVMTxDecorator creates a new SponsoredEVMTxDecorator
+func NewSponsoredEVMTxDecorator(paymasterKeeper *paymasterkeeper.Keeper, evmKeeper anteinterfaces.EVMKeeper) SponsoredEVMTxDecorator {
+	return SponsoredEVMTxDecorator{
+		paymasterKeeper: paymasterKeeper,
+		evmKeeper:       evmKeeper,
+	}
+}
+
+// AnteHandle prefunds the sender of a sponsored EVM transaction
+func (d SponsoredEVMTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// Invalid transactions are rejected by the mono decorator
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return next(ctx, tx, simulate)
+	}
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[0])
+	if err != nil || txData.GetTo() == nil || txData.GetValue().Sign() != 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params, err := d.paymasterKeeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if !params.IsGasAllowed(txData.GetGas()) {
+		return next(ctx, tx, simulate)
+	}
+
+	// The fee is computed as the mono decorator deducts it, the effective fee under a base fee
+	gasPrice, fee := txData.GetGasPrice(), txData.Fee()
+	if baseFee := d.evmKeeper.GetBaseFee(ctx); txData.TxType() == ethtypes.DynamicFeeTxType && baseFee != nil {
+		gasPrice, fee = txData.EffectiveGasPrice(baseFee), txData.EffectiveFee(baseFee)
+	}
+
+	// Priority tips and gas prices above the chain gas price are paid by the sender
+	if math.LegacyNewDecFromBigInt(gasPrice).GT(maxSponsoredGasPrice(ctx, d.evmKeeper)) {
+		return next(ctx, tx, simulate)
+	}
+
+	// The sender is recovered here, as the mono decorator only sets it after this decorator
+	signer := ethtypes.MakeSigner(evmtypes.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()))
+	sender, err := signer.Sender(ethMsg.AsTransaction())
+	if err != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	// Only the deducted fee is prefunded, the sender never holds sponsor funds past the fee deduction.
+	// A fee cap above the effective gas price is still checked against the sender balance
+	amount := math.NewIntFromBigInt(fee)
+	contract := sdk.AccAddress(txData.GetTo().Bytes())
+	sponsorship, found, err := d.paymasterKeeper.FindSponsorship(ctx, contract, paymastertypes.EVMMethod(txData.GetData()), amount)
+	if err != nil {
+		return ctx, err
+	}
+	if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.paymasterKeeper.PrefundFee(ctx, sponsorship, sender.Bytes(), ethMsg.Hash, amount); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// maxSponsoredGasPrice returns the highest gas price paid by sponsors, the base fee or the min gas price if higher
+func maxSponsoredGasPrice(ctx sdk.Context, evmKeeper anteinterfaces.EVMKeeper) math.LegacyDec {
+	maxGasPrice := evmKeeper.GetMinGasPrice(ctx)
+	if baseFee := evmKeeper.GetBaseFee(ctx); baseFee != nil {
+		maxGasPrice = math.LegacyMaxDec(maxGasPrice, math.LegacyNewDecFromBigInt(baseFee))
+	}
+	return maxGasPrice
+}
+
+// SponsoredEVMTxPostDecorator returns the unspent part of the sponsored EVM fees to the sponsor
+// The sender paid the gas used at the effective gas price, the rest of the prefunded fee is
+// returned, including the gas refunded by the EVM
+type SponsoredEVMTxPostDecorator struct {
+	// paymasterKeeper holds the sponsored fees
+	paymasterKeeper *paymasterkeeper.Keeper
+	// evmKeeper provides the base fee
+	evmKeeper anteinterfaces.EVMKeeper
+}
+
+// Type assertion for the SponsoredEVMTxPostDecorator
+var _ sdk.PostDecorator = SponsoredEVMTxPostDecorator{}
+
+// NewSponsoredEVMTxPostDecorator creates a new SponsoredEVMTxPostDecorator
+func NewSponsoredEVMTxPostDecorator(paymasterKeeper *paymasterkeeper.Keeper, evmKeeper anteinterfaces.EVMKeeper) SponsoredEVMTxPostDecorator {
+	return SponsoredEVMTxPostDecorator{
+		paymasterKeeper: paymasterKeeper,
+		evmKeeper:       evmKeeper,
+	}
+}
+
+// PostHandle returns the unspent sponsored fee after an EVM transaction
+func (d SponsoredEVMTxPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// Messages are only executed on DeliverTx
+	if !success || simulate || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return next(ctx, tx, simulate, success)
+	}
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[0])
+	if err != nil {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// The EVM keeper sets the gas meter to the gas used by the transaction
+	gasUsed := ctx.GasMeter().GasConsumed()
+	gasPrice := txData.GetGasPrice()
+	if baseFee := d.evmKeeper.GetBaseFee(ctx); txData.TxType() == ethtypes.DynamicFeeTxType && baseFee != nil {
+		gasPrice = txData.EffectiveGasPrice(baseFee)
+	}
+	spent := math.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), gasPrice))
+
+	// The returned fee must not count as gas used by the transaction
+	if err := d.paymasterKeeper.ReturnUnspentFee(ctx.WithGasMeter(NewNoConsumptionGasMeter()), ethMsg.Hash, spent); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,215 @@
+package ante_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	tenderminttypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authate "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/ante"
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	kiiparams "github.com/kiichain/kiichain/v3/app/params"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// TestSponsoredFeeDecorator tests the fees paid by the sponsors of CosmWasm contracts
+func TestSponsoredFeeDecorator(t *testing.T) {
+	// Start the app
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(false, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+	denom := evmtypes.GetEVMCoinDenom()
+
+	// Fund a sponsor and a sender
+	sponsor, sender, contract := apptesting.RandomAccountAddress(), apptesting.RandomAccountAddress(), apptesting.RandomAccountAddress()
+	funds := sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000))
+	for _, addr := range []sdk.AccAddress{sponsor, sender} {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, addr, funds))
+	}
+
+	// The sponsor covers the increment method up to 1500 per day
+	sponsorship := paymastertypes.NewSponsorship(sponsor.String(), contract.String(), []string{"increment"}, math.NewInt(1_500))
+	require.NoError(t, app.PaymasterKeeper.Sponsorships.Set(ctx, collections.Join(contract, sponsor), sponsorship))
+
+	// Wrap the native fee deduction
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewSponsoredFeeDecorator(
+			authate.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil, nil),
+			&app.PaymasterKeeper,
+			nil,
+			app.EVMKeeper,
+		),
+	)
+
+	executeMsg := func(msg string) sdk.Msg {
+		return &wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: contract.String(), Msg: []byte(msg)}
+	}
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		granter     sdk.AccAddress
+		gas         uint64
+		fee         int64
+		errMsg      string
+		sponsorPaid int64
+		senderPaid  int64
+	}{
+		{
+			name:        "sponsored call",
+			msgs:        []sdk.Msg{executeMsg(`{"increment":{}}`), executeMsg(`{"increment":{}}`)},
+			granter:     sponsor,
+			sponsorPaid: 1_000,
+		},
+		{
+			name:    "method not sponsored",
+			msgs:    []sdk.Msg{executeMsg(`{"increment":{}}`), executeMsg(`{"reset":{}}`)},
+			granter: sponsor,
+			errMsg:  "method not sponsored",
+		},
+		{
+			name:    "gas above the sponsored limit",
+			msgs:    []sdk.Msg{executeMsg(`{"increment":{}}`)},
+			granter: sponsor,
+			gas:     paymastertypes.DefaultMaxGasPerTx + 1,
+			errMsg:  "sponsored txs can request up to",
+		},
+		{
+			name:    "daily cap exceeded",
+			msgs:    []sdk.Msg{executeMsg(`{"increment":{}}`)},
+			granter: sponsor,
+			errMsg:  "sponsorship daily cap exceeded",
+		},
+		{
+			name:    "fee above the chain gas price",
+			msgs:    []sdk.Msg{executeMsg(`{"increment":{}}`)},
+			granter: sponsor,
+			fee:     app.EVMKeeper.GetBaseFee(ctx).Int64()*200_000 + 1,
+			errMsg:  "is above",
+		},
+		{
+			name:       "no granter pays from the sender",
+			msgs:       []sdk.Msg{executeMsg(`{"increment":{}}`)},
+			senderPaid: 1_000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Build the tx with the fee granter
+			txBuilder := kiiparams.MakeEncodingConfig().TxConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeePayer(sender)
+			txBuilder.SetFeeGranter(tc.granter)
+			fee := tc.fee
+			if fee == 0 {
+				fee = 1_000
+			}
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(denom, fee)))
+			gas := tc.gas
+			if gas == 0 {
+				gas = 200_000
+			}
+			txBuilder.SetGasLimit(gas)
+
+			sponsorBalance := app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount
+			senderBalance := app.BankKeeper.GetBalance(ctx, sender, denom).Amount
+
+			_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sponsorBalance.SubRaw(tc.sponsorPaid), app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount)
+			require.Equal(t, senderBalance.SubRaw(tc.senderPaid), app.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+		})
+	}
+}
+
+// TestSponsoredEVMTxDecorator tests that sponsors prefund the fee deducted from EVM transactions only
+func TestSponsoredEVMTxDecorator(t *testing.T) {
+	// Start the app
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(false, tenderminttypes.Header{Height: 1, ChainID: "testing_1010-1", Time: time.Now().UTC()})
+	denom := evmtypes.GetEVMCoinDenom()
+
+	// The EVM needs a block proposer for the coinbase
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr).WithConsensusParams(tenderminttypes.ConsensusParams{Block: &tenderminttypes.BlockParams{MaxGas: 10_000_000}})
+
+	// The sender sets a high fee cap without tip, the effective gas price is the base fee
+	gas := uint64(100_000)
+	baseFee := app.EVMKeeper.GetBaseFee(ctx)
+	require.NotNil(t, baseFee)
+	gasFeeCap := new(big.Int).Mul(baseFee, big.NewInt(1_000))
+	effectiveFee := math.NewIntFromBigInt(new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gas)))
+
+	// Fund a sponsor, and the sender with the balance checked against the fee cap
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := sdk.AccAddress(crypto.PubkeyToAddress(senderKey.PublicKey).Bytes())
+	sponsor, contract := apptesting.RandomAccountAddress(), common.BytesToAddress(apptesting.RandomAccountAddress())
+	senderFunds := sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromBigInt(new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gas)))))
+	sponsorFunds := senderFunds.MulInt(math.NewInt(2))
+	for addr, funds := range map[string]sdk.Coins{sponsor.String(): sponsorFunds, sender.String(): senderFunds} {
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, funds))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, sdk.MustAccAddressFromBech32(addr), funds))
+	}
+
+	// The sponsor covers any method of the contract
+	sponsorship := paymastertypes.NewSponsorship(sponsor.String(), sdk.AccAddress(contract.Bytes()).String(), nil, sponsorFunds.AmountOf(denom))
+	require.NoError(t, app.PaymasterKeeper.Sponsorships.Set(ctx, collections.Join(sdk.AccAddress(contract.Bytes()), sponsor), sponsorship))
+
+	// Sign the transaction
+	signer := ethtypes.MakeSigner(evmtypes.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()))
+	ethTx, err := ethtypes.SignNewTx(senderKey, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   evmtypes.GetEthChainConfig().ChainID,
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: gasFeeCap,
+		Gas:       gas,
+		To:        &contract,
+		Value:     big.NewInt(0),
+	})
+	require.NoError(t, err)
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	tx, err := msg.BuildTx(app.GetTxConfig().NewTxBuilder(), denom)
+	require.NoError(t, err)
+
+	// The ante handler runs, the execution fails so the post handler never returns the unspent fee
+	sponsorBalance := app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount
+	_, err = app.AnteHandler()(ctx, tx, false)
+	require.NoError(t, err)
+
+	// The sponsor paid the effective fee and the sender holds no sponsor funds
+	require.Equal(t, sponsorBalance.Sub(effectiveFee), app.BankKeeper.GetBalance(ctx, sponsor, denom).Amount)
+	require.Equal(t, senderFunds.AmountOf(denom), app.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	usage, err := app.PaymasterKeeper.GetUsage(ctx, sponsorship)
+	require.NoError(t, err)
+	require.Equal(t, effectiveFee, usage.DailySpent)
+
+	// The prefund record lives on the transient store, dropped with the block
+	_, err = app.PaymasterKeeper.SponsoredFees.Get(ctx, msg.Hash)
+	require.NoError(t, err)
+}
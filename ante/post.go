@@ -0,0 +1,68 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feelesskeeper "github.com/kiichain/kiichain/v3/x/feeless/keeper"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+)
+
+// NewPostHandler returns the post handler executed after the transaction messages
+func NewPostHandler(options HandlerOptions) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewFeelessVotePostDecorator(options.FeelessKeeper),
+		NewSponsoredEVMTxPostDecorator(options.PaymasterKeeper, options.EvmKeeper),
+	)
+}
+
+// FeelessVotePostDecorator clears the failed feeless votes of a feeder once a vote is executed
+// The FeelessDecorator counts every feeless vote as failed on DeliverTx, the post handler state
+// is only written when the messages succeed so the failures left are the votes that failed
+type FeelessVotePostDecorator struct {
+	// feelessKeeper holds the feeder counters
+	feelessKeeper *feelesskeeper.Keeper
+}
+
+// Type assertion for the FeelessVotePostDecorator
+var _ sdk.PostDecorator = FeelessVotePostDecorator{}
+
+// NewFeelessVotePostDecorator creates a new FeelessVotePostDecorator
+func NewFeelessVotePostDecorator(feelessKeeper *feelesskeeper.Keeper) FeelessVotePostDecorator {
+	return FeelessVotePostDecorator{
+		feelessKeeper: feelessKeeper,
+	}
+}
+
+// PostHandle resets the feeder failed votes after a successful oracle vote
+func (d FeelessVotePostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// Messages are only executed on DeliverTx
+	if !success || simulate || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	voteMsg, ok := GetOracleVote(tx)
+	if !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(voteMsg.Feeder)
+	if err != nil {
+		return ctx, err
+	}
+	if err := d.feelessKeeper.ResetFailedVotes(ctx, feederAddr); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// GetOracleVote returns the oracle vote of a transaction with a single vote message
+// The boolean is false for any other transaction
+func GetOracleVote(tx sdk.Tx) (*oracletypes.MsgAggregateExchangeRateVote, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, false
+	}
+	voteMsg, ok := msgs[0].(*oracletypes.MsgAggregateExchangeRateVote)
+	return voteMsg, ok
+}
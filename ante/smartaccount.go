@@ -0,0 +1,197 @@
+package ante
+
+import (
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	smartaccountkeeper "github.com/kiichain/kiichain/v3/x/smartaccount/keeper"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// SmartAccountDecorator verifies the signatures of smart accounts with their authenticator contract.
+// Transactions without smart account signers run the wrapped signature verification decorators
+type SmartAccountDecorator struct {
+	sigVerification    sdk.AnteHandler
+	smartAccountKeeper *smartaccountkeeper.Keeper
+	signModeHandler    *txsigning.HandlerMap
+}
+
+func NewSmartAccountDecorator(
+	smartAccountKeeper *smartaccountkeeper.Keeper,
+	signModeHandler *txsigning.HandlerMap,
+	sigVerificationDecorators ...sdk.AnteDecorator,
+) SmartAccountDecorator {
+	return SmartAccountDecorator{
+		sigVerification:    sdk.ChainAnteDecorators(sigVerificationDecorators...),
+		smartAccountKeeper: smartAccountKeeper,
+		signModeHandler:    signModeHandler,
+	}
+}
+
+// AnteHandle verifies the smart account signatures, or runs the key signature verification
+func (d SmartAccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	smartAccounts := make([]*smartaccounttypes.SmartAccount, len(signers))
+	hasSmartAccount := false
+	for i, signer := range signers {
+		smartAccounts[i], ok = d.smartAccountKeeper.GetSmartAccount(ctx, signer)
+		hasSmartAccount = hasSmartAccount || ok
+	}
+
+	// Transactions signed by keys only are verified as usual
+	if !hasSmartAccount {
+		newCtx, err := d.sigVerification(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return next(newCtx, tx, simulate)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		if err := d.verifySmartAccountSignature(ctx, tx, smartAccounts[i], sig, simulate); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifySmartAccountSignature checks the sequence of a smart account and calls its authenticator contract.
+// On simulations the contract is still called to estimate the gas, but its answer is ignored
+func (d SmartAccountDecorator) verifySmartAccountSignature(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	smartAccount *smartaccounttypes.SmartAccount,
+	sig signing.SignatureV2,
+	simulate bool,
+) error {
+	// Smart accounts can't share a transaction with key signers, the key signatures would be skipped
+	if smartAccount == nil {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, "smart accounts can't sign along with key accounts")
+	}
+
+	if sig.Sequence != smartAccount.GetSequence() {
+		return errorsmod.Wrapf(
+			errortypes.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", smartAccount.GetSequence(), sig.Sequence,
+		)
+	}
+
+	sigData, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrNotSupported, "smart accounts only support single signatures, got %T", sig.Data)
+	}
+
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return nil
+	}
+
+	// The sign bytes include the signer public key, which is not checked against the account.
+	// The authenticator contract decides which keys, like passkeys or session keys, can sign
+	if sig.PubKey == nil {
+		if !simulate {
+			return errorsmod.Wrapf(errortypes.ErrInvalidPubKey, "smart account %s signature has no public key", smartAccount.Address)
+		}
+
+		// Simulations without a public key are charged the max verification gas
+		params, err := d.smartAccountKeeper.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		ctx.GasMeter().ConsumeGas(params.MaxVerifyGas, "smart account signature verification")
+		return nil
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = smartAccount.GetAccountNumber()
+	}
+	signerData := authsigning.SignerData{
+		Address:       smartAccount.Address,
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      smartAccount.GetSequence(),
+		PubKey:        sig.PubKey,
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, d.signModeHandler, sigData.SignMode, signerData, tx)
+	if err != nil {
+		return err
+	}
+
+	err = d.smartAccountKeeper.VerifySignature(ctx, smartAccount, signBytes, sigData.Signature)
+	if err != nil && !simulate {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "smart account %s: %s", smartAccount.Address, err)
+	}
+
+	return nil
+}
+
+// SmartAccountEVMTxDecorator rejects the EVM transactions sent by smart accounts
+// Smart accounts are verified by their authenticator contract, the key of the address must not keep control of the account
+type SmartAccountEVMTxDecorator struct {
+	smartAccountKeeper *smartaccountkeeper.Keeper
+}
+
+// Type assertion for the SmartAccountEVMTxDecorator
+var _ sdk.AnteDecorator = SmartAccountEVMTxDecorator{}
+
+// NewSmartAccountEVMTxDecorator creates a new SmartAccountEVMTxDecorator
+func NewSmartAccountEVMTxDecorator(smartAccountKeeper *smartaccountkeeper.Keeper) SmartAccountEVMTxDecorator {
+	return SmartAccountEVMTxDecorator{
+		smartAccountKeeper: smartAccountKeeper,
+	}
+}
+
+// AnteHandle rejects the EVM transactions whose sender is a smart account
+func (d SmartAccountEVMTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// The sender is recovered here, as the mono decorator only sets it after this decorator
+	signer := ethtypes.MakeSigner(evmtypes.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()))
+	for _, msg := range tx.GetMsgs() {
+		// Invalid transactions are rejected by the mono decorator
+		ethMsg, _, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			continue
+		}
+		sender, err := signer.Sender(ethMsg.AsTransaction())
+		if err != nil {
+			continue
+		}
+
+		if _, ok := d.smartAccountKeeper.GetSmartAccount(ctx, sender.Bytes()); ok {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnauthorized, "smart account %s can't send EVM transactions", sdk.AccAddress(sender.Bytes()),
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,218 @@
+package ante_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	sdkante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/ante"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	smartaccountkeeper "github.com/kiichain/kiichain/v3/x/smartaccount/keeper"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// TestSmartAccountDecorator tests the verification of smart account signatures by their authenticator contract
+func TestSmartAccountDecorator(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+
+	// The EVM needs a block proposer for the coinbase
+	validators, err := kiiApp.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+
+	decorator := ante.NewSmartAccountDecorator(
+		&kiiApp.SmartAccountKeeper,
+		kiiApp.GetTxConfig().SignModeHandler(),
+		sdkante.NewSetPubKeyDecorator(kiiApp.AccountKeeper),
+		sdkante.NewValidateSigCountDecorator(kiiApp.AccountKeeper),
+		sdkante.NewSigVerificationDecorator(kiiApp.AccountKeeper, kiiApp.GetTxConfig().SignModeHandler()),
+	)
+
+	// Set an ERC-1271 contract returning the magic value when the signature starts with 0x01
+	code := common.FromHex("60643560001a600114631626ba7e0260e01b60005260206000f3")
+	contract := common.BytesToAddress([]byte("authenticator_______"))
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccountWithAddress(ctx, contract.Bytes()))
+	kiiApp.EVMKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+	kiiApp.EVMKeeper.SetCodeHash(ctx, contract.Bytes(), crypto.Keccak256(code))
+
+	// Register the smart account
+	smartAccount := sdk.AccAddress("smart_account_______")
+	keyAccount := sdk.AccAddress("key_account_________")
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccountWithAddress(ctx, smartAccount))
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccountWithAddress(ctx, keyAccount))
+	_, err = smartaccountkeeper.NewMsgServerImpl(kiiApp.SmartAccountKeeper).RegisterSmartAccount(ctx,
+		smartaccounttypes.NewMsgRegisterSmartAccount(smartAccount.String(), smartaccounttypes.CONTRACT_TYPE_EVM, contract.Hex()))
+	require.NoError(t, err)
+
+	newSend := func(from sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, keyAccount, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1))))
+	}
+	sessionKey := secp256k1.GenPrivKey().PubKey()
+	newSig := func(sequence uint64, signature []byte) signing.SignatureV2 {
+		return signing.SignatureV2{
+			PubKey:   sessionKey,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: signature},
+			Sequence: sequence,
+		}
+	}
+
+	anteHandle := func(msgs []sdk.Msg, sigs []signing.SignatureV2, simulate bool) error {
+		txBuilder := kiiApp.GetTxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+		_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), simulate,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		sigs     []signing.SignatureV2
+		simulate bool
+		err      error
+		errMsg   string
+	}{
+		{
+			name: "signature accepted by the contract",
+			msgs: []sdk.Msg{newSend(smartAccount)},
+			sigs: []signing.SignatureV2{newSig(0, []byte{0x01})},
+		},
+		{
+			name:   "signature rejected by the contract",
+			msgs:   []sdk.Msg{newSend(smartAccount)},
+			sigs:   []signing.SignatureV2{newSig(0, []byte{0x02})},
+			err:    errortypes.ErrUnauthorized,
+			errMsg: "rejected by the authenticator contract",
+		},
+		{
+			name:     "rejected signature on simulation",
+			msgs:     []sdk.Msg{newSend(smartAccount)},
+			sigs:     []signing.SignatureV2{newSig(0, []byte{0x02})},
+			simulate: true,
+		},
+		{
+			name: "signature without public key",
+			msgs: []sdk.Msg{newSend(smartAccount)},
+			sigs: []signing.SignatureV2{{
+				Data: &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte{0x01}},
+			}},
+			err:    errortypes.ErrInvalidPubKey,
+			errMsg: "signature has no public key",
+		},
+		{
+			name:   "wrong sequence",
+			msgs:   []sdk.Msg{newSend(smartAccount)},
+			sigs:   []signing.SignatureV2{newSig(1, []byte{0x01})},
+			err:    errortypes.ErrWrongSequence,
+			errMsg: "account sequence mismatch",
+		},
+		{
+			name:   "missing signature",
+			msgs:   []sdk.Msg{newSend(smartAccount)},
+			err:    errortypes.ErrUnauthorized,
+			errMsg: "invalid number of signer",
+		},
+		{
+			name:   "smart account along with a key account",
+			msgs:   []sdk.Msg{newSend(smartAccount), newSend(keyAccount)},
+			sigs:   []signing.SignatureV2{newSig(0, []byte{0x01}), newSig(0, []byte{0x01})},
+			err:    errortypes.ErrUnauthorized,
+			errMsg: "smart accounts can't sign along with key accounts",
+		},
+		{
+			name: "key account still verified by its key",
+			msgs: []sdk.Msg{newSend(keyAccount)},
+			sigs: []signing.SignatureV2{newSig(0, []byte{0x01})},
+			err:  errortypes.ErrInvalidPubKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := anteHandle(tc.msgs, tc.sigs, tc.simulate)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+// TestSmartAccountEVMTxDecorator tests that smart accounts can't send EVM transactions with the key of their address
+func TestSmartAccountEVMTxDecorator(t *testing.T) {
+	kiiApp := helpers.Setup(t)
+	ctx := kiiApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+
+	// Set an authenticator contract
+	code := common.FromHex("60643560001a600114631626ba7e0260e01b60005260206000f3")
+	contract := common.BytesToAddress([]byte("authenticator_______"))
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccountWithAddress(ctx, contract.Bytes()))
+	kiiApp.EVMKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+	kiiApp.EVMKeeper.SetCodeHash(ctx, contract.Bytes(), crypto.Keccak256(code))
+
+	// Register the smart account, the original key of the address still signs the EVM transaction
+	smartAccountKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	smartAccount := sdk.AccAddress(crypto.PubkeyToAddress(smartAccountKey.PublicKey).Bytes())
+	kiiApp.AccountKeeper.SetAccount(ctx, kiiApp.AccountKeeper.NewAccountWithAddress(ctx, smartAccount))
+	_, err = smartaccountkeeper.NewMsgServerImpl(kiiApp.SmartAccountKeeper).RegisterSmartAccount(ctx,
+		smartaccounttypes.NewMsgRegisterSmartAccount(smartAccount.String(), smartaccounttypes.CONTRACT_TYPE_EVM, contract.Hex()))
+	require.NoError(t, err)
+
+	keyAccountKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	newEthTx := func(key *ecdsa.PrivateKey) *evmtypes.MsgEthereumTx {
+		signer := ethtypes.MakeSigner(evmtypes.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()))
+		ethTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{
+			To:       &contract,
+			Gas:      100_000,
+			GasPrice: big.NewInt(1),
+			Value:    big.NewInt(0),
+		})
+		require.NoError(t, err)
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(ethTx))
+		return msg
+	}
+
+	// The decorator rejects the smart account and lets the key accounts through
+	decorator := ante.NewSmartAccountEVMTxDecorator(&kiiApp.SmartAccountKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	_, err = decorator.AnteHandle(ctx, newEthTx(smartAccountKey), false, next)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.ErrorContains(t, err, "can't send EVM transactions")
+	_, err = decorator.AnteHandle(ctx, newEthTx(keyAccountKey), false, next)
+	require.NoError(t, err)
+
+	// The EVM ante handler rejects the transaction of the smart account
+	tx, err := newEthTx(smartAccountKey).BuildTx(kiiApp.GetTxConfig().NewTxBuilder(), evmtypes.GetEVMCoinDenom())
+	require.NoError(t, err)
+	_, err = kiiApp.AnteHandler()(ctx, tx, false)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.ErrorContains(t, err, "can't send EVM transactions")
+}
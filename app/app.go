@@ -65,8 +65,10 @@ import (
 
 	kiiante "github.com/kiichain/kiichain/v3/ante"
 	"github.com/kiichain/kiichain/v3/app/keepers"
+	kiimempool "github.com/kiichain/kiichain/v3/app/mempool"
 	"github.com/kiichain/kiichain/v3/app/upgrades"
 	v3_0 "github.com/kiichain/kiichain/v3/app/upgrades/v3_0"
+	v3_1 "github.com/kiichain/kiichain/v3/app/upgrades/v3_1"
 	"github.com/kiichain/kiichain/v3/client/docs"
 )
 
@@ -77,6 +79,7 @@ var (
 	// Upgrades is a list of all the upgrades that are available for the application.
 	Upgrades = []upgrades.Upgrade{
 		v3_0.Upgrade,
+		v3_1.Upgrade,
 	}
 )
 
@@ -273,6 +276,9 @@ func NewKiichainApp(
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 	app.setAnteHandler(app.txConfig, maxGasWanted, appOpts)
 
+	// Set the lane mempool
+	app.setMempool(appOpts)
+
 	if manager := app.SnapshotManager(); manager != nil {
 		err = manager.RegisterExtensions(wasmkeeper.NewWasmSnapshotter(app.CommitMultiStore(), &app.AppKeepers.WasmKeeper))
 		if err != nil {
@@ -311,7 +317,7 @@ func NewKiichainApp(
 	return app
 }
 
-// setAnteHandler sets the antehandler on the app
+// setAnteHandler sets the antehandler and the posthandler on the app
 func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, appOpts servertypes.AppOptions) {
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -335,12 +341,33 @@ func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 		TXCounterStoreService:  runtime.NewKVStoreService(app.AppKeepers.GetKey(wasmtypes.StoreKey)),
 		WasmConfig:             &wasmConfig,
 		OracleKeeper:           &app.OracleKeeper,
+		FeelessKeeper:          &app.FeelessKeeper,
+		FeeAbsKeeper:           &app.FeeAbsKeeper,
+		AnteParamsKeeper:       &app.AnteParamsKeeper,
+		PaymasterKeeper:        &app.PaymasterKeeper,
+		SmartAccountKeeper:     &app.SmartAccountKeeper,
 	}
 	if err := options.Validate(); err != nil {
 		panic(err)
 	}
 
 	app.SetAnteHandler(kiiante.NewAnteHandler(options))
+	app.SetPostHandler(kiiante.NewPostHandler(options))
+}
+
+// setMempool sets the lane mempool and its proposal handler on the app
+func (app *KiichainApp) setMempool(appOpts servertypes.AppOptions) {
+	lanesConfig, err := kiimempool.ReadConfig(appOpts)
+	if err != nil {
+		panic("error while reading lanes config: " + err.Error())
+	}
+	if !lanesConfig.Enable {
+		return
+	}
+
+	laneMempool := kiimempool.NewLaneMempool(kiimempool.NewLanes(lanesConfig)...)
+	app.SetMempool(laneMempool)
+	app.SetPrepareProposal(kiimempool.NewProposalHandler(laneMempool, app.BaseApp).PrepareProposalHandler())
 }
 
 // Name returns the name of the App
@@ -86,10 +86,20 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"github.com/kiichain/kiichain/v3/wasmbinding"
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	feeabskeeper "github.com/kiichain/kiichain/v3/x/feeabs/keeper"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+	feelesskeeper "github.com/kiichain/kiichain/v3/x/feeless/keeper"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
 	oraclekeeper "github.com/kiichain/kiichain/v3/x/oracle/keeper"
 	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	paymasterkeeper "github.com/kiichain/kiichain/v3/x/paymaster/keeper"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
 	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
 	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+	smartaccountkeeper "github.com/kiichain/kiichain/v3/x/smartaccount/keeper"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
@@ -124,6 +134,11 @@ type AppKeepers struct {
 	AuthzKeeper           authzkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	OracleKeeper          oraclekeeper.Keeper
+	FeelessKeeper         feelesskeeper.Keeper
+	FeeAbsKeeper          feeabskeeper.Keeper
+	AnteParamsKeeper      anteparamskeeper.Keeper
+	PaymasterKeeper       paymasterkeeper.Keeper
+	SmartAccountKeeper    smartaccountkeeper.Keeper
 
 	PFMRouterKeeper *pfmrouterkeeper.Keeper
 	RatelimitKeeper ratelimitkeeper.Keeper
@@ -152,6 +167,7 @@ type AppKeepers struct {
 var tokenFactoryCapabilities = []string{
 	tokenfactorytypes.EnableSetMetadata,
 	tokenfactorytypes.EnableCommunityPoolFeeFunding,
+	tokenfactorytypes.EnableFreeze,
 }
 
 func NewAppKeeper(
@@ -429,10 +445,35 @@ func NewAppKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[rewardstypes.StoreKey]),
 		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
 	)
 
+	// Feeless Keeper
+	appKeepers.FeelessKeeper = feelesskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feelesstypes.StoreKey]),
+		runtime.NewTransientStoreService(appKeepers.tkeys[feelesstypes.TStoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Ante params Keeper
+	appKeepers.AnteParamsKeeper = anteparamskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[anteparamstypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Paymaster Keeper
+	appKeepers.PaymasterKeeper = paymasterkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[paymastertypes.StoreKey]),
+		runtime.NewTransientStoreService(appKeepers.tkeys[paymastertypes.TStoreKey]),
+		appKeepers.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Cosmos EVM keepers
 	appKeepers.FeeMarketKeeper = feemarketkeeper.NewKeeper(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
@@ -491,6 +532,14 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// Fee abstraction Keeper
+	appKeepers.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feeabstypes.StoreKey]),
+		&appKeepers.OracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	appKeepers.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[tokenfactorytypes.StoreKey],
@@ -498,6 +547,8 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		&appKeepers.Erc20Keeper,
+		&appKeepers.OracleKeeper,
 		tokenFactoryCapabilities,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -508,6 +559,7 @@ func NewAppKeeper(
 			&appKeepers.TokenFactoryKeeper,
 			appKeepers.EVMKeeper,
 			appKeepers.OracleKeeper,
+			appKeepers.RewardsKeeper,
 		)...,
 	)
 
@@ -541,6 +593,23 @@ func NewAppKeeper(
 		wasmOpts...,
 	)
 
+	// Smart account keeper, the authenticators are wasm or evm contracts
+	appKeepers.SmartAccountKeeper = smartaccountkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[smartaccounttypes.StoreKey]),
+		appKeepers.AccountKeeper,
+		&appKeepers.WasmKeeper,
+		appKeepers.EVMKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// The tokenfactory before send hooks are wasm contracts called on every send
+	appKeepers.TokenFactoryKeeper.SetContractKeeper(wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper))
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.BlockBeforeSend)
+
+	// Frozen accounts and paused tokenfactory denoms can't be transferred
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.BlockFrozenSend)
+
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(&appKeepers.ICAControllerKeeper, &appKeepers.ICAHostKeeper)
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
@@ -606,6 +675,9 @@ func NewAppKeeper(
 		appKeepers.EvidenceKeeper,
 		appKeepers.WasmKeeper,
 		appKeepers.OracleKeeper,
+		appKeepers.RewardsKeeper,
+		appKeepers.TokenFactoryKeeper,
+		appKeepers.AnteParamsKeeper,
 	)
 	appKeepers.EVMKeeper.WithStaticPrecompiles(
 		corePrecompiles,
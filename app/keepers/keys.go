@@ -34,8 +34,13 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	// Kiichain
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
 	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
 	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
 	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
 
@@ -73,6 +78,11 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		tokenfactorytypes.StoreKey,
 		rewardstypes.StoreKey,
 		oracletypes.StoreKey,
+		feelesstypes.StoreKey,
+		feeabstypes.StoreKey,
+		anteparamstypes.StoreKey,
+		paymastertypes.StoreKey,
+		smartaccounttypes.StoreKey,
 	)
 
 	// Define transient store keys
@@ -81,6 +91,9 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		// EVM keys
 		evmtypes.TransientKey,
 		feemarkettypes.TransientKey,
+		// Custom modules
+		feelesstypes.TStoreKey,
+		paymastertypes.TStoreKey,
 	)
 
 	// MemKeys are for information that is stored only in RAM.
@@ -29,7 +29,6 @@ import (
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
-	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	"github.com/cosmos/evm/x/vm/core/vm"
@@ -37,8 +36,14 @@ import (
 
 	"github.com/kiichain/kiichain/v3/precompiles/ibc"
 	"github.com/kiichain/kiichain/v3/precompiles/oracle"
+	"github.com/kiichain/kiichain/v3/precompiles/rewards"
+	"github.com/kiichain/kiichain/v3/precompiles/staking"
+	"github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
 	"github.com/kiichain/kiichain/v3/precompiles/wasmd"
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v3/x/oracle/keeper"
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
+	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
 )
 
 const bech32PrecompileBaseGas = 6_000
@@ -62,6 +67,9 @@ func NewAvailableStaticPrecompiles(
 	evidenceKeeper evidencekeeper.Keeper,
 	wasmdKeeper wasmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	rewardsKeeper rewardskeeper.Keeper,
+	tokenFactoryKeeper tokenfactorykeeper.Keeper,
+	anteParamsKeeper anteparamskeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -75,8 +83,8 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate bech32 precompile: %w", err))
 	}
 
-	// Prepare the staking precompile
-	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	// Prepare the staking precompile, wrapped to enforce the minimum commission rate
+	stakingPrecompile, err := staking.NewPrecompile(stakingKeeper, authzKeeper, anteParamsKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to instantiate staking precompile: %w", err))
 	}
@@ -146,6 +154,18 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate oracle precompile: %w", err))
 	}
 
+	// Prepare the rewards precompile
+	rewardsPrecompile, err := rewards.NewPrecompile(rewardsKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate rewards precompile: %w", err))
+	}
+
+	// Prepare the tokenfactory precompile
+	tokenFactoryPrecompile, err := tokenfactory.NewPrecompile(tokenFactoryKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate tokenfactory precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -161,6 +181,8 @@ func NewAvailableStaticPrecompiles(
 	precompiles[wasmdPrecompile.Address()] = wasmdPrecompile
 	precompiles[ibcPrecompile.Address()] = ibcPrecompile
 	precompiles[oraclePrecompile.Address()] = oraclePrecompile
+	precompiles[rewardsPrecompile.Address()] = rewardsPrecompile
+	precompiles[tokenFactoryPrecompile.Address()] = tokenFactoryPrecompile
 
 	// Return the precompiles
 	return precompiles
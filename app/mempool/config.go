@@ -0,0 +1,133 @@
+package mempool
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// FlagEnable is the app.toml key enabling the lane mempool
+	FlagEnable = "lanes.enable"
+	// FlagMaxTxs is the app.toml key of the transactions kept per lane
+	FlagMaxTxs = "lanes.max-txs"
+	// FlagOracleBlockSpace is the app.toml key of the oracle lane block space
+	FlagOracleBlockSpace = "lanes.oracle-block-space"
+	// FlagIBCBlockSpace is the app.toml key of the IBC lane block space
+	FlagIBCBlockSpace = "lanes.ibc-block-space"
+	// FlagEVMBlockSpace is the app.toml key of the EVM lane block space
+	FlagEVMBlockSpace = "lanes.evm-block-space"
+	// FlagDefaultBlockSpace is the app.toml key of the default lane block space
+	FlagDefaultBlockSpace = "lanes.default-block-space"
+)
+
+// Config defines the lanes of the app mempool
+// The block spaces are percentages of the block bytes and gas, a zero block space
+// disables the lane and its transactions go to the default lane
+type Config struct {
+	// Enable turns on the lane mempool and its proposal handler
+	Enable bool `mapstructure:"enable"`
+	// MaxTxs is the highest number of transactions kept on each lane, zero disables the cap
+	MaxTxs int `mapstructure:"max-txs"`
+	// OracleBlockSpace is the block space of the oracle votes
+	OracleBlockSpace uint64 `mapstructure:"oracle-block-space"`
+	// IBCBlockSpace is the block space of the IBC relaying transactions
+	IBCBlockSpace uint64 `mapstructure:"ibc-block-space"`
+	// EVMBlockSpace is the block space of the EVM transactions
+	EVMBlockSpace uint64 `mapstructure:"evm-block-space"`
+	// DefaultBlockSpace is the block space of the remaining transactions
+	DefaultBlockSpace uint64 `mapstructure:"default-block-space"`
+}
+
+// DefaultConfig returns the default lanes config
+func DefaultConfig() Config {
+	return Config{
+		Enable:            true,
+		MaxTxs:            5000,
+		OracleBlockSpace:  10,
+		IBCBlockSpace:     20,
+		EVMBlockSpace:     50,
+		DefaultBlockSpace: 100,
+	}
+}
+
+// Validate validates the lanes config
+func (c Config) Validate() error {
+	if c.MaxTxs < 0 {
+		return fmt.Errorf("max txs cannot be negative, got %d", c.MaxTxs)
+	}
+	for name, blockSpace := range map[string]uint64{
+		LaneOracle:  c.OracleBlockSpace,
+		LaneIBC:     c.IBCBlockSpace,
+		LaneEVM:     c.EVMBlockSpace,
+		LaneDefault: c.DefaultBlockSpace,
+	} {
+		if blockSpace > 100 {
+			return fmt.Errorf("%s lane block space must be a percentage, got %d", name, blockSpace)
+		}
+	}
+	if c.DefaultBlockSpace == 0 {
+		return fmt.Errorf("default lane block space must be positive")
+	}
+
+	return nil
+}
+
+// ReadConfig reads the lanes config from the app options
+// Nodes without the lanes section on app.toml keep the previous mempool
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	v := opts.Get(FlagEnable)
+	if v == nil {
+		cfg.Enable = false
+		return cfg, nil
+	}
+
+	var err error
+	if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+		return cfg, err
+	}
+	if v := opts.Get(FlagMaxTxs); v != nil {
+		if cfg.MaxTxs, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	for flag, value := range map[string]*uint64{
+		FlagOracleBlockSpace:  &cfg.OracleBlockSpace,
+		FlagIBCBlockSpace:     &cfg.IBCBlockSpace,
+		FlagEVMBlockSpace:     &cfg.EVMBlockSpace,
+		FlagDefaultBlockSpace: &cfg.DefaultBlockSpace,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *value, err = cast.ToUint64E(v); err != nil {
+				return cfg, err
+			}
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// DefaultConfigTemplate is the app.toml snippet of the default lanes config
+const DefaultConfigTemplate = `
+###############################################################################
+###                           Lanes Configuration                           ###
+###############################################################################
+
+[lanes]
+# Enables the lane mempool, proposals are built from the lanes in order:
+# oracle votes, IBC relaying, EVM and default transactions
+enable = {{ .Lanes.Enable }}
+
+# Highest number of transactions kept on each lane, zero disables the cap
+max-txs = {{ .Lanes.MaxTxs }}
+
+# Percentage of the block bytes and gas each lane can use
+# Zero disables a lane, its transactions go to the default lane
+oracle-block-space = {{ .Lanes.OracleBlockSpace }}
+ibc-block-space = {{ .Lanes.IBCBlockSpace }}
+evm-block-space = {{ .Lanes.EVMBlockSpace }}
+default-block-space = {{ .Lanes.DefaultBlockSpace }}
+`
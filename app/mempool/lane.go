@@ -0,0 +1,112 @@
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	kiiante "github.com/kiichain/kiichain/v3/ante"
+)
+
+const (
+	// LaneOracle is the lane of the oracle votes
+	LaneOracle = "oracle"
+	// LaneIBC is the lane of the IBC relaying transactions
+	LaneIBC = "ibc"
+	// LaneEVM is the lane of the EVM transactions
+	LaneEVM = "evm"
+	// LaneDefault is the lane of the remaining transactions
+	LaneDefault = "default"
+)
+
+// IBCRelayMsgTypeURLs are the messages sent by the IBC relayers
+var IBCRelayMsgTypeURLs = map[string]bool{
+	sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}):     true,
+	sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}):      true,
+	sdk.MsgTypeURL(&channeltypes.MsgAcknowledgement{}): true,
+	sdk.MsgTypeURL(&channeltypes.MsgTimeout{}):         true,
+	sdk.MsgTypeURL(&channeltypes.MsgTimeoutOnClose{}):  true,
+}
+
+// MatchFn checks if a transaction belongs to a lane
+type MatchFn func(tx sdk.Tx) bool
+
+// Lane is a partition of the mempool with a share of the block space
+type Lane struct {
+	// Name is the name of the lane
+	Name string
+	// MaxBlockSpace is the percentage of the block bytes and gas the lane can use
+	MaxBlockSpace uint64
+	// Match checks if a transaction belongs to the lane
+	Match MatchFn
+
+	// mempool holds the lane transactions ordered by priority and nonce
+	mempool *sdkmempool.PriorityNonceMempool[int64]
+}
+
+// NewLane creates a new lane keeping up to maxTxs transactions, zero disables the cap
+func NewLane(name string, maxBlockSpace uint64, match MatchFn, maxTxs int) *Lane {
+	cfg := sdkmempool.DefaultPriorityNonceMempoolConfig()
+	cfg.MaxTx = maxTxs
+	cfg.SignerExtractor = NewSignerExtractionAdapter()
+
+	return &Lane{
+		Name:          name,
+		MaxBlockSpace: maxBlockSpace,
+		Match:         match,
+		mempool:       sdkmempool.NewPriorityMempool(cfg),
+	}
+}
+
+// NewLanes returns the lanes of a config in priority order
+// Lanes without block space are skipped, the default lane is always the last one
+func NewLanes(cfg Config) []*Lane {
+	lanes := make([]*Lane, 0, 4)
+	for _, lane := range []struct {
+		name       string
+		blockSpace uint64
+		match      MatchFn
+	}{
+		{LaneOracle, cfg.OracleBlockSpace, MatchOracleVote},
+		{LaneIBC, cfg.IBCBlockSpace, MatchIBCRelay},
+		{LaneEVM, cfg.EVMBlockSpace, kiiante.IsEthereumTx},
+	} {
+		if lane.blockSpace > 0 {
+			lanes = append(lanes, NewLane(lane.name, lane.blockSpace, lane.match, cfg.MaxTxs))
+		}
+	}
+
+	return append(lanes, NewLane(LaneDefault, cfg.DefaultBlockSpace, MatchAll, cfg.MaxTxs))
+}
+
+// CountTx returns the number of transactions on the lane
+func (l *Lane) CountTx() int {
+	return l.mempool.CountTx()
+}
+
+// MatchOracleVote matches the transactions with a single oracle vote, like the feeless votes
+func MatchOracleVote(tx sdk.Tx) bool {
+	_, ok := kiiante.GetOracleVote(tx)
+	return ok
+}
+
+// MatchIBCRelay matches the transactions made only of IBC relaying messages
+func MatchIBCRelay(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !IBCRelayMsgTypeURLs[sdk.MsgTypeURL(msg)] {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchAll matches every transaction
+func MatchAll(_ sdk.Tx) bool {
+	return true
+}
@@ -0,0 +1,104 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// LaneMempool is an app mempool split in lanes
+// A transaction is kept on the first lane it matches, so the last lane should match every transaction
+type LaneMempool struct {
+	lanes []*Lane
+}
+
+// Type assertion for the LaneMempool
+var _ sdkmempool.Mempool = (*LaneMempool)(nil)
+
+// NewLaneMempool creates a new LaneMempool with the lanes in priority order
+func NewLaneMempool(lanes ...*Lane) *LaneMempool {
+	return &LaneMempool{
+		lanes: lanes,
+	}
+}
+
+// Lanes returns the lanes in priority order
+func (m *LaneMempool) Lanes() []*Lane {
+	return m.lanes
+}
+
+// Insert adds a transaction to its lane
+func (m *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	lane := m.laneOf(tx)
+	if lane == nil {
+		return fmt.Errorf("no lane matches the transaction")
+	}
+	return lane.mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all the lanes in priority order
+func (m *LaneMempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	iterators := make([]sdkmempool.Iterator, len(m.lanes))
+	for i, lane := range m.lanes {
+		iterators[i] = lane.mempool.Select(ctx, txs)
+	}
+	return newChainIterator(iterators)
+}
+
+// CountTx returns the number of transactions on all the lanes
+func (m *LaneMempool) CountTx() int {
+	count := 0
+	for _, lane := range m.lanes {
+		count += lane.CountTx()
+	}
+	return count
+}
+
+// Remove removes a transaction from its lane
+func (m *LaneMempool) Remove(tx sdk.Tx) error {
+	lane := m.laneOf(tx)
+	if lane == nil {
+		return sdkmempool.ErrTxNotFound
+	}
+	return lane.mempool.Remove(tx)
+}
+
+// laneOf returns the first lane matching a transaction
+func (m *LaneMempool) laneOf(tx sdk.Tx) *Lane {
+	for _, lane := range m.lanes {
+		if lane.Match(tx) {
+			return lane
+		}
+	}
+	return nil
+}
+
+// chainIterator iterates over the lane iterators one after the other
+type chainIterator struct {
+	// iterators are the remaining iterators, the first one is never nil
+	iterators []sdkmempool.Iterator
+}
+
+// newChainIterator creates an iterator over the non empty iterators, nil if all are empty
+func newChainIterator(iterators []sdkmempool.Iterator) sdkmempool.Iterator {
+	for len(iterators) > 0 && iterators[0] == nil {
+		iterators = iterators[1:]
+	}
+	if len(iterators) == 0 {
+		return nil
+	}
+	return &chainIterator{iterators: iterators}
+}
+
+// Next returns the iterator of the next transaction
+func (it *chainIterator) Next() sdkmempool.Iterator {
+	iterators := append([]sdkmempool.Iterator{it.iterators[0].Next()}, it.iterators[1:]...)
+	return newChainIterator(iterators)
+}
+
+// Tx returns the current transaction
+func (it *chainIterator) Tx() sdk.Tx {
+	return it.iterators[0].Tx()
+}
@@ -0,0 +1,138 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	kiimempool "github.com/kiichain/kiichain/v3/app/mempool"
+	kiiparams "github.com/kiichain/kiichain/v3/app/params"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+)
+
+// testAccount is a signer of the test transactions
+type testAccount struct {
+	priv *secp256k1.PrivKey
+	addr sdk.AccAddress
+}
+
+// newTestAccount creates a new test account
+func newTestAccount() testAccount {
+	priv := secp256k1.GenPrivKey()
+	return testAccount{priv: priv, addr: sdk.AccAddress(priv.PubKey().Address())}
+}
+
+// newTestContext returns a context with the priority of a transaction
+func newTestContext(priority int64) sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithPriority(priority)
+}
+
+// buildTx builds a transaction signed by the account with the sequence and gas
+func buildTx(t *testing.T, signer testAccount, sequence, gas uint64, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+	txBuilder := kiiparams.MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(gas)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   signer.priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+	return txBuilder.GetTx()
+}
+
+// oracleVote returns an oracle vote message from the account
+func oracleVote(signer testAccount) sdk.Msg {
+	return &oracletypes.MsgAggregateExchangeRateVote{
+		ExchangeRates: "1.0uatom",
+		Feeder:        signer.addr.String(),
+		Validator:     sdk.ValAddress(signer.addr).String(),
+	}
+}
+
+// bankSend returns a bank send message from the account
+func bankSend(signer testAccount) sdk.Msg {
+	return banktypes.NewMsgSend(signer.addr, signer.addr, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1))))
+}
+
+// TestLaneMatching tests the lane of each transaction type
+func TestLaneMatching(t *testing.T) {
+	signer := newTestAccount()
+	updateClient := &clienttypes.MsgUpdateClient{Signer: signer.addr.String()}
+
+	require.True(t, kiimempool.MatchOracleVote(buildTx(t, signer, 0, 100, oracleVote(signer))))
+	require.False(t, kiimempool.MatchOracleVote(buildTx(t, signer, 0, 100, oracleVote(signer), oracleVote(signer))))
+	require.False(t, kiimempool.MatchOracleVote(buildTx(t, signer, 0, 100, bankSend(signer))))
+
+	require.True(t, kiimempool.MatchIBCRelay(buildTx(t, signer, 0, 100, updateClient)))
+	require.False(t, kiimempool.MatchIBCRelay(buildTx(t, signer, 0, 100, updateClient, bankSend(signer))))
+}
+
+// TestLaneMempool tests the transactions kept on each lane
+func TestLaneMempool(t *testing.T) {
+	cfg := kiimempool.DefaultConfig()
+	mp := kiimempool.NewLaneMempool(kiimempool.NewLanes(cfg)...)
+	lanes := mp.Lanes()
+	require.Len(t, lanes, 4)
+
+	signerA, signerB, signerC := newTestAccount(), newTestAccount(), newTestAccount()
+	sendTx := buildTx(t, signerA, 0, 100, bankSend(signerA))
+	voteTx := buildTx(t, signerB, 0, 100, oracleVote(signerB))
+	relayTx := buildTx(t, signerC, 0, 100, &clienttypes.MsgUpdateClient{Signer: signerC.addr.String()})
+
+	// The send has the highest priority but goes after the reserved lanes
+	require.NoError(t, mp.Insert(newTestContext(100), sendTx))
+	require.NoError(t, mp.Insert(newTestContext(1), voteTx))
+	require.NoError(t, mp.Insert(newTestContext(1), relayTx))
+	require.Equal(t, 3, mp.CountTx())
+	for i, count := range []int{1, 1, 0, 1} {
+		require.Equal(t, count, lanes[i].CountTx(), lanes[i].Name)
+	}
+
+	var selected []sdk.Tx
+	for it := mp.Select(newTestContext(0), nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	require.Equal(t, []sdk.Tx{voteTx, relayTx, sendTx}, selected)
+
+	// Txs are removed from their lane
+	require.NoError(t, mp.Remove(voteTx))
+	require.Zero(t, lanes[0].CountTx())
+	require.Equal(t, 2, mp.CountTx())
+
+	// Disabled lanes send their txs to the default lane
+	cfg.OracleBlockSpace = 0
+	mp = kiimempool.NewLaneMempool(kiimempool.NewLanes(cfg)...)
+	require.NoError(t, mp.Insert(newTestContext(1), voteTx))
+	require.Equal(t, kiimempool.LaneDefault, mp.Lanes()[2].Name)
+	require.Equal(t, 1, mp.Lanes()[2].CountTx())
+}
+
+// TestConfigValidate tests the validation of the lanes config
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, kiimempool.DefaultConfig().Validate())
+
+	cfg := kiimempool.DefaultConfig()
+	cfg.EVMBlockSpace = 101
+	require.ErrorContains(t, cfg.Validate(), "evm lane block space must be a percentage")
+
+	cfg = kiimempool.DefaultConfig()
+	cfg.DefaultBlockSpace = 0
+	require.ErrorContains(t, cfg.Validate(), "default lane block space must be positive")
+
+	cfg = kiimempool.DefaultConfig()
+	cfg.MaxTxs = -1
+	require.ErrorContains(t, cfg.Validate(), "max txs cannot be negative")
+}
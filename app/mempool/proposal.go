@@ -0,0 +1,153 @@
+package mempool
+
+import (
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// ProposalHandler builds the block proposals from the lanes of the mempool
+// Lanes are filled in priority order, each one up to its share of the block bytes
+// and gas, so the block space of the first lanes is reserved for their transactions
+type ProposalHandler struct {
+	mempool          *LaneMempool
+	txVerifier       baseapp.ProposalTxVerifier
+	signerExtAdapter sdkmempool.SignerExtractionAdapter
+}
+
+// NewProposalHandler creates a new ProposalHandler
+func NewProposalHandler(mempool *LaneMempool, txVerifier baseapp.ProposalTxVerifier) *ProposalHandler {
+	return &ProposalHandler{
+		mempool:          mempool,
+		txVerifier:       txVerifier,
+		signerExtAdapter: NewSignerExtractionAdapter(),
+	}
+}
+
+// PrepareProposalHandler returns the PrepareProposal handler selecting the transactions lane by lane
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		var maxBlockGas uint64
+		if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+			maxBlockGas = uint64(b.MaxGas)
+		}
+
+		proposal := newProposal(uint64(req.MaxTxBytes), maxBlockGas)
+		var invalidTxs []sdk.Tx
+		for _, lane := range h.mempool.Lanes() {
+			laneInvalidTxs, err := h.fillLane(ctx, req, lane, proposal)
+			if err != nil {
+				return nil, err
+			}
+			invalidTxs = append(invalidTxs, laneInvalidTxs...)
+		}
+
+		// Invalid txs are removed after the iteration to avoid a dead lock
+		for _, tx := range invalidTxs {
+			if err := h.mempool.Remove(tx); err != nil && !errors.Is(err, sdkmempool.ErrTxNotFound) {
+				return nil, err
+			}
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: proposal.txs}, nil
+	}
+}
+
+// fillLane adds the transactions of a lane to the proposal up to the lane block space
+// It returns the transactions that failed the verification
+func (h *ProposalHandler) fillLane(ctx sdk.Context, req *abci.RequestPrepareProposal, lane *Lane, p *proposal) ([]sdk.Tx, error) {
+	var (
+		resErr     error
+		invalidTxs []sdk.Tx
+		laneBytes  uint64
+		laneGas    uint64
+	)
+	maxLaneBytes := p.maxBytes * lane.MaxBlockSpace / 100
+	maxLaneGas := p.maxGas * lane.MaxBlockSpace / 100
+
+	sdkmempool.SelectBy(ctx, lane.mempool, req.Txs, func(memTx sdk.Tx) bool {
+		signers, err := h.signerExtAdapter.GetSigners(memTx)
+		if err != nil {
+			resErr = err
+			return false
+		}
+		// Txs out of sequence with the txs already selected are skipped
+		if !p.inSequence(signers) {
+			return true
+		}
+
+		txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			invalidTxs = append(invalidTxs, memTx)
+			return true
+		}
+
+		// Skip the txs not fitting on the lane or on the block
+		txSize := uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+		if laneBytes+txSize > maxLaneBytes || p.totalBytes+txSize > p.maxBytes {
+			return true
+		}
+		var txGas uint64
+		if feeTx, ok := memTx.(sdk.FeeTx); ok {
+			txGas = feeTx.GetGas()
+		}
+		if p.maxGas > 0 && (laneGas+txGas > maxLaneGas || p.totalGas+txGas > p.maxGas) {
+			return true
+		}
+
+		p.add(txBz, txSize, txGas, signers)
+		laneBytes += txSize
+		laneGas += txGas
+
+		// Stop once the lane is full
+		return laneBytes < maxLaneBytes && (p.maxGas == 0 || laneGas < maxLaneGas)
+	})
+
+	return invalidTxs, resErr
+}
+
+// proposal holds the transactions selected for a block
+type proposal struct {
+	txs        [][]byte
+	maxBytes   uint64
+	maxGas     uint64
+	totalBytes uint64
+	totalGas   uint64
+	// signerSeqs are the last sequences selected for each signer
+	signerSeqs map[string]uint64
+}
+
+// newProposal creates an empty proposal, a zero max gas disables the gas limit
+func newProposal(maxBytes, maxGas uint64) *proposal {
+	return &proposal{
+		maxBytes:   maxBytes,
+		maxGas:     maxGas,
+		signerSeqs: make(map[string]uint64),
+	}
+}
+
+// inSequence checks if the signers follow the sequences already selected
+func (p *proposal) inSequence(signers []sdkmempool.SignerData) bool {
+	for _, signer := range signers {
+		seq, ok := p.signerSeqs[signer.Signer.String()]
+		if ok && seq+1 != signer.Sequence {
+			return false
+		}
+	}
+	return true
+}
+
+// add adds a transaction to the proposal
+func (p *proposal) add(txBz []byte, txSize, txGas uint64, signers []sdkmempool.SignerData) {
+	p.txs = append(p.txs, txBz)
+	p.totalBytes += txSize
+	p.totalGas += txGas
+	for _, signer := range signers {
+		p.signerSeqs[signer.Signer.String()] = signer.Sequence
+	}
+}
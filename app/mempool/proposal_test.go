@@ -0,0 +1,130 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	kiimempool "github.com/kiichain/kiichain/v3/app/mempool"
+	kiiparams "github.com/kiichain/kiichain/v3/app/params"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+)
+
+// testTxVerifier accepts all the transactions except the rejected encoded ones
+type testTxVerifier struct {
+	txConfig kiiparams.EncodingConfig
+	rejected map[string]bool
+}
+
+func (v testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	txBz, err := v.TxEncode(tx)
+	if err != nil || v.rejected[string(txBz)] {
+		return nil, errortypes.ErrTxDecode
+	}
+	return txBz, nil
+}
+
+func (v testTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return v.TxDecode(txBz)
+}
+
+func (v testTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return v.txConfig.TxConfig.TxDecoder()(txBz)
+}
+
+func (v testTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.txConfig.TxConfig.TxEncoder()(tx)
+}
+
+// txSize returns the size of an encoded transaction on the block
+func txSize(t *testing.T, verifier testTxVerifier, tx sdk.Tx) int64 {
+	t.Helper()
+	txBz, err := verifier.TxEncode(tx)
+	require.NoError(t, err)
+	return cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})
+}
+
+// TestPrepareProposal tests the block space reserved for each lane
+func TestPrepareProposal(t *testing.T) {
+	encodingConfig := kiiparams.MakeEncodingConfig()
+	std.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	oracletypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	verifier := testTxVerifier{txConfig: encodingConfig, rejected: map[string]bool{}}
+	cfg := kiimempool.Config{OracleBlockSpace: 20, DefaultBlockSpace: 100}
+	mp := kiimempool.NewLaneMempool(kiimempool.NewLanes(cfg)...)
+	handler := kiimempool.NewProposalHandler(mp, verifier).PrepareProposalHandler()
+
+	// Ten high priority sends and three votes
+	var sendTxs, voteTxs []sdk.Tx
+	for i := 0; i < 10; i++ {
+		signer := newTestAccount()
+		sendTxs = append(sendTxs, buildTx(t, signer, 0, 100, bankSend(signer)))
+		require.NoError(t, mp.Insert(newTestContext(100), sendTxs[i]))
+	}
+	for i := 0; i < 3; i++ {
+		signer := newTestAccount()
+		voteTxs = append(voteTxs, buildTx(t, signer, 0, 100, oracleVote(signer)))
+		require.NoError(t, mp.Insert(newTestContext(1), voteTxs[i]))
+	}
+
+	// The oracle lane can use a fifth of the block, enough for a single vote
+	voteSize := txSize(t, verifier, voteTxs[0])
+	maxTxBytes := 5*voteSize + 1
+	require.Less(t, 2*txSize(t, verifier, sendTxs[0]), maxTxBytes-voteSize)
+
+	ctx := newTestContext(0).WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: -1}})
+	res, err := handler(ctx, &abci.RequestPrepareProposal{MaxTxBytes: maxTxBytes})
+	require.NoError(t, err)
+
+	// The votes come first, then the sends fill the remaining space
+	votes := 0
+	totalSize := int64(0)
+	for i, txBz := range res.Txs {
+		tx, err := verifier.TxDecode(txBz)
+		require.NoError(t, err)
+		isVote := kiimempool.MatchOracleVote(tx)
+		if isVote {
+			require.Equal(t, votes, i)
+			votes++
+		}
+		totalSize += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz})
+	}
+	require.Equal(t, 1, votes)
+	require.Greater(t, len(res.Txs), votes)
+	require.LessOrEqual(t, totalSize, maxTxBytes)
+
+	// The block gas is shared the same way
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 500}})
+	res, err = handler(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 5)
+	tx, err := verifier.TxDecode(res.Txs[0])
+	require.NoError(t, err)
+	require.True(t, kiimempool.MatchOracleVote(tx))
+	tx, err = verifier.TxDecode(res.Txs[1])
+	require.NoError(t, err)
+	require.False(t, kiimempool.MatchOracleVote(tx))
+
+	// Invalid txs are removed from the mempool
+	signer := newTestAccount()
+	rejectedTx := buildTx(t, signer, 0, 100, oracleVote(signer))
+	require.NoError(t, mp.Insert(newTestContext(10), rejectedTx))
+	rejectedBz, err := verifier.TxEncode(rejectedTx)
+	require.NoError(t, err)
+	verifier.rejected[string(rejectedBz)] = true
+
+	res, err = handler(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 5)
+	require.Equal(t, 3, mp.Lanes()[0].CountTx())
+}
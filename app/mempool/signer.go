@@ -0,0 +1,57 @@
+package mempool
+
+import (
+	"fmt"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	kiiante "github.com/kiichain/kiichain/v3/ante"
+)
+
+// SignerExtractionAdapter extracts the signers of Cosmos and EVM transactions
+// EVM transactions have no Cosmos signatures, the sender and nonce come from the ethereum transaction
+type SignerExtractionAdapter struct {
+	sdkmempool.DefaultSignerExtractionAdapter
+}
+
+// Type assertion for the SignerExtractionAdapter
+var _ sdkmempool.SignerExtractionAdapter = SignerExtractionAdapter{}
+
+// NewSignerExtractionAdapter creates a new SignerExtractionAdapter
+func NewSignerExtractionAdapter() SignerExtractionAdapter {
+	return SignerExtractionAdapter{}
+}
+
+// GetSigners returns the signers of a transaction with their sequences
+func (a SignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	if !kiiante.IsEthereumTx(tx) {
+		return a.DefaultSignerExtractionAdapter.GetSigners(tx)
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("evm transactions must have a single message, got %d", len(msgs))
+	}
+	ethMsg, txData, err := evmtypes.UnpackEthMsg(msgs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// The sender is set by the ante handler, otherwise it is recovered from the signature
+	sender := ethMsg.GetFrom()
+	if sender.Empty() {
+		signer := ethtypes.LatestSignerForChainID(evmtypes.GetEthChainConfig().ChainID)
+		from, err := signer.Sender(ethMsg.AsTransaction())
+		if err != nil {
+			return nil, err
+		}
+		sender = from.Bytes()
+	}
+
+	return []sdkmempool.SignerData{sdkmempool.NewSignerData(sender, txData.GetNonce())}, nil
+}
@@ -61,10 +61,20 @@ import (
 	"github.com/cosmos/evm/x/vm"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"github.com/kiichain/kiichain/v3/x/anteparams"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	"github.com/kiichain/kiichain/v3/x/feeabs"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+	"github.com/kiichain/kiichain/v3/x/feeless"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
 	"github.com/kiichain/kiichain/v3/x/oracle"
 	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	"github.com/kiichain/kiichain/v3/x/paymaster"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
 	"github.com/kiichain/kiichain/v3/x/rewards"
 	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+	"github.com/kiichain/kiichain/v3/x/smartaccount"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
 	"github.com/kiichain/kiichain/v3/x/tokenfactory"
 	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
@@ -121,6 +131,11 @@ func appModules(
 		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		rewards.NewAppModule(app.RewardsKeeper, app.BankKeeper),
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
+		feeless.NewAppModule(app.FeelessKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper),
+		anteparams.NewAppModule(app.AnteParamsKeeper),
+		paymaster.NewAppModule(app.PaymasterKeeper),
+		smartaccount.NewAppModule(app.SmartAccountKeeper),
 		sdkparams.NewAppModule(app.ParamsKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		wasm.NewAppModule(appCodec, &app.AppKeepers.WasmKeeper, app.AppKeepers.StakingKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
@@ -178,6 +193,9 @@ func simulationModules(
 		ibc.NewAppModule(app.IBCKeeper),
 		app.TransferModule,
 		app.ICAModule,
+		anteparams.NewAppModule(app.AnteParamsKeeper),
+		paymaster.NewAppModule(app.PaymasterKeeper),
+		smartaccount.NewAppModule(app.SmartAccountKeeper),
 	}
 }
 
@@ -220,6 +238,11 @@ func orderBeginBlockers() []string {
 		wasmtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		oracletypes.ModuleName,
+		feelesstypes.ModuleName,
+		feeabstypes.ModuleName,
+		anteparamstypes.ModuleName,
+		paymastertypes.ModuleName,
+		smartaccounttypes.ModuleName,
 	}
 }
 
@@ -257,6 +280,11 @@ func orderEndBlockers() []string {
 		wasmtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		oracletypes.ModuleName,
+		feelesstypes.ModuleName,
+		feeabstypes.ModuleName,
+		anteparamstypes.ModuleName,
+		paymastertypes.ModuleName,
+		smartaccounttypes.ModuleName,
 	}
 }
 
@@ -304,6 +332,11 @@ func orderInitBlockers() []string {
 		tokenfactorytypes.ModuleName,
 		oracletypes.ModuleName,
 		rewardstypes.ModuleName,
+		feelesstypes.ModuleName,
+		feeabstypes.ModuleName,
+		anteparamstypes.ModuleName,
+		paymastertypes.ModuleName,
+		smartaccounttypes.ModuleName,
 		// crisis needs to be last so that the genesis state is consistent
 		// when it checks invariants
 		crisistypes.ModuleName,
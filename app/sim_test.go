@@ -12,7 +12,6 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/log"
-	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -23,7 +22,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 
-	"github.com/kiichain/kiichain/v3/ante"
 	kiichain "github.com/kiichain/kiichain/v3/app"
 	"github.com/kiichain/kiichain/v3/app/sim"
 )
@@ -99,14 +97,9 @@ func TestAppStateDeterminism(t *testing.T) {
 				baseapp.SetChainID(AppChainID),
 			)
 
-			// NOTE: setting to zero to avoid failing the simulation
-			// due to the minimum staked tokens required to submit a vote
-			ante.SetMinStakedTokens(math.LegacyZeroDec())
-
-			// NOTE: setting to zero to avoid failing the simulation
-			// kiichain ante allows only certain proposals to be expedited - the simulation doesn't know about this
-			ante.SetExpeditedProposalsEnabled(false)
-
+			// NOTE: the ante checks failing the simulation, like the minimum staked tokens to vote,
+			// the expedited proposals whitelist and the minimum commission rate, are disabled
+			// on the anteparams simulation genesis
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
 				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
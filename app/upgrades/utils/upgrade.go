@@ -3,7 +3,10 @@ package utils
 import (
 	"github.com/ethereum/go-ethereum/common"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/kiichain/kiichain/v3/app/keepers"
 )
@@ -16,3 +19,64 @@ func InstallNewPrecompiles(ctx sdk.Context, keepers *keepers.AppKeepers, precomp
 	// Install the new address
 	return keepers.EVMKeeper.EnableStaticPrecompiles(ctx, precompiles...)
 }
+
+// BumpMinCommissionRate sets the anteparams minimum commission rate and raises the commission of the validators below it.
+// The max rate is raised too when needed, the max change rate and the 24h update limit are skipped
+func BumpMinCommissionRate(ctx sdk.Context, keepers *keepers.AppKeepers, minRate math.LegacyDec) error {
+	params, err := keepers.AnteParamsKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.MinCommissionRate = minRate
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := keepers.AnteParamsKeeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	// Log the upgrade
+	ctx.Logger().Info("Bumping validators commission rate...", "min_commission_rate", minRate)
+
+	validators, err := keepers.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if validator.Commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		valAddr, err := keepers.StakingKeeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+		if err := keepers.StakingKeeper.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		maxRate := math.LegacyMaxDec(validator.Commission.MaxRate, minRate)
+		validator.Commission = stakingtypes.NewCommissionWithTime(minRate, maxRate, validator.Commission.MaxChangeRate, ctx.BlockTime())
+		if err := keepers.StakingKeeper.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetMaxReleaseHistory enables the rewards release history, the params stored before the
+// history was added read a zero max release history and keep it disabled
+func SetMaxReleaseHistory(ctx sdk.Context, keepers *keepers.AppKeepers, maxReleaseHistory uint64) error {
+	params, err := keepers.RewardsKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Log the upgrade
+	ctx.Logger().Info("Setting the rewards max release history...", "max_release_history", maxReleaseHistory)
+
+	params.MaxReleaseHistory = maxReleaseHistory
+	return keepers.RewardsKeeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,37 @@
+package v310
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/kiichain/kiichain/v3/app/upgrades"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+	feeabstypes "github.com/kiichain/kiichain/v3/x/feeabs/types"
+	feelesstypes "github.com/kiichain/kiichain/v3/x/feeless/types"
+	paymastertypes "github.com/kiichain/kiichain/v3/x/paymaster/types"
+	smartaccounttypes "github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+const (
+	// UpgradeName is the name of the upgrade
+	UpgradeName = "v3.1.0"
+)
+
+// MinCommissionRate is the minimum validator commission rate set by the upgrade, 5%
+// A floor stops validators from competing for delegations with a zero commission, which the smaller
+// validators can't sustain. 5% matches the floor of the Cosmos Hub and keeps the raise small for the
+// validators bumped by the upgrade. It's set here so a later change of the anteparams
+// default doesn't change what the upgrade applies on chain
+var MinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+
+// Upgrade defines the upgrade
+// This adds the rewards and tokenfactory precompiles into the precompiles list for the EVM module
+// and the feeless, fee abstraction, anteparams, paymaster and smartaccount module stores.
+// The validators below the minimum commission rate are bumped to it
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{feelesstypes.StoreKey, feeabstypes.StoreKey, anteparamstypes.StoreKey, paymastertypes.StoreKey, smartaccounttypes.StoreKey},
+	},
+}
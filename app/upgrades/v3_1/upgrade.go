@@ -0,0 +1,66 @@
+package v310
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/kiichain/kiichain/v3/app/keepers"
+	"github.com/kiichain/kiichain/v3/app/upgrades/utils"
+	"github.com/kiichain/kiichain/v3/precompiles/rewards"
+	"github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// CreateUpgradeHandler creates the upgrade handler for the v3.1.0 upgrade
+// This install the rewards and tokenfactory precompiles into the precompiles list for the EVM module
+// enables the rewards release history and raises the commission of the validators below the minimum commission rate
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// State the context and log
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting module migrations...")
+
+		// Run the module migrations
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// Install the new precompiles
+		err = utils.InstallNewPrecompiles(
+			ctx,
+			keepers,
+			[]common.Address{
+				common.HexToAddress(rewards.RewardsPrecompileAddress),
+				common.HexToAddress(tokenfactory.TokenFactoryPrecompileAddress),
+			},
+		)
+		if err != nil {
+			return vm, err
+		}
+
+		// Enable the rewards release history, used to estimate the block time
+		if err := utils.SetMaxReleaseHistory(ctx, keepers, rewardstypes.DefaultMaxReleaseHistory); err != nil {
+			return vm, err
+		}
+
+		// Set the min commission rate and bump the validators below it
+		if err := utils.BumpMinCommissionRate(ctx, keepers, MinCommissionRate); err != nil {
+			return vm, err
+		}
+
+		// Log the upgrade completion
+		ctx.Logger().Info("Upgrade v3.1.0 complete")
+		return vm, nil
+	}
+}
@@ -0,0 +1,130 @@
+package v310_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	utils "github.com/kiichain/kiichain/v3/app/upgrades/utils"
+	"github.com/kiichain/kiichain/v3/precompiles/rewards"
+	"github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// TestUpgrade tests the upgrade handler for v3.1.0
+func TestUpgrade(t *testing.T) {
+	// Create the app and the context
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Create a pre-populated list of pre-compiles
+	precompiles := []string{
+		"0x0000000000000000000000000000000000000001",
+		"0x0000000000000000000000000000000000000002",
+	}
+
+	// Install the precompiles
+	evmParams := app.EVMKeeper.GetParams(ctx)
+	evmParams.ActiveStaticPrecompiles = precompiles
+	err := app.EVMKeeper.SetParams(ctx, evmParams)
+	require.NoError(t, err)
+
+	// Now install the rewards and tokenfactory precompiles
+	err = utils.InstallNewPrecompiles(
+		ctx,
+		&app.AppKeepers,
+		[]common.Address{
+			common.HexToAddress(rewards.RewardsPrecompileAddress),
+			common.HexToAddress(tokenfactory.TokenFactoryPrecompileAddress),
+		},
+	)
+	require.NoError(t, err)
+
+	// Get the params again
+	evmParams = app.EVMKeeper.GetParams(ctx)
+
+	// Check that the precompiles was added
+	require.Len(t, evmParams.ActiveStaticPrecompiles, 4)
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, "0x0000000000000000000000000000000000000001")
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, "0x0000000000000000000000000000000000000002")
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, rewards.RewardsPrecompileAddress)
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, tokenfactory.TokenFactoryPrecompileAddress)
+}
+
+// TestBumpMinCommissionRate tests the commission bump of the validators below the minimum
+func TestBumpMinCommissionRate(t *testing.T) {
+	// Create the app and the context
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Use a rate apart from the anteparams default, the upgrade must not depend on it
+	minRate := math.LegacyNewDecWithPrec(7, 2)
+
+	// The genesis validator is below the minimum, raise its max change rate to check it's kept
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.True(t, validators[0].Commission.Rate.LT(minRate))
+	validators[0].Commission.MaxChangeRate = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validators[0]))
+
+	// Set a second validator above the minimum
+	validator := validators[0]
+	validator.OperatorAddress = sdk.ValAddress("validator___________").String()
+	validator.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(10, 2), math.LegacyNewDecWithPrec(20, 2), math.LegacyNewDecWithPrec(1, 2))
+	require.NoError(t, app.StakingKeeper.SetValidator(ctx, validator))
+
+	err = utils.BumpMinCommissionRate(ctx, &app.AppKeepers, minRate)
+	require.NoError(t, err)
+
+	// The min commission rate is stored on the anteparams
+	params, err := app.AnteParamsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, minRate, params.MinCommissionRate)
+
+	// The validator below the minimum is bumped, including its max rate
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(t, err)
+	bumped, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, minRate, bumped.Commission.Rate)
+	require.Equal(t, minRate, bumped.Commission.MaxRate)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 2), bumped.Commission.MaxChangeRate)
+	require.Equal(t, ctx.BlockTime(), bumped.Commission.UpdateTime)
+
+	// The validator above the minimum is kept
+	kept, err := app.StakingKeeper.GetValidator(ctx, sdk.ValAddress("validator___________"))
+	require.NoError(t, err)
+	require.Equal(t, validator.Commission, kept.Commission)
+}
+
+// TestSetMaxReleaseHistory tests that the upgrade enables the rewards release history
+func TestSetMaxReleaseHistory(t *testing.T) {
+	// Create the app and the context
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Params stored before the upgrade have no max release history
+	params, err := app.RewardsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxReleaseHistory = 0
+	require.NoError(t, app.RewardsKeeper.Params.Set(ctx, params))
+
+	err = utils.SetMaxReleaseHistory(ctx, &app.AppKeepers, rewardstypes.DefaultMaxReleaseHistory)
+	require.NoError(t, err)
+
+	// The history is enabled and the other params are kept
+	updated, err := app.RewardsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rewardstypes.DefaultMaxReleaseHistory, updated.MaxReleaseHistory)
+	require.Equal(t, params.TokenDenom, updated.TokenDenom)
+}
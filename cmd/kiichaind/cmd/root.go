@@ -63,6 +63,7 @@ import (
 	srvflags "github.com/cosmos/evm/server/flags"
 
 	kiichain "github.com/kiichain/kiichain/v3/app"
+	kiimempool "github.com/kiichain/kiichain/v3/app/mempool"
 )
 
 // CustomAppConfig generates a new custom config
@@ -76,6 +77,9 @@ type CustomAppConfig struct {
 
 	// wasm config
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+
+	// Lanes config
+	Lanes kiimempool.Config `mapstructure:"lanes"`
 }
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -209,6 +213,7 @@ func initAppConfig() (string, interface{}) {
 		JSONRPC: *evmserverconfig.DefaultJSONRPCConfig(),
 		TLS:     *evmserverconfig.DefaultTLSConfig(),
 		Wasm:    wasmtypes.DefaultWasmConfig(),
+		Lanes:   kiimempool.DefaultConfig(),
 	}
 
 	// Default template
@@ -217,6 +222,9 @@ func initAppConfig() (string, interface{}) {
 	// EVM template
 	defaultAppTemplate += evmserverconfig.DefaultEVMConfigTemplate
 
+	// Lanes template
+	defaultAppTemplate += kiimempool.DefaultConfigTemplate
+
 	return defaultAppTemplate, customAppConfig
 }
 
@@ -0,0 +1,55 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev IRewards contract address
+address constant REWARDS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001004;
+
+/// @author Kiichain Team
+/// @title Rewards Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the Rewards module
+/// @custom:address 0x0000000000000000000000000000000000001004
+interface IRewards {
+    /// @dev Emitted when the reward pool is funded through the precompile
+    /// @param sender The address that funded the pool
+    /// @param denom The denomination sent to the pool
+    /// @param amount The amount sent to the pool
+    event FundPool(address indexed sender, string denom, uint256 amount);
+
+    /// @dev Fund the reward pool with the rewards token denomination
+    /// @param amount The amount of the rewards token to send to the pool
+    /// @return success True if the pool was funded
+    function fundPool(uint256 amount) external returns (bool success);
+
+    /// @dev Get the current release schedule
+    /// @return denom The denomination being released
+    /// @return totalAmount The total amount to be released by the schedule
+    /// @return releasedAmount The amount already released by the schedule
+    /// @return endTime The unix timestamp when the release ends, zero for height based schedules
+    /// @return lastReleaseTime The unix timestamp of the last release, zero for height based schedules
+    /// @return endHeight The block height when the release ends, zero for time based schedules
+    /// @return lastReleaseHeight The block height of the last release, zero for time based schedules
+    /// @return active True if the schedule is currently releasing
+    /// @return paused True if the schedule is paused
+    function getReleaseSchedule()
+        external
+        view
+        returns (
+            string memory denom,
+            uint256 totalAmount,
+            uint256 releasedAmount,
+            int64 endTime,
+            int64 lastReleaseTime,
+            int64 endHeight,
+            int64 lastReleaseHeight,
+            bool active,
+            bool paused
+        );
+
+    /// @dev Get the coins held by the reward pool
+    /// @return denoms An array of denominations held by the pool
+    /// @return amounts An array of decimal amounts corresponding to the denominations
+    function getRewardPool()
+        external
+        view
+        returns (string[] memory denoms, string[] memory amounts);
+}
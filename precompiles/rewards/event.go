@@ -0,0 +1,63 @@
+package rewards
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const (
+	// EventTypeFundPool defines the event when the pool is funded via contract
+	EventTypeFundPool = "FundPool"
+)
+
+// FundPoolEvent represents the solidity event that is logged
+type FundPoolEvent struct {
+	Sender common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// EmitEventFundPool emits the FundPool event
+func (p *Precompile) EmitEventFundPool(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	sender common.Address,
+	denom string,
+	amount *big.Int,
+) (err error) {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeFundPool]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is the signature of the event
+	topics[0] = event.ID
+
+	// The second topic is the sender address
+	topics[1], err = cmn.MakeTopic(sender)
+	if err != nil {
+		return err
+	}
+
+	// Parse the data
+	dataField, err := event.Inputs.NonIndexed().Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	// Write to the stateDB
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        dataField,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
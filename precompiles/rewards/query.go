@@ -0,0 +1,102 @@
+package rewards
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
+)
+
+const (
+	// GetReleaseScheduleMethod is the method name for the release schedule query
+	GetReleaseScheduleMethod = "getReleaseSchedule"
+	// GetRewardPoolMethod is the method name for the reward pool query
+	GetRewardPoolMethod = "getRewardPool"
+)
+
+// GetReleaseSchedule queries the release schedule through the IRewards precompile
+func (p Precompile) GetReleaseSchedule(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetReleaseScheduleArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.ReleaseSchedule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	schedule := res.ReleaseSchedule
+
+	// Unset coins are returned as zero amounts
+	totalAmount, releasedAmount := big.NewInt(0), big.NewInt(0)
+	if !schedule.TotalAmount.Amount.IsNil() {
+		totalAmount = schedule.TotalAmount.Amount.BigInt()
+	}
+	if !schedule.ReleasedAmount.Amount.IsNil() {
+		releasedAmount = schedule.ReleasedAmount.Amount.BigInt()
+	}
+
+	// Zero times are returned as zero timestamps
+	var endTime, lastReleaseTime int64
+	if !schedule.EndTime.IsZero() {
+		endTime = schedule.EndTime.Unix()
+	}
+	if !schedule.LastReleaseTime.IsZero() {
+		lastReleaseTime = schedule.LastReleaseTime.Unix()
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(
+		schedule.TotalAmount.Denom,
+		totalAmount,
+		releasedAmount,
+		endTime,
+		lastReleaseTime,
+		schedule.EndHeight,
+		schedule.LastReleaseHeight,
+		schedule.Active,
+		schedule.IsPaused(),
+	)
+}
+
+// GetRewardPool queries the reward pool through the IRewards precompile
+func (p Precompile) GetRewardPool(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetRewardPoolArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.RewardPool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	denoms := make([]string, len(res.RewardPool.CommunityPool))
+	amounts := make([]string, len(res.RewardPool.CommunityPool))
+
+	// Iterate over the pool coins and fill the slices
+	for i, coin := range res.RewardPool.CommunityPool {
+		denoms[i] = coin.Denom
+		amounts[i] = coin.Amount.String()
+	}
+
+	// Return the packed response
+	return method.Outputs.Pack(
+		denoms,
+		amounts,
+	)
+}
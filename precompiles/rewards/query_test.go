@@ -0,0 +1,128 @@
+package rewards_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsprecompile "github.com/kiichain/kiichain/v3/precompiles/rewards"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// TestGetReleaseSchedule tests the GetReleaseSchedule method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetReleaseSchedule() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetReleaseScheduleMethod]
+
+	// Store a schedule for testing
+	endTime := time.Unix(2_000_000_000, 0).UTC()
+	lastReleaseTime := time.Unix(1_900_000_000, 0).UTC()
+	err := s.App.RewardsKeeper.ReleaseSchedule.Set(s.Ctx, rewardstypes.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(100)),
+		EndTime:         endTime,
+		LastReleaseTime: lastReleaseTime,
+		Active:          true,
+	})
+	s.Require().NoError(err)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get release schedule",
+			args: []any{},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{"extra"},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetReleaseSchedule(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(rewardsprecompile.GetReleaseScheduleMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response
+				s.Require().Len(resUnpacked, 9)
+				s.Require().Equal("akii", resUnpacked[0])
+				s.Require().Equal(big.NewInt(1000), resUnpacked[1])
+				s.Require().Equal(big.NewInt(100), resUnpacked[2])
+				s.Require().Equal(endTime.Unix(), resUnpacked[3])
+				s.Require().Equal(lastReleaseTime.Unix(), resUnpacked[4])
+				s.Require().Equal(int64(0), resUnpacked[5])
+				s.Require().Equal(int64(0), resUnpacked[6])
+				s.Require().Equal(true, resUnpacked[7])
+				s.Require().Equal(false, resUnpacked[8])
+			}
+		})
+	}
+}
+
+// TestGetRewardPool tests the GetRewardPool method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetRewardPool() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetRewardPoolMethod]
+
+	// Store a pool for testing
+	err := s.App.RewardsKeeper.RewardPool.Set(s.Ctx, rewardstypes.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin("akii", math.NewInt(500))),
+	})
+	s.Require().NoError(err)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get reward pool",
+			args: []any{},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{"extra"},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetRewardPool(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(rewardsprecompile.GetRewardPoolMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response
+				s.Require().Len(resUnpacked, 2)
+				s.Require().Equal([]string{"akii"}, resUnpacked[0])
+				s.Require().Equal([]string{"500.000000000000000000"}, resUnpacked[1])
+			}
+		})
+	}
+}
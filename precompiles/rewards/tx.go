@@ -0,0 +1,77 @@
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
+)
+
+const (
+	// FundPoolMethod is the method name for funding the reward pool
+	FundPoolMethod = "fundPool"
+)
+
+// FundPool sends funds from the contract caller to the reward pool through the IRewards precompile
+func (p *Precompile) FundPool(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// The funds are taken from the direct caller, which is a contract when called from one
+	caller := contract.Caller()
+
+	// The pool is always funded with the rewards token denom
+	params, err := p.rewardsKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build and validate the msg
+	msg, err := NewMsgFundPool(args, caller, params.TokenDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, amount: %s }", msg.Sender, msg.Amount),
+	)
+
+	// Fund the pool
+	msgServer := rewardskeeper.NewMsgServerImpl(p.rewardsKeeper)
+	if _, err = msgServer.FundPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin && msg.Amount.Denom == evmtypes.GetEVMCoinDenom() {
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(msg.Amount.Amount.BigInt())
+		// check if converted amount is greater than zero
+		if convertedAmount.Cmp(common.Big0) == 1 {
+			p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(caller, convertedAmount, cmn.Sub))
+		}
+	}
+
+	// Emit the event
+	if err = p.EmitEventFundPool(ctx, stateDB, caller, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,126 @@
+package rewards_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	rewardsprecompile "github.com/kiichain/kiichain/v3/precompiles/rewards"
+)
+
+// TestFundPool tests the FundPool method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestFundPool() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.FundPoolMethod]
+
+	// Get an account from the keyring
+	sender := s.keyring.GetKey(0)
+
+	tc := []struct {
+		name        string
+		args        []any
+		fund        bool
+		errContains string
+	}{
+		{
+			name: "valid execute",
+			args: []any{big.NewInt(1000)},
+			fund: true,
+		},
+		{
+			name:        "invalid args length",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid amount - wrong type",
+			args:        []any{"1000"},
+			errContains: "invalid amount",
+		},
+		{
+			name:        "invalid amount - zero",
+			args:        []any{big.NewInt(0)},
+			errContains: "invalid amount",
+		},
+		{
+			name:        "insufficient funds",
+			args:        []any{big.NewInt(1000)},
+			errContains: "insufficient funds",
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Get the rewards denom
+			params, err := s.App.RewardsKeeper.Params.Get(s.Ctx)
+			s.Require().NoError(err)
+
+			// Fund the sender if needed
+			if tc.fund {
+				coins := sdk.NewCoins(sdk.NewCoin(params.TokenDenom, math.NewInt(1000)))
+				err := s.App.BankKeeper.MintCoins(s.Ctx, evmtypes.ModuleName, coins)
+				s.Require().NoError(err)
+				err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, evmtypes.ModuleName, sender.AccAddr, coins)
+				s.Require().NoError(err)
+			}
+
+			// Get the pool before the call
+			poolBefore, err := s.App.RewardsKeeper.RewardPool.Get(s.Ctx)
+			s.Require().NoError(err)
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, sender.Addr, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.FundPool(ctx, sender.Addr, contract, stateDB, &method, tc.args)
+
+			// Check if the error contains the expected string
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(rewardsprecompile.FundPoolMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The pool must have received the funds
+			poolAfter, err := s.App.RewardsKeeper.RewardPool.Get(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(
+				poolBefore.CommunityPool.AmountOf(params.TokenDenom).Add(math.LegacyNewDec(1000)),
+				poolAfter.CommunityPool.AmountOf(params.TokenDenom),
+			)
+
+			// Check if events were emitted
+			log := stateDB.Logs()[0]
+			event := s.Precompile.ABI.Events[rewardsprecompile.EventTypeFundPool]
+			s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+			s.Require().Equal(log.BlockNumber, uint64(ctx.BlockHeight()))
+
+			// Decode the event data and check
+			var fundPoolEvent rewardsprecompile.FundPoolEvent
+			err = cmn.UnpackLog(s.Precompile.ABI, &fundPoolEvent, rewardsprecompile.EventTypeFundPool, *log)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.Addr, fundPoolEvent.Sender)
+			s.Require().Equal(params.TokenDenom, fundPoolEvent.Denom)
+			s.Require().Equal(big.NewInt(1000), fundPoolEvent.Amount)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// NewMsgFundPool builds a MsgFundPool from the arguments, the caller is the sender
+// and the denom is always the rewards token denom
+func NewMsgFundPool(args []interface{}, caller common.Address, denom string) (*rewardstypes.MsgFundPool, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the amount
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid amount")
+	}
+
+	// Create the MsgFundPool and return
+	return &rewardstypes.MsgFundPool{
+		Sender: sdk.AccAddress(caller.Bytes()).String(),
+		Amount: sdk.NewCoin(denom, math.NewIntFromBigInt(amount)),
+	}, nil
+}
+
+// ParseGetReleaseScheduleArgs parses the arguments for the GetReleaseSchedule method
+func ParseGetReleaseScheduleArgs(args []interface{}) (*rewardstypes.QueryReleaseScheduleRequest, error) {
+	// Check the number of arguments, should be 0
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	// Create the QueryReleaseScheduleRequest and return
+	return &rewardstypes.QueryReleaseScheduleRequest{}, nil
+}
+
+// ParseGetRewardPoolArgs parses the arguments for the GetRewardPool method
+func ParseGetRewardPoolArgs(args []interface{}) (*rewardstypes.QueryRewardPoolRequest, error) {
+	// Check the number of arguments, should be 0
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	// Create the QueryRewardPoolRequest and return
+	return &rewardstypes.QueryRewardPoolRequest{}, nil
+}
@@ -0,0 +1,70 @@
+package staking_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	app "github.com/kiichain/kiichain/v3/app"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	stakingprecompile "github.com/kiichain/kiichain/v3/precompiles/staking"
+)
+
+// StakingPrecompileTestSuite is a test suite for the staking precompile
+type StakingPrecompileTestSuite struct {
+	suite.Suite
+
+	// App and context
+	App     *app.KiichainApp
+	Ctx     sdk.Context
+	keyring testkeyring.Keyring
+
+	// Precompile
+	Precompile *stakingprecompile.Precompile
+}
+
+// TestStakingPrecompileTestSuite runs all the tests under the staking pre-compile test suite
+func TestStakingPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(StakingPrecompileTestSuite))
+}
+
+// SetupTest sets up each test with a fresh app
+func (s *StakingPrecompileTestSuite) SetupTest() {
+	// Get the test context
+	t := s.T()
+
+	// Create the app and the context
+	s.App = helpers.Setup(t)
+	s.Ctx = s.App.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Start a new keyring
+	keyring := testkeyring.New(2)
+	s.keyring = keyring
+
+	// Start the precompile
+	pc, err := stakingprecompile.NewPrecompile(*s.App.StakingKeeper, s.App.AuthzKeeper, s.App.AnteParamsKeeper)
+	s.Require().NoError(err)
+	s.Precompile = pc
+}
+
+// GetStateDB returns the state database for the precompile
+func (s *StakingPrecompileTestSuite) GetStateDB() *statedb.StateDB {
+	// Get the header hash
+	headerHash := s.Ctx.HeaderHash()
+
+	// Return the statedb
+	return statedb.New(
+		s.Ctx,
+		s.App.EVMKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(headerHash)),
+	)
+}
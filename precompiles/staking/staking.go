@@ -0,0 +1,111 @@
+package staking
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	anteparamskeeper "github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	anteparamstypes "github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// Precompile implements the PrecompiledContract interface
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Precompile wraps the upstream staking precompile to enforce the minimum commission rate
+// The ante handler only sees cosmos transactions, so validators created or edited from the EVM are checked here
+type Precompile struct {
+	*stakingprecompile.Precompile
+	stakingKeeper    stakingkeeper.Keeper
+	anteParamsKeeper anteparamskeeper.Keeper
+}
+
+// NewPrecompile creates a new staking precompile instance
+func NewPrecompile(
+	stakingKeeper stakingkeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	anteParamsKeeper anteparamskeeper.Keeper,
+) (*Precompile, error) {
+	// Initialize the upstream precompile
+	precompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Precompile{
+		Precompile:       precompile,
+		stakingKeeper:    stakingKeeper,
+		anteParamsKeeper: anteParamsKeeper,
+	}, nil
+}
+
+// Run checks the commission of the validator messages and executes the upstream precompile
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	if err := p.validateCommission(evm, contract); err != nil {
+		return nil, err
+	}
+
+	return p.Precompile.Run(evm, contract, readOnly)
+}
+
+// validateCommission rejects the createValidator and editValidator calls with a commission below the minimum
+// Other methods and malformed inputs are left to the upstream precompile
+func (p Precompile) validateCommission(evm *vm.EVM, contract *vm.Contract) error {
+	if len(contract.Input) < 4 {
+		return nil
+	}
+
+	method, err := p.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil
+	}
+	if method.Name != stakingprecompile.CreateValidatorMethod && method.Name != stakingprecompile.EditValidatorMethod {
+		return nil
+	}
+
+	stateDB, ok := evm.StateDB.(*statedb.StateDB)
+	if !ok {
+		return errors.New(cmn.ErrNotRunInEvm)
+	}
+	ctx, err := stateDB.GetCacheContext()
+	if err != nil {
+		return err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil
+	}
+
+	var msg sdk.Msg
+	switch method.Name {
+	case stakingprecompile.CreateValidatorMethod:
+		bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return err
+		}
+		msg, _, err = stakingprecompile.NewMsgCreateValidator(args, bondDenom)
+		if err != nil {
+			return nil
+		}
+	case stakingprecompile.EditValidatorMethod:
+		msg, _, err = stakingprecompile.NewMsgEditValidator(args)
+		if err != nil {
+			return nil
+		}
+	}
+
+	params, err := p.anteParamsKeeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return anteparamstypes.ValidateMinCommission(msg, params.MinCommissionRate)
+}
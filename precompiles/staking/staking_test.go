@@ -0,0 +1,135 @@
+package staking_test
+
+import (
+	"encoding/base64"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// TestMinCommission tests that the staking precompile enforces the minimum commission rate
+func (s *StakingPrecompileTestSuite) TestMinCommission() {
+	// Get an account from the keyring
+	sender := s.keyring.GetKey(0)
+
+	// Commission rates are passed with 18 decimals
+	rate := func(r math.LegacyDec) *big.Int {
+		return r.BigInt()
+	}
+	description := stakingprecompile.Description{Moniker: "validator"}
+	pubKey := base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes())
+	stake := big.NewInt(1_000_000)
+
+	tc := []struct {
+		name        string
+		method      string
+		args        []any
+		errContains string
+		expErr      error
+	}{
+		{
+			name:   "create validator below the minimum",
+			method: stakingprecompile.CreateValidatorMethod,
+			args: []any{
+				description,
+				stakingprecompile.Commission{
+					Rate:          rate(math.LegacyNewDecWithPrec(1, 2)),
+					MaxRate:       rate(math.LegacyNewDecWithPrec(1, 2)),
+					MaxChangeRate: rate(math.LegacyNewDecWithPrec(1, 2)),
+				},
+				big.NewInt(1), sender.Addr, pubKey, stake,
+			},
+			expErr: xerrors.ErrCommissionTooLow,
+		},
+		{
+			name:   "create validator at the minimum",
+			method: stakingprecompile.CreateValidatorMethod,
+			args: []any{
+				description,
+				stakingprecompile.Commission{
+					Rate:          rate(math.LegacyNewDecWithPrec(5, 2)),
+					MaxRate:       rate(math.LegacyNewDecWithPrec(5, 2)),
+					MaxChangeRate: rate(math.LegacyNewDecWithPrec(5, 2)),
+				},
+				big.NewInt(1), sender.Addr, pubKey, stake,
+			},
+		},
+		{
+			name:   "edit validator below the minimum",
+			method: stakingprecompile.EditValidatorMethod,
+			args: []any{
+				description, sender.Addr, rate(math.LegacyNewDecWithPrec(1, 2)), big.NewInt(stakingprecompile.DoNotModifyMinSelfDelegation),
+			},
+			expErr: xerrors.ErrCommissionTooLow,
+		},
+		{
+			name:   "unchanged commission is left to the upstream precompile",
+			method: stakingprecompile.EditValidatorMethod,
+			args: []any{
+				description, sender.Addr, big.NewInt(stakingprecompile.DoNotModifyCommissionRate), big.NewInt(stakingprecompile.DoNotModifyMinSelfDelegation),
+			},
+			errContains: "does not exist",
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Fund the sender with the bond denom
+			bondDenom, err := s.App.StakingKeeper.BondDenom(s.Ctx)
+			s.Require().NoError(err)
+			coins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewIntFromBigInt(stake)))
+			err = s.App.BankKeeper.MintCoins(s.Ctx, evmtypes.ModuleName, coins)
+			s.Require().NoError(err)
+			err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, evmtypes.ModuleName, sender.AccAddr, coins)
+			s.Require().NoError(err)
+
+			// Pack the call
+			input, err := s.Precompile.Pack(tc.method, tc.args...)
+			s.Require().NoError(err)
+
+			// Create the contract and the evm
+			stateDB := s.GetStateDB()
+			contract, _ := testutil.NewPrecompileContract(s.T(), s.Ctx, sender.Addr, s.Precompile, 1_000_000)
+			contract.Input = input
+			evm := vm.NewEVM(
+				vm.BlockContext{BlockNumber: big.NewInt(s.Ctx.BlockHeight())},
+				vm.TxContext{Origin: sender.Addr},
+				stateDB,
+				params.TestChainConfig,
+				vm.Config{},
+			)
+
+			// Run the precompile
+			_, err = s.Precompile.Run(evm, contract, false)
+			switch {
+			case tc.expErr != nil:
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			case tc.errContains != "":
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The validator must have been created
+			s.Require().NoError(stateDB.Commit())
+			validator, err := s.App.StakingKeeper.GetValidator(s.Ctx, sdk.ValAddress(sender.AccAddr))
+			s.Require().NoError(err)
+			s.Require().Equal(math.LegacyNewDecWithPrec(5, 2), validator.Commission.Rate)
+		})
+	}
+}
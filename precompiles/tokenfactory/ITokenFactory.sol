@@ -0,0 +1,105 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev ITokenFactory contract address
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001005;
+
+/// @author Kiichain Team
+/// @title TokenFactory Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the TokenFactory module
+/// @custom:address 0x0000000000000000000000000000000000001005
+interface ITokenFactory {
+    /// @dev Emitted when a new denom is created through the precompile
+    /// @param creator The address that created the denom
+    /// @param denom The full denomination created
+    event CreateDenom(address indexed creator, string denom);
+
+    /// @dev Emitted when tokens are minted through the precompile
+    /// @param sender The admin that minted the tokens
+    /// @param to The address that received the tokens
+    /// @param denom The denomination minted
+    /// @param amount The amount minted
+    event Mint(address indexed sender, address indexed to, string denom, uint256 amount);
+
+    /// @dev Emitted when tokens are burned through the precompile
+    /// @param sender The admin that burned the tokens
+    /// @param from The address the tokens were burned from
+    /// @param denom The denomination burned
+    /// @param amount The amount burned
+    event Burn(address indexed sender, address indexed from, string denom, uint256 amount);
+
+    /// @dev Emitted when the admin of a denom is changed through the precompile
+    /// @param sender The previous admin
+    /// @param newAdmin The new admin
+    /// @param denom The denomination changed
+    event ChangeAdmin(address indexed sender, address indexed newAdmin, string denom);
+
+    /// @dev Emitted when the metadata of a denom is set through the precompile
+    /// @param sender The admin that set the metadata
+    /// @param denom The denomination changed
+    event SetMetadata(address indexed sender, string denom);
+
+    /// @dev Emitted when tokens are force transferred through the precompile
+    /// @param sender The admin that forced the transfer
+    /// @param from The address the tokens were taken from
+    /// @param to The address that received the tokens
+    /// @param denom The denomination transferred
+    /// @param amount The amount transferred
+    event ForceTransfer(address indexed sender, address indexed from, address indexed to, string denom, uint256 amount);
+
+    /// @dev Create a new denom with the caller as creator and admin, the denom creation fee is charged to the caller
+    /// @param subdenom The subdenom, the full denom is factory/{caller}/{subdenom}
+    /// @return denom The full denomination created
+    function createDenom(string memory subdenom) external returns (string memory denom);
+
+    /// @dev Mint tokens of a denom the caller is admin of
+    /// @param denom The full denomination to mint
+    /// @param amount The amount to mint
+    /// @param to The address receiving the tokens
+    /// @return success True if the tokens were minted
+    function mint(string memory denom, uint256 amount, address to) external returns (bool success);
+
+    /// @dev Burn tokens of a denom the caller is admin of
+    /// @param denom The full denomination to burn
+    /// @param amount The amount to burn
+    /// @param from The address the tokens are burned from
+    /// @return success True if the tokens were burned
+    function burn(string memory denom, uint256 amount, address from) external returns (bool success);
+
+    /// @dev Change the admin of a denom the caller is admin of
+    /// @param denom The full denomination
+    /// @param newAdmin The new admin of the denom
+    /// @return success True if the admin was changed
+    function changeAdmin(string memory denom, address newAdmin) external returns (bool success);
+
+    /// @dev Set the bank metadata of a denom the caller is admin of
+    /// @param denom The full denomination
+    /// @param symbol The ticker symbol, also used as the display denomination
+    /// @param description The description of the token
+    /// @param exponent The exponent of the display denomination
+    /// @return success True if the metadata was set
+    function setMetadata(
+        string memory denom,
+        string memory symbol,
+        string memory description,
+        uint32 exponent
+    ) external returns (bool success);
+
+    /// @dev Force a transfer of tokens of a denom the caller is admin of
+    /// @param denom The full denomination to transfer
+    /// @param amount The amount to transfer
+    /// @param from The address the tokens are taken from
+    /// @param to The address receiving the tokens
+    /// @return success True if the tokens were transferred
+    function forceTransfer(string memory denom, uint256 amount, address from, address to) external returns (bool success);
+
+    /// @dev Get the admin of a denom
+    /// @param denom The full denomination
+    /// @return admin The admin address, the zero address if the denom has no admin
+    function getDenomAdmin(string memory denom) external view returns (address admin);
+
+    /// @dev Get the denoms created by an address
+    /// @param creator The creator address
+    /// @return denoms An array of full denominations created by the address
+    function getDenomsFromCreator(address creator) external view returns (string[] memory denoms);
+}
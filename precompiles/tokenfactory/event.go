@@ -0,0 +1,113 @@
+package tokenfactory
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const (
+	// EventTypeCreateDenom defines the event when a denom is created via contract
+	EventTypeCreateDenom = "CreateDenom"
+	// EventTypeMint defines the event when tokens are minted via contract
+	EventTypeMint = "Mint"
+	// EventTypeBurn defines the event when tokens are burned via contract
+	EventTypeBurn = "Burn"
+	// EventTypeChangeAdmin defines the event when the admin of a denom is changed via contract
+	EventTypeChangeAdmin = "ChangeAdmin"
+	// EventTypeSetMetadata defines the event when the metadata of a denom is set via contract
+	EventTypeSetMetadata = "SetMetadata"
+	// EventTypeForceTransfer defines the event when tokens are force transferred via contract
+	EventTypeForceTransfer = "ForceTransfer"
+)
+
+// CreateDenomEvent represents the solidity CreateDenom event
+type CreateDenomEvent struct {
+	Creator common.Address
+	Denom   string
+}
+
+// MintEvent represents the solidity Mint event
+type MintEvent struct {
+	Sender common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// BurnEvent represents the solidity Burn event
+type BurnEvent struct {
+	Sender common.Address
+	From   common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// ChangeAdminEvent represents the solidity ChangeAdmin event
+type ChangeAdminEvent struct {
+	Sender   common.Address
+	NewAdmin common.Address
+	Denom    string
+}
+
+// SetMetadataEvent represents the solidity SetMetadata event
+type SetMetadataEvent struct {
+	Sender common.Address
+	Denom  string
+}
+
+// ForceTransferEvent represents the solidity ForceTransfer event
+type ForceTransferEvent struct {
+	Sender common.Address
+	From   common.Address
+	To     common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// emitEvent emits an event of the given type, all the addresses are indexed topics
+// and the remaining values are packed as the event data
+func (p *Precompile) emitEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	indexed []common.Address,
+	data ...interface{},
+) (err error) {
+	// Prepare the event topics
+	event := p.ABI.Events[eventType]
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is the signature of the event
+	topics[0] = event.ID
+
+	// The following topics are the indexed addresses
+	for i, address := range indexed {
+		topics[i+1], err = cmn.MakeTopic(address)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Parse the data
+	dataField, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	// Write to the stateDB
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        dataField,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package tokenfactory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	app "github.com/kiichain/kiichain/v3/app"
+	"github.com/kiichain/kiichain/v3/app/helpers"
+	tokenfactoryprecompile "github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
+	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TokenFactoryPrecompileTestSuite is a test suite for the tokenfactory precompile
+type TokenFactoryPrecompileTestSuite struct {
+	suite.Suite
+
+	// App and context
+	App     *app.KiichainApp
+	Ctx     sdk.Context
+	keyring testkeyring.Keyring
+
+	// Precompile
+	Precompile *tokenfactoryprecompile.Precompile
+}
+
+// TestTokenFactoryPrecompileTestSuite runs all the tests under the tokenfactory pre-compile test suite
+func TestTokenFactoryPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenFactoryPrecompileTestSuite))
+}
+
+// SetupTest sets up each test with a fresh app
+func (s *TokenFactoryPrecompileTestSuite) SetupTest() {
+	// Get the test context
+	t := s.T()
+
+	// Create the app and the context
+	s.App = helpers.Setup(t)
+	s.Ctx = s.App.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Start a new keyring
+	keyring := testkeyring.New(3)
+	s.keyring = keyring
+
+	// Start the precompile
+	pc, err := tokenfactoryprecompile.NewPrecompile(s.App.TokenFactoryKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.Precompile = pc
+}
+
+// GetStateDB returns the state database for the precompile
+func (s *TokenFactoryPrecompileTestSuite) GetStateDB() *statedb.StateDB {
+	// Get the header hash
+	headerHash := s.Ctx.HeaderHash()
+
+	// Return the statedb
+	return statedb.New(
+		s.Ctx,
+		s.App.EVMKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(headerHash)),
+	)
+}
+
+// FundDenomCreationFee funds an account with the denom creation fee
+func (s *TokenFactoryPrecompileTestSuite) FundDenomCreationFee(addr sdk.AccAddress) {
+	fee := s.App.TokenFactoryKeeper.GetParams(s.Ctx).DenomCreationFee
+	if fee.IsZero() {
+		return
+	}
+
+	err := s.App.BankKeeper.MintCoins(s.Ctx, evmtypes.ModuleName, fee)
+	s.Require().NoError(err)
+	err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, evmtypes.ModuleName, addr, fee)
+	s.Require().NoError(err)
+}
+
+// CreateDenom creates a denom with the given account as admin
+func (s *TokenFactoryPrecompileTestSuite) CreateDenom(addr sdk.AccAddress, subdenom string) string {
+	s.FundDenomCreationFee(addr)
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(s.App.TokenFactoryKeeper)
+	res, err := msgServer.CreateDenom(s.Ctx, tokenfactorytypes.NewMsgCreateDenom(addr.String(), subdenom))
+	s.Require().NoError(err)
+
+	return res.NewTokenDenom
+}
@@ -0,0 +1,55 @@
+package tokenfactory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GetDenomAdminMethod is the method name for the denom admin query
+	GetDenomAdminMethod = "getDenomAdmin"
+	// GetDenomsFromCreatorMethod is the method name for the denoms from creator query
+	GetDenomsFromCreatorMethod = "getDenomsFromCreator"
+)
+
+// GetDenomAdmin queries the admin of a denom through the ITokenFactory precompile
+func (p Precompile) GetDenomAdmin(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetDenomAdminArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request
+	res, err := p.tokenFactoryKeeper.DenomAuthorityMetadata(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Denoms without an admin return the zero address
+	return method.Outputs.Pack(hexAddress(res.AuthorityMetadata.Admin))
+}
+
+// GetDenomsFromCreator queries the denoms created by an address through the ITokenFactory precompile
+func (p Precompile) GetDenomsFromCreator(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetDenomsFromCreatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make the request
+	res, err := p.tokenFactoryKeeper.DenomsFromCreator(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Nil slices are returned as empty arrays
+	denoms := res.Denoms
+	if denoms == nil {
+		denoms = []string{}
+	}
+
+	return method.Outputs.Pack(denoms)
+}
@@ -0,0 +1,120 @@
+package tokenfactory_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	tokenfactoryprecompile "github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
+)
+
+// TestGetDenomAdmin tests the GetDenomAdmin method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestGetDenomAdmin() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.GetDenomAdminMethod]
+
+	// Create a denom for testing
+	admin := s.keyring.GetKey(0)
+	denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expAdmin    common.Address
+		errContains string
+	}{
+		{
+			name:     "valid query - get denom admin",
+			args:     []any{denom},
+			expAdmin: admin.Addr,
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid denom - empty",
+			args:        []any{""},
+			errContains: "invalid denom",
+		},
+		{
+			name:        "invalid denom - not a factory denom",
+			args:        []any{"akii"},
+			errContains: "invalid denom",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetDenomAdmin(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Decode the response
+			resUnpacked, err := s.Precompile.Unpack(tokenfactoryprecompile.GetDenomAdminMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAdmin, resUnpacked[0])
+		})
+	}
+}
+
+// TestGetDenomsFromCreator tests the GetDenomsFromCreator method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestGetDenomsFromCreator() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.GetDenomsFromCreatorMethod]
+
+	// Create denoms for testing
+	creator := s.keyring.GetKey(0)
+	bitcoin := s.CreateDenom(creator.AccAddr, "bitcoin")
+	litecoin := s.CreateDenom(creator.AccAddr, "litecoin")
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expDenoms   []string
+		errContains string
+	}{
+		{
+			name:      "valid query - creator with denoms",
+			args:      []any{creator.Addr},
+			expDenoms: []string{bitcoin, litecoin},
+		},
+		{
+			name:      "valid query - creator without denoms",
+			args:      []any{s.keyring.GetKey(1).Addr},
+			expDenoms: []string{},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid creator - zero address",
+			args:        []any{common.Address{}},
+			errContains: "invalid address",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetDenomsFromCreator(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Decode the response
+			resUnpacked, err := s.Precompile.Unpack(tokenfactoryprecompile.GetDenomsFromCreatorMethod, res)
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expDenoms, resUnpacked[0])
+		})
+	}
+}
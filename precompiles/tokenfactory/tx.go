@@ -0,0 +1,273 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
+)
+
+const (
+	// CreateDenomMethod is the method name for creating a denom
+	CreateDenomMethod = "createDenom"
+	// MintMethod is the method name for minting tokens
+	MintMethod = "mint"
+	// BurnMethod is the method name for burning tokens
+	BurnMethod = "burn"
+	// ChangeAdminMethod is the method name for changing the admin of a denom
+	ChangeAdminMethod = "changeAdmin"
+	// SetMetadataMethod is the method name for setting the metadata of a denom
+	SetMetadataMethod = "setMetadata"
+	// ForceTransferMethod is the method name for force transferring tokens
+	ForceTransferMethod = "forceTransfer"
+)
+
+// CreateDenom creates a new denom with the contract caller as creator through the ITokenFactory precompile
+func (p *Precompile) CreateDenom(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgCreateDenom(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, subdenom: %s }", msg.Sender, msg.Subdenom),
+	)
+
+	// The creation fee must be read before it's charged, it may depend on the oracle prices
+	creationFee := p.tokenFactoryKeeper.GetDenomCreationFee(ctx)
+
+	// Create the denom
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	res, err := msgServer.CreateDenom(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB
+	// when calling the precompile from a smart contract
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if contract.CallerAddress != origin {
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(creationFee.AmountOf(evmtypes.GetEVMCoinDenom()).BigInt())
+		// check if converted amount is greater than zero
+		if convertedAmount.Cmp(common.Big0) == 1 {
+			p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(caller, convertedAmount, cmn.Sub))
+		}
+	}
+
+	// Emit the event
+	if err = p.emitEvent(ctx, stateDB, EventTypeCreateDenom, []common.Address{caller}, res.NewTokenDenom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.NewTokenDenom)
+}
+
+// Mint mints tokens of a denom the caller is admin of through the ITokenFactory precompile
+func (p Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgMint(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, amount: %s, to: %s }", msg.Sender, msg.Amount, msg.MintToAddress),
+	)
+
+	// Mint the tokens
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	if _, err = msgServer.Mint(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	to := hexAddress(msg.MintToAddress)
+	if err = p.emitEvent(ctx, stateDB, EventTypeMint, []common.Address{caller, to}, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn burns tokens of a denom the caller is admin of through the ITokenFactory precompile
+func (p Precompile) Burn(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgBurn(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, amount: %s, from: %s }", msg.Sender, msg.Amount, msg.BurnFromAddress),
+	)
+
+	// Burn the tokens
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	if _, err = msgServer.Burn(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event, the msg server sets the burn from address to the sender if empty
+	from := hexAddress(msg.BurnFromAddress)
+	if err = p.emitEvent(ctx, stateDB, EventTypeBurn, []common.Address{caller, from}, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ChangeAdmin changes the admin of a denom the caller is admin of through the ITokenFactory precompile
+func (p Precompile) ChangeAdmin(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgChangeAdmin(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, denom: %s, new_admin: %s }", msg.Sender, msg.Denom, msg.NewAdmin),
+	)
+
+	// Change the admin
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	if _, err = msgServer.ChangeAdmin(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	newAdmin := hexAddress(msg.NewAdmin)
+	if err = p.emitEvent(ctx, stateDB, EventTypeChangeAdmin, []common.Address{caller, newAdmin}, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetMetadata sets the bank metadata of a denom the caller is admin of through the ITokenFactory precompile
+func (p Precompile) SetMetadata(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgSetDenomMetadata(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, denom: %s, symbol: %s }", msg.Sender, msg.Metadata.Base, msg.Metadata.Symbol),
+	)
+
+	// Set the metadata
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	if _, err = msgServer.SetDenomMetadata(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	if err = p.emitEvent(ctx, stateDB, EventTypeSetMetadata, []common.Address{caller}, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ForceTransfer moves tokens of a denom the caller is admin of through the ITokenFactory precompile
+func (p Precompile) ForceTransfer(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	caller := contract.Caller()
+
+	// Build and validate the msg
+	msg, err := NewMsgForceTransfer(args, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, amount: %s, from: %s, to: %s }", msg.Sender, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress),
+	)
+
+	// Transfer the tokens
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(p.tokenFactoryKeeper)
+	if _, err = msgServer.ForceTransfer(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit the event
+	from, to := hexAddress(msg.TransferFromAddress), hexAddress(msg.TransferToAddress)
+	if err = p.emitEvent(ctx, stateDB, EventTypeForceTransfer, []common.Address{caller, from, to}, msg.Amount.Denom, msg.Amount.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
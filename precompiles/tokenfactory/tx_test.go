@@ -0,0 +1,594 @@
+package tokenfactory_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	tokenfactoryprecompile "github.com/kiichain/kiichain/v3/precompiles/tokenfactory"
+	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// requireEvent checks the single log emitted by the precompile and decodes it into the event
+func (s *TokenFactoryPrecompileTestSuite) requireEvent(stateDB *statedb.StateDB, eventType string, event interface{}) {
+	s.Require().Len(stateDB.Logs(), 1)
+	log := stateDB.Logs()[0]
+
+	// Check the event signature
+	abiEvent := s.Precompile.ABI.Events[eventType]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(abiEvent.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	// Decode the event data
+	err := cmn.UnpackLog(s.Precompile.ABI, event, eventType, *log)
+	s.Require().NoError(err)
+}
+
+// TestCreateDenom tests the CreateDenom method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestCreateDenom() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.CreateDenomMethod]
+
+	// Get an account from the keyring
+	sender := s.keyring.GetKey(0)
+
+	tc := []struct {
+		name        string
+		args        []any
+		fund        bool
+		errContains string
+	}{
+		{
+			name: "valid execute",
+			args: []any{"bitcoin"},
+			fund: true,
+		},
+		{
+			name:        "invalid args length",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid subdenom - wrong type",
+			args:        []any{1},
+			errContains: "invalid subdenom",
+		},
+		{
+			name:        "invalid subdenom - bad characters",
+			args:        []any{"bit coin"},
+			errContains: "invalid denom",
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// Fund the sender with the creation fee if needed
+			if tc.fund {
+				s.FundDenomCreationFee(sender.AccAddr)
+			}
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, sender.Addr, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.CreateDenom(ctx, sender.Addr, contract, stateDB, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			expDenom := fmt.Sprintf("factory/%s/bitcoin", sender.AccAddr.String())
+			denom, err := s.Precompile.Unpack(tokenfactoryprecompile.CreateDenomMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(expDenom, denom[0])
+
+			// The caller is the admin of the new denom
+			authorityMetadata, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, expDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.AccAddr.String(), authorityMetadata.Admin)
+
+			// Check the event
+			var event tokenfactoryprecompile.CreateDenomEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeCreateDenom, &event)
+			s.Require().Equal(sender.Addr, event.Creator)
+			s.Require().Equal(expDenom, event.Denom)
+		})
+	}
+}
+
+// TestCreateDenomFromContract tests that the contract calling the precompile is the creator, not the tx origin
+func (s *TokenFactoryPrecompileTestSuite) TestCreateDenomFromContract() {
+	s.SetupTest()
+
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.CreateDenomMethod]
+
+	// The origin signs the tx and the caller plays the contract calling the precompile
+	origin := s.keyring.GetKey(0)
+	caller := s.keyring.GetKey(1)
+	s.FundDenomCreationFee(caller.AccAddr)
+
+	// Get the state db
+	stateDB := s.GetStateDB()
+
+	// Create the contract with the caller as the precompile caller
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, caller.Addr, s.Precompile, 200000)
+
+	// Execute the contract using the precompile
+	res, err := s.Precompile.CreateDenom(ctx, origin.Addr, contract, stateDB, &method, []any{"bitcoin"})
+	s.Require().NoError(err)
+
+	// The denom belongs to the caller
+	expDenom := fmt.Sprintf("factory/%s/bitcoin", caller.AccAddr.String())
+	denom, err := s.Precompile.Unpack(tokenfactoryprecompile.CreateDenomMethod, res)
+	s.Require().NoError(err)
+	s.Require().Equal(expDenom, denom[0])
+
+	authorityMetadata, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, expDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(caller.AccAddr.String(), authorityMetadata.Admin)
+}
+
+// TestMint tests the Mint method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestMint() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.MintMethod]
+
+	// Get the accounts from the keyring
+	admin := s.keyring.GetKey(0)
+	receiver := s.keyring.GetKey(1)
+
+	tc := []struct {
+		name        string
+		sender      common.Address
+		args        func(denom string) []any
+		errContains string
+	}{
+		{
+			name:   "valid execute",
+			sender: admin.Addr,
+			args:   func(denom string) []any { return []any{denom, big.NewInt(1000), receiver.Addr} },
+		},
+		{
+			name:        "invalid args length",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(1000)} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid amount - zero",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(0), receiver.Addr} },
+			errContains: "invalid amount",
+		},
+		{
+			name:        "invalid receiver - zero address",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(1000), common.Address{}} },
+			errContains: "invalid address",
+		},
+		{
+			name:        "not the admin",
+			sender:      receiver.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(1000), receiver.Addr} },
+			errContains: tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, tc.sender, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.Mint(ctx, contract, stateDB, &method, tc.args(denom))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(tokenfactoryprecompile.MintMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The receiver must have the tokens
+			balance := s.App.BankKeeper.GetBalance(s.Ctx, receiver.AccAddr, denom)
+			s.Require().Equal(math.NewInt(1000), balance.Amount)
+
+			// Check the event
+			var event tokenfactoryprecompile.MintEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeMint, &event)
+			s.Require().Equal(admin.Addr, event.Sender)
+			s.Require().Equal(receiver.Addr, event.To)
+			s.Require().Equal(denom, event.Denom)
+			s.Require().Equal(big.NewInt(1000), event.Amount)
+		})
+	}
+}
+
+// TestBurn tests the Burn method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestBurn() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.BurnMethod]
+
+	// Get the accounts from the keyring
+	admin := s.keyring.GetKey(0)
+	other := s.keyring.GetKey(1)
+
+	tc := []struct {
+		name        string
+		sender      common.Address
+		args        func(denom string) []any
+		errContains string
+	}{
+		{
+			name:   "valid execute",
+			sender: admin.Addr,
+			args:   func(denom string) []any { return []any{denom, big.NewInt(400), admin.Addr} },
+		},
+		{
+			name:        "invalid args length",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid denom - empty",
+			sender:      admin.Addr,
+			args:        func(string) []any { return []any{"", big.NewInt(400), admin.Addr} },
+			errContains: "invalid denom",
+		},
+		{
+			name:        "not the admin",
+			sender:      other.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(400), other.Addr} },
+			errContains: tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+		{
+			name:        "burn from another account - capability not enabled",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(400), other.Addr} },
+			errContains: tokenfactorytypes.ErrCapabilityNotEnabled.Error(),
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+			// Mint some tokens to the admin
+			coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000)))
+			err := s.App.BankKeeper.MintCoins(s.Ctx, tokenfactorytypes.ModuleName, coins)
+			s.Require().NoError(err)
+			err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, tokenfactorytypes.ModuleName, admin.AccAddr, coins)
+			s.Require().NoError(err)
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, tc.sender, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.Burn(ctx, contract, stateDB, &method, tc.args(denom))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(tokenfactoryprecompile.BurnMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The tokens must be burned
+			balance := s.App.BankKeeper.GetBalance(s.Ctx, admin.AccAddr, denom)
+			s.Require().Equal(math.NewInt(600), balance.Amount)
+
+			// Check the event
+			var event tokenfactoryprecompile.BurnEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeBurn, &event)
+			s.Require().Equal(admin.Addr, event.Sender)
+			s.Require().Equal(admin.Addr, event.From)
+			s.Require().Equal(denom, event.Denom)
+			s.Require().Equal(big.NewInt(400), event.Amount)
+		})
+	}
+}
+
+// TestChangeAdmin tests the ChangeAdmin method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestChangeAdmin() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.ChangeAdminMethod]
+
+	// Get the accounts from the keyring
+	admin := s.keyring.GetKey(0)
+	newAdmin := s.keyring.GetKey(1)
+
+	tc := []struct {
+		name        string
+		sender      common.Address
+		args        func(denom string) []any
+		errContains string
+	}{
+		{
+			name:   "valid execute",
+			sender: admin.Addr,
+			args:   func(denom string) []any { return []any{denom, newAdmin.Addr} },
+		},
+		{
+			name:        "invalid args length",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid new admin - wrong type",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, "admin"} },
+			errContains: "invalid address",
+		},
+		{
+			name:        "not the admin",
+			sender:      newAdmin.Addr,
+			args:        func(denom string) []any { return []any{denom, newAdmin.Addr} },
+			errContains: tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, tc.sender, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.ChangeAdmin(ctx, contract, stateDB, &method, tc.args(denom))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(tokenfactoryprecompile.ChangeAdminMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The admin must be changed
+			authorityMetadata, err := s.App.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+			s.Require().NoError(err)
+			s.Require().Equal(newAdmin.AccAddr.String(), authorityMetadata.Admin)
+
+			// Check the event
+			var event tokenfactoryprecompile.ChangeAdminEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeChangeAdmin, &event)
+			s.Require().Equal(admin.Addr, event.Sender)
+			s.Require().Equal(newAdmin.Addr, event.NewAdmin)
+			s.Require().Equal(denom, event.Denom)
+		})
+	}
+}
+
+// TestSetMetadata tests the SetMetadata method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestSetMetadata() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.SetMetadataMethod]
+
+	// Get the accounts from the keyring
+	admin := s.keyring.GetKey(0)
+	other := s.keyring.GetKey(1)
+
+	tc := []struct {
+		name        string
+		sender      common.Address
+		args        func(denom string) []any
+		errContains string
+	}{
+		{
+			name:   "valid execute",
+			sender: admin.Addr,
+			args:   func(denom string) []any { return []any{denom, "btc", "Bitcoin", uint32(6)} },
+		},
+		{
+			name:        "invalid args length",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, "btc", "Bitcoin"} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid symbol - empty",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, "", "Bitcoin", uint32(6)} },
+			errContains: "invalid symbol",
+		},
+		{
+			name:        "invalid exponent - wrong type",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, "btc", "Bitcoin", int64(6)} },
+			errContains: "invalid exponent",
+		},
+		{
+			name:        "not the admin",
+			sender:      other.Addr,
+			args:        func(denom string) []any { return []any{denom, "btc", "Bitcoin", uint32(6)} },
+			errContains: tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, tc.sender, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.SetMetadata(ctx, contract, stateDB, &method, tc.args(denom))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(tokenfactoryprecompile.SetMetadataMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The metadata must be set on the bank module
+			metadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, denom)
+			s.Require().True(found)
+			s.Require().Equal("BTC", metadata.Symbol)
+			s.Require().Equal("Bitcoin", metadata.Description)
+			s.Require().Len(metadata.DenomUnits, 2)
+			s.Require().Equal(uint32(6), metadata.DenomUnits[1].Exponent)
+
+			// Check the event
+			var event tokenfactoryprecompile.SetMetadataEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeSetMetadata, &event)
+			s.Require().Equal(admin.Addr, event.Sender)
+			s.Require().Equal(denom, event.Denom)
+		})
+	}
+}
+
+// TestForceTransfer tests the ForceTransfer method of the tokenfactory precompile
+func (s *TokenFactoryPrecompileTestSuite) TestForceTransfer() {
+	// Get the method
+	method := s.Precompile.Methods[tokenfactoryprecompile.ForceTransferMethod]
+
+	// Get the accounts from the keyring
+	admin := s.keyring.GetKey(0)
+	holder := s.keyring.GetKey(1)
+	receiver := s.keyring.GetKey(2)
+
+	tc := []struct {
+		name         string
+		sender       common.Address
+		args         func(denom string) []any
+		capabilities []string
+		errContains  string
+	}{
+		{
+			name:         "valid execute",
+			sender:       admin.Addr,
+			args:         func(denom string) []any { return []any{denom, big.NewInt(400), holder.Addr, receiver.Addr} },
+			capabilities: []string{tokenfactorytypes.EnableForceTransfer},
+		},
+		{
+			name:        "capability not enabled",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(400), holder.Addr, receiver.Addr} },
+			errContains: tokenfactorytypes.ErrCapabilityNotEnabled.Error(),
+		},
+		{
+			name:        "invalid args length",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, big.NewInt(400), holder.Addr} },
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid amount - wrong type",
+			sender:      admin.Addr,
+			args:        func(denom string) []any { return []any{denom, "400", holder.Addr, receiver.Addr} },
+			errContains: "invalid amount",
+		},
+		{
+			name:         "not the admin",
+			sender:       receiver.Addr,
+			args:         func(denom string) []any { return []any{denom, big.NewInt(400), holder.Addr, receiver.Addr} },
+			capabilities: []string{tokenfactorytypes.EnableForceTransfer},
+			errContains:  tokenfactorytypes.ErrUnauthorized.Error(),
+		},
+	}
+
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			denom := s.CreateDenom(admin.AccAddr, "bitcoin")
+
+			// Mint some tokens to the holder
+			coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000)))
+			err := s.App.BankKeeper.MintCoins(s.Ctx, tokenfactorytypes.ModuleName, coins)
+			s.Require().NoError(err)
+			err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, tokenfactorytypes.ModuleName, holder.AccAddr, coins)
+			s.Require().NoError(err)
+
+			// Force transfers are only allowed with the capability enabled
+			if tc.capabilities != nil {
+				tokenFactoryKeeper := s.App.TokenFactoryKeeper
+				tokenFactoryKeeper.SetEnabledCapabilities(s.Ctx, tc.capabilities)
+				s.Precompile, err = tokenfactoryprecompile.NewPrecompile(tokenFactoryKeeper, s.App.AuthzKeeper)
+				s.Require().NoError(err)
+			}
+
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, tc.sender, s.Precompile, 200000)
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.ForceTransfer(ctx, contract, stateDB, &method, tc.args(denom))
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Unpack the result
+			success, err := s.Precompile.Unpack(tokenfactoryprecompile.ForceTransferMethod, res)
+			s.Require().NoError(err)
+			s.Require().Equal(true, success[0])
+
+			// The tokens must be moved
+			s.Require().Equal(math.NewInt(600), s.App.BankKeeper.GetBalance(s.Ctx, holder.AccAddr, denom).Amount)
+			s.Require().Equal(math.NewInt(400), s.App.BankKeeper.GetBalance(s.Ctx, receiver.AccAddr, denom).Amount)
+
+			// Check the event
+			var event tokenfactoryprecompile.ForceTransferEvent
+			s.requireEvent(stateDB, tokenfactoryprecompile.EventTypeForceTransfer, &event)
+			s.Require().Equal(admin.Addr, event.Sender)
+			s.Require().Equal(holder.Addr, event.From)
+			s.Require().Equal(receiver.Addr, event.To)
+			s.Require().Equal(denom, event.Denom)
+			s.Require().Equal(big.NewInt(400), event.Amount)
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// NewMsgCreateDenom builds a MsgCreateDenom from the arguments, the caller is the sender
+func NewMsgCreateDenom(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgCreateDenom, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the subdenom
+	subdenom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid subdenom")
+	}
+
+	// Create the message and validate it
+	msg := tokenfactorytypes.NewMsgCreateDenom(accAddress(caller), subdenom)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgMint builds a MsgMint from the arguments, the caller is the sender
+func NewMsgMint(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgMint, error) {
+	// Check the number of arguments, should be 3
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	// Parse the coin and the receiver
+	coin, err := parseCoin(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseAddress(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the message and validate it
+	msg := tokenfactorytypes.NewMsgMintTo(accAddress(caller), coin, accAddress(to))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgBurn builds a MsgBurn from the arguments, the caller is the sender
+func NewMsgBurn(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgBurn, error) {
+	// Check the number of arguments, should be 3
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	// Parse the coin and the address to burn from
+	coin, err := parseCoin(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	from, err := parseAddress(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	// Burning from the caller itself doesn't require the burn from capability
+	msg := tokenfactorytypes.NewMsgBurn(accAddress(caller), coin)
+	if from != caller {
+		msg = tokenfactorytypes.NewMsgBurnFrom(accAddress(caller), coin, accAddress(from))
+	}
+
+	// Validate the message
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgChangeAdmin builds a MsgChangeAdmin from the arguments, the caller is the sender
+func NewMsgChangeAdmin(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgChangeAdmin, error) {
+	// Check the number of arguments, should be 2
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Parse the denom and the new admin
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf("invalid denom")
+	}
+	newAdmin, err := parseAddress(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the message and validate it
+	msg := tokenfactorytypes.NewMsgChangeAdmin(accAddress(caller), denom, accAddress(newAdmin))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgSetDenomMetadata builds a MsgSetDenomMetadata from the arguments, the caller is the sender
+// The metadata is built the same way as the modify-metadata CLI command
+func NewMsgSetDenomMetadata(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgSetDenomMetadata, error) {
+	// Check the number of arguments, should be 4
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	// Parse the arguments
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf("invalid denom")
+	}
+	symbol, ok := args[1].(string)
+	if !ok || symbol == "" {
+		return nil, fmt.Errorf("invalid symbol")
+	}
+	description, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid description")
+	}
+	exponent, ok := args[3].(uint32)
+	if !ok {
+		return nil, fmt.Errorf("invalid exponent")
+	}
+
+	// Build the bank metadata, the symbol is used as the display denom
+	ticker := strings.ToUpper(symbol)
+	metadata := banktypes.Metadata{
+		Description: description,
+		Display:     ticker,
+		Symbol:      ticker,
+		Name:        denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+				Aliases:  []string{ticker},
+			},
+			{
+				Denom:    ticker,
+				Exponent: exponent,
+				Aliases:  []string{denom},
+			},
+		},
+		Base: denom,
+	}
+
+	// Create the message and validate it
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(accAddress(caller), metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgForceTransfer builds a MsgForceTransfer from the arguments, the caller is the sender
+func NewMsgForceTransfer(args []interface{}, caller common.Address) (*tokenfactorytypes.MsgForceTransfer, error) {
+	// Check the number of arguments, should be 4
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	// Parse the coin and the addresses
+	coin, err := parseCoin(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	from, err := parseAddress(args[2])
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseAddress(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the message and validate it
+	msg := tokenfactorytypes.NewMsgForceTransfer(accAddress(caller), coin, accAddress(from), accAddress(to))
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ParseGetDenomAdminArgs parses the arguments for the GetDenomAdmin method
+func ParseGetDenomAdminArgs(args []interface{}) (*tokenfactorytypes.QueryDenomAuthorityMetadataRequest, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the denom
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf("invalid denom")
+	}
+
+	// Only factory denoms have an admin
+	if _, _, err := tokenfactorytypes.DeconstructDenom(denom); err != nil {
+		return nil, err
+	}
+
+	// Create the QueryDenomAuthorityMetadataRequest and return
+	return &tokenfactorytypes.QueryDenomAuthorityMetadataRequest{Denom: denom}, nil
+}
+
+// ParseGetDenomsFromCreatorArgs parses the arguments for the GetDenomsFromCreator method
+func ParseGetDenomsFromCreatorArgs(args []interface{}) (*tokenfactorytypes.QueryDenomsFromCreatorRequest, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the creator
+	creator, err := parseAddress(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the QueryDenomsFromCreatorRequest and return
+	return &tokenfactorytypes.QueryDenomsFromCreatorRequest{Creator: accAddress(creator)}, nil
+}
+
+// parseCoin parses a denom and amount pair of arguments into a coin
+func parseCoin(denomArg, amountArg interface{}) (sdk.Coin, error) {
+	denom, ok := denomArg.(string)
+	if !ok || denom == "" {
+		return sdk.Coin{}, fmt.Errorf("invalid denom")
+	}
+
+	amount, ok := amountArg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return sdk.Coin{}, fmt.Errorf("invalid amount")
+	}
+
+	return sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}, nil
+}
+
+// parseAddress parses a non zero address argument
+func parseAddress(addressArg interface{}) (common.Address, error) {
+	address, ok := addressArg.(common.Address)
+	if !ok || address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("invalid address")
+	}
+
+	return address, nil
+}
+
+// accAddress returns the bech32 account address of an EVM address
+func accAddress(address common.Address) string {
+	return sdk.AccAddress(address.Bytes()).String()
+}
+
+// hexAddress returns the EVM address of a bech32 account address, the zero address if invalid
+func hexAddress(address string) common.Address {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}
+	}
+
+	return common.BytesToAddress(accAddress)
+}
@@ -15,16 +15,19 @@ import (
 	evmbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/evm/types"
 	"github.com/kiichain/kiichain/v3/wasmbinding/oracle"
 	oraclebindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/oracle/types"
+	"github.com/kiichain/kiichain/v3/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
 	"github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory"
 	tfbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory/types"
 )
 
 // KiichainQuery is the query type for all cosmwasm bindings
 type KiichainQuery struct {
-	TokenFactory *tfbindingtypes.Query     `json:"token_factory,omitempty"`
-	EVM          *evmbindingtypes.Query    `json:"evm,omitempty"`
-	Bech32       *bech32bindingtypes.Query `json:"bech32,omitempty"`
-	Oracle       *oraclebindingtypes.Query `json:"oracle,omitempty"`
+	TokenFactory *tfbindingtypes.Query      `json:"token_factory,omitempty"`
+	EVM          *evmbindingtypes.Query     `json:"evm,omitempty"`
+	Bech32       *bech32bindingtypes.Query  `json:"bech32,omitempty"`
+	Oracle       *oraclebindingtypes.Query  `json:"oracle,omitempty"`
+	Rewards      *rewardsbindingtypes.Query `json:"rewards,omitempty"`
 }
 
 // QueryPlugin is the query plugin for all cosmwasm bindings
@@ -33,6 +36,7 @@ type QueryPlugin struct {
 	evmHandler          *evm.QueryPlugin
 	bech32Handler       *bech32.QueryPlugin
 	oracleHandler       *oracle.QueryPlugin
+	rewardsHandler      *rewards.QueryPlugin
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin
@@ -41,12 +45,14 @@ func NewQueryPlugin(
 	evm *evm.QueryPlugin,
 	bech32 *bech32.QueryPlugin,
 	oracle *oracle.QueryPlugin,
+	rewards *rewards.QueryPlugin,
 ) *QueryPlugin {
 	return &QueryPlugin{
 		tokenfactoryHandler: th,
 		evmHandler:          evm,
 		bech32Handler:       bech32,
 		oracleHandler:       oracle,
+		rewardsHandler:      rewards,
 	}
 }
 
@@ -73,6 +79,9 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 		case contractQuery.Oracle != nil:
 			// Call the oracle custom querier
 			return qp.oracleHandler.HandleOracleQuery(ctx, *contractQuery.Oracle)
+		case contractQuery.Rewards != nil:
+			// Call the rewards custom querier
+			return qp.rewardsHandler.HandleRewardsQuery(ctx, *contractQuery.Rewards)
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown query variant"}
 		}
@@ -0,0 +1,135 @@
+package rewards_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/kiichain/kiichain/v3/app"
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/wasmbinding"
+	"github.com/kiichain/kiichain/v3/wasmbinding/bech32"
+	"github.com/kiichain/kiichain/v3/wasmbinding/evm"
+	"github.com/kiichain/kiichain/v3/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v3/wasmbinding/oracle"
+	"github.com/kiichain/kiichain/v3/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
+	"github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// The bundled reflect contract only knows the token factory, evm, bech32 and oracle
+// variants, so these tests go through the same custom querier and messenger the
+// wasm keeper calls with the raw contract JSON
+
+// TestRewardsCustomQuery tests the rewards queries through the kiichain custom querier
+func TestRewardsCustomQuery(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Set a pool to be queried
+	err := app.RewardsKeeper.RewardPool.Set(ctx, rewardstypes.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin("akii", math.NewInt(500))),
+	})
+	require.NoError(t, err)
+
+	// Query the release schedule
+	query := rewardsbindingtypes.Query{
+		ReleaseSchedule: &rewardsbindingtypes.ReleaseScheduleQuery{},
+	}
+	resp := rewardstypes.QueryReleaseScheduleResponse{}
+	queryCustom(t, ctx, app, query, &resp)
+	require.False(t, resp.ReleaseSchedule.Active)
+
+	// Query the reward pool
+	query = rewardsbindingtypes.Query{
+		RewardPool: &rewardsbindingtypes.RewardPoolQuery{},
+	}
+	respPool := rewardstypes.QueryRewardPoolResponse{}
+	queryCustom(t, ctx, app, query, &respPool)
+	require.Equal(t, math.LegacyNewDec(500), respPool.RewardPool.CommunityPool.AmountOf("akii"))
+}
+
+// TestFundPoolCustomMsg tests funding the reward pool through the kiichain custom messenger
+func TestFundPoolCustomMsg(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	contract := apptesting.RandomAccountAddress()
+
+	// Fund the contract with the rewards denom
+	params, err := app.RewardsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	helpers.FundAccount(t, ctx, app, contract, sdk.NewCoins(sdk.NewCoin(params.TokenDenom, math.NewInt(1000))))
+
+	// Fund the pool from the contract
+	msg := rewardsbindingtypes.Msg{FundPool: &rewardsbindingtypes.FundPool{
+		Denom:  params.TokenDenom,
+		Amount: math.NewInt(400),
+	}}
+	err = executeCustom(t, ctx, app, contract, msg)
+	require.NoError(t, err)
+
+	// The funds moved from the contract into the pool
+	require.Equal(t, math.NewInt(600), app.BankKeeper.GetBalance(ctx, contract, params.TokenDenom).Amount)
+	pool, err := app.RewardsKeeper.RewardPool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(400), pool.CommunityPool.AmountOf(params.TokenDenom))
+
+	// Funding above the contract balance fails
+	msg.FundPool.Amount = math.NewInt(1000)
+	err = executeCustom(t, ctx, app, contract, msg)
+	require.Error(t, err)
+}
+
+// queryCustom is a helper function to query through the custom querier
+func queryCustom(t *testing.T, ctx sdk.Context, app *app.KiichainApp, request rewardsbindingtypes.Query, response interface{}) {
+	t.Helper()
+
+	// Build the central query plugin
+	queryPlugin := wasmbinding.NewQueryPlugin(
+		tokenfactory.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper),
+		evm.NewQueryPlugin(app.EVMKeeper),
+		bech32.NewQueryPlugin(),
+		oracle.NewQueryPlugin(app.OracleKeeper),
+		rewards.NewQueryPlugin(app.RewardsKeeper),
+	)
+
+	// Make the request a kiichain query
+	queryBz, err := json.Marshal(wasmbinding.KiichainQuery{
+		Rewards: &request,
+	})
+	require.NoError(t, err)
+
+	// Query and decode the response
+	resBz, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, queryBz)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(resBz, response))
+}
+
+// executeCustom is a helper function to execute a message through the custom messenger
+func executeCustom(t *testing.T, ctx sdk.Context, app *app.KiichainApp, contract sdk.AccAddress, msg rewardsbindingtypes.Msg) error {
+	t.Helper()
+
+	// Build the messenger, the wrapped messenger is never reached by custom messages
+	messenger := wasmbinding.CustomMessageDecorator(
+		app.BankKeeper,
+		tokenfactory.NewCustomMessenger(app.BankKeeper, &app.TokenFactoryKeeper),
+		rewards.NewCustomMessenger(app.RewardsKeeper),
+	)(nil)
+
+	// Make the request a kiichain msg
+	customBz, err := json.Marshal(wasmbinding.KiichainMsg{
+		Rewards: &msg,
+	})
+	require.NoError(t, err)
+
+	_, _, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
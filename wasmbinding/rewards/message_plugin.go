@@ -0,0 +1,69 @@
+package rewards
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
+	"github.com/kiichain/kiichain/v3/wasmbinding/utils"
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// CustomMessenger is a wrapper for the rewards message plugin
+type CustomMessenger struct {
+	rewardsKeeper rewardskeeper.Keeper
+}
+
+// NewCustomMessenger returns a reference to a new CustomMessenger
+func NewCustomMessenger(rewardsKeeper rewardskeeper.Keeper) *CustomMessenger {
+	return &CustomMessenger{
+		rewardsKeeper: rewardsKeeper,
+	}
+}
+
+// DispatchMsg implements keeper.Messenger
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg rewardsbindingtypes.Msg) (events []sdk.Event, data [][]byte, msgResponses [][]*types.Any, err error) {
+	// Match the message
+	switch {
+	case msg.FundPool != nil:
+		return m.FundPool(ctx, contractAddr, msg.FundPool)
+	default:
+		return nil, nil, utils.EmptyMsgResp, wasmvmtypes.UnsupportedRequest{Kind: "unknown rewards msg variant"}
+	}
+}
+
+// FundPool sends funds from the contract to the reward pool
+func (m *CustomMessenger) FundPool(ctx sdk.Context, contractAddr sdk.AccAddress, fundPool *rewardsbindingtypes.FundPool) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformFundPool(m.rewardsKeeper, ctx, contractAddr, fundPool)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform fund pool")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformFundPool validates the fund pool message and funds the pool through the rewards module
+func PerformFundPool(k rewardskeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, fundPool *rewardsbindingtypes.FundPool) error {
+	if fundPool == nil {
+		return wasmvmtypes.InvalidRequest{Err: "fund pool null fund pool"}
+	}
+	if fundPool.Amount.IsNil() {
+		return wasmvmtypes.InvalidRequest{Err: "fund pool null amount"}
+	}
+
+	coin := sdk.Coin{Denom: fundPool.Denom, Amount: fundPool.Amount}
+	sdkMsg := rewardstypes.NewMsgFundPool(contractAddr, coin)
+
+	// Fund the pool, the msg server validates the amount and denom
+	msgServer := rewardskeeper.NewMsgServerImpl(k)
+	_, err := msgServer.FundPool(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "funding pool")
+	}
+
+	return nil
+}
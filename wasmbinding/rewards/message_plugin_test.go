@@ -0,0 +1,87 @@
+package rewards_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/wasmbinding/helpers"
+	wasmbinding "github.com/kiichain/kiichain/v3/wasmbinding/rewards"
+	bindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
+)
+
+// TestFundPool tests the FundPool function
+func TestFundPool(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund actor with the rewards denom
+	params, err := app.RewardsKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	helpers.FundAccount(t, ctx, app, actor, sdk.NewCoins(sdk.NewCoin(params.TokenDenom, sdkmath.NewInt(1000))))
+
+	specs := map[string]struct {
+		fundPool *bindingtypes.FundPool
+		expErr   bool
+	}{
+		"valid fund pool": {
+			fundPool: &bindingtypes.FundPool{
+				Denom:  params.TokenDenom,
+				Amount: sdkmath.NewInt(100),
+			},
+		},
+		"wrong denom": {
+			fundPool: &bindingtypes.FundPool{
+				Denom:  "uatom",
+				Amount: sdkmath.NewInt(100),
+			},
+			expErr: true,
+		},
+		"zero amount": {
+			fundPool: &bindingtypes.FundPool{
+				Denom:  params.TokenDenom,
+				Amount: sdkmath.ZeroInt(),
+			},
+			expErr: true,
+		},
+		"nil amount": {
+			fundPool: &bindingtypes.FundPool{
+				Denom: params.TokenDenom,
+			},
+			expErr: true,
+		},
+		"insufficient funds": {
+			fundPool: &bindingtypes.FundPool{
+				Denom:  params.TokenDenom,
+				Amount: sdkmath.NewInt(10_000),
+			},
+			expErr: true,
+		},
+		"null fund pool": {
+			fundPool: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotErr := wasmbinding.PerformFundPool(app.RewardsKeeper, ctx, actor, spec.fundPool)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+
+	// The pool received the valid funding only
+	pool, err := app.RewardsKeeper.RewardPool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), pool.CommunityPool.AmountOf(params.TokenDenom))
+}
@@ -0,0 +1,100 @@
+package rewards
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
+	rewardstypes "github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// QueryPlugin is the query plugin object for the rewards queries
+type QueryPlugin struct {
+	rewardsKeeper  rewardskeeper.Keeper
+	rewardsQuerier rewardskeeper.Querier
+}
+
+// NewQueryPlugin returns a new query plugin
+func NewQueryPlugin(rewardsKeeper rewardskeeper.Keeper) *QueryPlugin {
+	// Start the querier
+	rewardsQuerier := rewardskeeper.NewQuerier(rewardsKeeper)
+
+	// Return the query plugin
+	return &QueryPlugin{
+		rewardsKeeper:  rewardsKeeper,
+		rewardsQuerier: rewardsQuerier,
+	}
+}
+
+// HandleRewardsQuery is a custom querier for the rewards module
+func (qp *QueryPlugin) HandleRewardsQuery(ctx sdk.Context, rewardsQuery rewardsbindingtypes.Query) ([]byte, error) {
+	// Match the query under the module
+	switch {
+	// The query is a release schedule query
+	case rewardsQuery.ReleaseSchedule != nil:
+		// Apply the request
+		schedule, err := qp.HandleReleaseSchedule(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		bz, err := json.Marshal(schedule)
+		if err != nil {
+			return nil, err
+		}
+		return bz, nil
+
+	// The query is a reward pool query
+	case rewardsQuery.RewardPool != nil:
+		// Apply the request
+		pool, err := qp.HandleRewardPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		bz, err := json.Marshal(pool)
+		if err != nil {
+			return nil, err
+		}
+		return bz, nil
+
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown rewards query variant"}
+	}
+}
+
+// HandleReleaseSchedule handles the release schedule query
+func (qp *QueryPlugin) HandleReleaseSchedule(ctx sdk.Context) (*rewardstypes.QueryReleaseScheduleResponse, error) {
+	// Get the release schedule from the keeper
+	schedule, err := qp.rewardsQuerier.ReleaseSchedule(
+		ctx,
+		&rewardstypes.QueryReleaseScheduleRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return schedule, nil
+}
+
+// HandleRewardPool handles the reward pool query
+func (qp *QueryPlugin) HandleRewardPool(ctx sdk.Context) (*rewardstypes.QueryRewardPoolResponse, error) {
+	// Get the reward pool from the keeper
+	pool, err := qp.rewardsQuerier.RewardPool(
+		ctx,
+		&rewardstypes.QueryRewardPoolRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return pool, nil
+}
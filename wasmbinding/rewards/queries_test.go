@@ -0,0 +1,86 @@
+package rewards_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v3/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/rewards/types"
+	"github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// TestHandleRewardsQuery tests the HandleRewardsQuery function of the rewards module
+func TestHandleRewardsQuery(t *testing.T) {
+	// Setup the app
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Set a schedule and a pool
+	err := app.RewardsKeeper.ReleaseSchedule.Set(ctx, types.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(100)),
+		EndTime:         time.Unix(2_000_000_000, 0).UTC(),
+		LastReleaseTime: time.Unix(1_900_000_000, 0).UTC(),
+		Active:          true,
+	})
+	require.NoError(t, err)
+	err = app.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin("akii", math.NewInt(500))),
+	})
+	require.NoError(t, err)
+
+	// Set all the test cases
+	testCases := []struct {
+		name        string
+		query       rewardsbindingtypes.Query
+		expected    []byte
+		errContains string
+	}{
+		{
+			name: "Valid - release schedule",
+			query: rewardsbindingtypes.Query{
+				ReleaseSchedule: &rewardsbindingtypes.ReleaseScheduleQuery{},
+			},
+			expected: []byte(`{"release_schedule":{"total_amount":{"denom":"akii","amount":"1000"},"released_amount":{"denom":"akii","amount":"100"},"end_time":"2033-05-18T03:33:20Z","last_release_time":"2030-03-17T17:46:40Z","active":true,"paused_time":"0001-01-01T00:00:00Z"}}`),
+		},
+		{
+			name: "Valid - reward pool",
+			query: rewardsbindingtypes.Query{
+				RewardPool: &rewardsbindingtypes.RewardPoolQuery{},
+			},
+			expected: []byte(`{"reward_pool":{"community_pool":[{"denom":"akii","amount":"500.000000000000000000"}]}}`),
+		},
+		{
+			name:        "Invalid - empty query",
+			query:       rewardsbindingtypes.Query{},
+			errContains: "unknown rewards query variant",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start the query plugin
+			queryPlugin := rewards.NewQueryPlugin(app.RewardsKeeper)
+
+			// Handle the query
+			bz, err := queryPlugin.HandleRewardsQuery(ctx, tc.query)
+
+			// Check for errors
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, bz)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import "cosmossdk.io/math"
+
+// Query defines the structure for rewards queries
+type Query struct {
+	ReleaseSchedule *ReleaseScheduleQuery `json:"release_schedule,omitempty"`
+	RewardPool      *RewardPoolQuery      `json:"reward_pool,omitempty"`
+}
+
+// ReleaseScheduleQuery defines the structure for querying the current release schedule
+type ReleaseScheduleQuery struct{}
+
+// RewardPoolQuery defines the structure for querying the reward pool balance
+type RewardPoolQuery struct{}
+
+// Msg defines the structure for rewards messages
+type Msg struct {
+	/// Contracts can fund the reward pool with the rewards token denom.
+	/// The funds are sent from the contract balance.
+	FundPool *FundPool `json:"fund_pool,omitempty"`
+}
+
+// FundPool sends funds from the contract to the reward pool
+type FundPool struct {
+	Denom  string   `json:"denom"`
+	Amount math.Int `json:"amount"`
+}
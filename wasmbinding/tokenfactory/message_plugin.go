@@ -38,6 +38,22 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		return m.SetMetadata(ctx, contractAddr, msg.SetMetadata)
 	case msg.ForceTransfer != nil:
 		return m.ForceTransfer(ctx, contractAddr, msg.ForceTransfer)
+	case msg.SetBeforeSendHook != nil:
+		return m.SetBeforeSendHook(ctx, contractAddr, msg.SetBeforeSendHook)
+	case msg.FreezeAccount != nil:
+		return m.FreezeAccount(ctx, contractAddr, msg.FreezeAccount)
+	case msg.UnfreezeAccount != nil:
+		return m.UnfreezeAccount(ctx, contractAddr, msg.UnfreezeAccount)
+	case msg.PauseDenom != nil:
+		return m.PauseDenom(ctx, contractAddr, msg.PauseDenom)
+	case msg.GrantRole != nil:
+		return m.GrantRole(ctx, contractAddr, msg.GrantRole)
+	case msg.RevokeRole != nil:
+		return m.RevokeRole(ctx, contractAddr, msg.RevokeRole)
+	case msg.RenounceAdmin != nil:
+		return m.RenounceAdmin(ctx, contractAddr, msg.RenounceAdmin)
+	case msg.MultiMint != nil:
+		return m.MultiMint(ctx, contractAddr, msg.MultiMint)
 	default:
 		return nil, nil, utils.EmptyMsgResp, wasmvmtypes.UnsupportedRequest{Kind: "unknown token factory msg variant"}
 	}
@@ -53,7 +69,7 @@ func NewCustomMessenger(bank bankkeeper.Keeper, tokenFactory *tokenfactorykeeper
 
 // CreateDenom creates a new token denom
 func (m *CustomMessenger) CreateDenom(ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *tfbindingtypes.CreateDenom) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	bz, err := PerformCreateDenom(m.tokenFactory, m.bank, ctx, contractAddr, createDenom)
+	bz, err := PerformCreateDenom(m.tokenFactory, ctx, contractAddr, createDenom)
 	if err != nil {
 		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform create denom")
 	}
@@ -61,7 +77,7 @@ func (m *CustomMessenger) CreateDenom(ctx sdk.Context, contractAddr sdk.AccAddre
 }
 
 // PerformCreateDenom is used with createDenom to create a token denom; validates the msgCreateDenom.
-func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *tfbindingtypes.CreateDenom) ([]byte, error) {
+func PerformCreateDenom(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createDenom *tfbindingtypes.CreateDenom) ([]byte, error) {
 	if createDenom == nil {
 		return nil, wasmvmtypes.InvalidRequest{Err: "create denom null create denom"}
 	}
@@ -85,7 +101,7 @@ func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 
 	if createDenom.Metadata != nil {
 		newDenom := resp.NewTokenDenom
-		err := PerformSetMetadata(f, b, ctx, contractAddr, newDenom, *createDenom.Metadata)
+		err := PerformSetMetadata(f, ctx, contractAddr, newDenom, *createDenom.Metadata)
 		if err != nil {
 			return nil, errorsmod.Wrap(err, "setting metadata")
 		}
@@ -138,6 +154,43 @@ func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Cont
 	return nil
 }
 
+// MultiMint mints tokens of a specified denom to many addresses.
+func (m *CustomMessenger) MultiMint(ctx sdk.Context, contractAddr sdk.AccAddress, multiMint *tfbindingtypes.MultiMint) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformMultiMint(m.tokenFactory, ctx, contractAddr, multiMint)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform multi mint")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformMultiMint validates and dispatches a multiMint message.
+func PerformMultiMint(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, multiMint *tfbindingtypes.MultiMint) error {
+	if multiMint == nil {
+		return wasmvmtypes.InvalidRequest{Err: "multi mint null"}
+	}
+
+	recipients := make([]tokenfactorytypes.MintRecipient, 0, len(multiMint.Recipients))
+	for _, recipient := range multiMint.Recipients {
+		recipients = append(recipients, tokenfactorytypes.MintRecipient{
+			Address: recipient.Address,
+			Amount:  recipient.Amount,
+		})
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgMultiMint(contractAddr.String(), multiMint.Denom, recipients)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Mint through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.MultiMint(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "multi minting coins from message")
+	}
+	return nil
+}
+
 // ChangeAdmin changes the admin.
 func (m *CustomMessenger) ChangeAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *tfbindingtypes.ChangeAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	err := ChangeAdmin(m.tokenFactory, ctx, contractAddr, changeAdmin)
@@ -245,27 +298,221 @@ func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contrac
 	return nil
 }
 
-// createDenom creates a new token denom
-func (m *CustomMessenger) SetMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *tfbindingtypes.SetMetadata) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
-	err := PerformSetMetadata(m.tokenFactory, m.bank, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
+// SetBeforeSendHook sets the before send hook contract of a denom.
+func (m *CustomMessenger) SetBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *tfbindingtypes.SetBeforeSendHook) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
 	if err != nil {
-		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set metadata")
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set before send hook")
 	}
 	return nil, nil, utils.EmptyMsgResp, nil
 }
 
-// PerformSetMetadata is used with setMetadata to add new metadata
-// It also is called inside CreateDenom if optional metadata field is set
-func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata tfbindingtypes.Metadata) error {
-	// ensure contract address is admin of denom
-	auth, err := f.GetAuthorityMetadata(ctx, denom)
+// PerformSetBeforeSendHook validates and dispatches a setBeforeSendHook message.
+func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *tfbindingtypes.SetBeforeSendHook) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.CosmwasmAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Set the hook through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHook(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting before send hook from message")
+	}
+	return nil
+}
+
+// FreezeAccount freezes an account for a denom.
+func (m *CustomMessenger) FreezeAccount(ctx sdk.Context, contractAddr sdk.AccAddress, freezeAccount *tfbindingtypes.FreezeAccount) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformFreezeAccount(m.tokenFactory, ctx, contractAddr, freezeAccount)
 	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform freeze account")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformFreezeAccount validates and dispatches a freezeAccount message.
+func PerformFreezeAccount(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, freezeAccount *tfbindingtypes.FreezeAccount) error {
+	if freezeAccount == nil {
+		return wasmvmtypes.InvalidRequest{Err: "freeze account null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgFreezeAccount(contractAddr.String(), freezeAccount.Denom, freezeAccount.Account)
+	if err := sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+
+	// Freeze through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.FreezeAccount(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "freezing account from message")
+	}
+	return nil
+}
+
+// UnfreezeAccount unfreezes an account for a denom.
+func (m *CustomMessenger) UnfreezeAccount(ctx sdk.Context, contractAddr sdk.AccAddress, unfreezeAccount *tfbindingtypes.UnfreezeAccount) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformUnfreezeAccount(m.tokenFactory, ctx, contractAddr, unfreezeAccount)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform unfreeze account")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformUnfreezeAccount validates and dispatches an unfreezeAccount message.
+func PerformUnfreezeAccount(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, unfreezeAccount *tfbindingtypes.UnfreezeAccount) error {
+	if unfreezeAccount == nil {
+		return wasmvmtypes.InvalidRequest{Err: "unfreeze account null"}
 	}
 
+	sdkMsg := tokenfactorytypes.NewMsgUnfreezeAccount(contractAddr.String(), unfreezeAccount.Denom, unfreezeAccount.Account)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Unfreeze through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.UnfreezeAccount(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "unfreezing account from message")
+	}
+	return nil
+}
+
+// PauseDenom pauses or unpauses the transfers of a denom.
+func (m *CustomMessenger) PauseDenom(ctx sdk.Context, contractAddr sdk.AccAddress, pauseDenom *tfbindingtypes.PauseDenom) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformPauseDenom(m.tokenFactory, ctx, contractAddr, pauseDenom)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform pause denom")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformPauseDenom validates and dispatches a pauseDenom message.
+func PerformPauseDenom(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, pauseDenom *tfbindingtypes.PauseDenom) error {
+	if pauseDenom == nil {
+		return wasmvmtypes.InvalidRequest{Err: "pause denom null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgPauseDenom(contractAddr.String(), pauseDenom.Denom, pauseDenom.Paused)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Pause through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.PauseDenom(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "pausing denom from message")
+	}
+	return nil
+}
+
+// GrantRole grants a denom role to an address.
+func (m *CustomMessenger) GrantRole(ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *tfbindingtypes.GrantRole) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformGrantRole(m.tokenFactory, ctx, contractAddr, grantRole)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform grant role")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformGrantRole validates and dispatches a grantRole message.
+func PerformGrantRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *tfbindingtypes.GrantRole) error {
+	if grantRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "grant role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgGrantRole(contractAddr.String(), grantRole.Denom, grantRole.Role, grantRole.Address)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Grant through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.GrantRole(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "granting role from message")
+	}
+	return nil
+}
+
+// RevokeRole revokes a denom role from an address.
+func (m *CustomMessenger) RevokeRole(ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *tfbindingtypes.RevokeRole) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformRevokeRole(m.tokenFactory, ctx, contractAddr, revokeRole)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform revoke role")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformRevokeRole validates and dispatches a revokeRole message.
+func PerformRevokeRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *tfbindingtypes.RevokeRole) error {
+	if revokeRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "revoke role null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRevokeRole(contractAddr.String(), revokeRole.Denom, revokeRole.Role, revokeRole.Address)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Revoke through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.RevokeRole(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "revoking role from message")
+	}
+	return nil
+}
+
+// RenounceAdmin permanently renounces the admin of a denom.
+func (m *CustomMessenger) RenounceAdmin(ctx sdk.Context, contractAddr sdk.AccAddress, renounceAdmin *tfbindingtypes.RenounceAdmin) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformRenounceAdmin(m.tokenFactory, ctx, contractAddr, renounceAdmin)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform renounce admin")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformRenounceAdmin validates and dispatches a renounceAdmin message.
+func PerformRenounceAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, renounceAdmin *tfbindingtypes.RenounceAdmin) error {
+	if renounceAdmin == nil {
+		return wasmvmtypes.InvalidRequest{Err: "renounce admin null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRenounceAdmin(contractAddr.String(), renounceAdmin.Denom)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Renounce through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.RenounceAdmin(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "renouncing admin from message")
+	}
+	return nil
+}
+
+// createDenom creates a new token denom
+func (m *CustomMessenger) SetMetadata(ctx sdk.Context, contractAddr sdk.AccAddress, setMetadata *tfbindingtypes.SetMetadata) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetMetadata(m.tokenFactory, ctx, contractAddr, setMetadata.Denom, setMetadata.Metadata)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set metadata")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformSetMetadata is used with setMetadata to add new metadata
+// It also is called inside CreateDenom if optional metadata field is set
+func PerformSetMetadata(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata tfbindingtypes.Metadata) error {
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
 	if metadata.Base == "" {
 		metadata.Base = denom
@@ -274,13 +521,17 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 		return wasmvmtypes.InvalidRequest{Err: "Base must be the same as denom"}
 	}
 
-	// Create and validate the metadata
-	bankMetadata := WasmMetadataToSdk(metadata)
-	if err := bankMetadata.Validate(); err != nil {
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomMetadata(contractAddr.String(), WasmMetadataToSdk(metadata))
+	if err := sdkMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
-	b.SetDenomMetaData(ctx, bankMetadata)
+	// Set through token factory / message server
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetDenomMetadata(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting metadata from message")
+	}
 	return nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/kiichain/kiichain/v3/wasmbinding/helpers"
 	wasmbinding "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory"
 	bindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory/types"
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
 	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
 
@@ -55,7 +56,7 @@ func TestCreateDenom(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			// when
-			_, gotErr := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, spec.createDenom)
+			_, gotErr := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, actor, spec.createDenom)
 			// then
 			if spec.expErr {
 				t.Logf("validate_msg_test got error: %v", gotErr)
@@ -155,7 +156,7 @@ func TestChangeAdmin(t *testing.T) {
 			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
 			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
 
-			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
 				Subdenom: validDenom,
 			})
 			require.NoError(t, err)
@@ -185,13 +186,13 @@ func TestMint(t *testing.T) {
 	validDenom := bindingtypes.CreateDenom{
 		Subdenom: "MOON",
 	}
-	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, &validDenom)
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, creator, &validDenom)
 	require.NoError(t, err)
 
 	emptyDenom := bindingtypes.CreateDenom{
 		Subdenom: "",
 	}
-	_, err = wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, &emptyDenom)
+	_, err = wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, creator, &emptyDenom)
 	require.NoError(t, err)
 
 	validDenomStr := fmt.Sprintf("factory/%s/%s", creator.String(), validDenom.Subdenom)
@@ -305,13 +306,13 @@ func TestBurn(t *testing.T) {
 	validDenom := bindingtypes.CreateDenom{
 		Subdenom: "MOON",
 	}
-	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, &validDenom)
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, creator, &validDenom)
 	require.NoError(t, err)
 
 	emptyDenom := bindingtypes.CreateDenom{
 		Subdenom: "",
 	}
-	_, err = wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, creator, &emptyDenom)
+	_, err = wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, creator, &emptyDenom)
 	require.NoError(t, err)
 
 	lucky := apptesting.RandomAccountAddress()
@@ -419,3 +420,506 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+// TestSetBeforeSendHook tests setting the before send hook through the bindings
+func TestSetBeforeSendHook(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	hookAddress := helpers.RandomBech32AccountAddress()
+
+	specs := map[string]struct {
+		actor             sdk.AccAddress
+		setBeforeSendHook *bindingtypes.SetBeforeSendHook
+
+		expHook   string
+		expErrMsg string
+	}{
+		"valid": {
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: hookAddress,
+			},
+			actor:   tokenCreator,
+			expHook: hookAddress,
+		},
+		"empty address removes the hook": {
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: "",
+			},
+			actor: tokenCreator,
+		},
+		"creator is a different address": {
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:           fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom),
+				CosmwasmAddress: hookAddress,
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "setting before send hook from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: set before send hook null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformSetBeforeSendHook(&app.TokenFactoryKeeper, ctx, spec.actor, spec.setBeforeSendHook)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// The hook is returned by the query plugin
+			queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+			res := queryPlugin.GetTokenfactoryBeforeSendHookAddress(ctx, spec.setBeforeSendHook.Denom)
+			require.Equal(t, spec.expHook, res.CosmwasmAddress)
+		})
+	}
+}
+
+func TestFreezeAccount(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	frozenAccount := apptesting.RandomAccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	specs := map[string]struct {
+		actor         sdk.AccAddress
+		freezeAccount *bindingtypes.FreezeAccount
+
+		expErrMsg string
+	}{
+		"valid": {
+			freezeAccount: &bindingtypes.FreezeAccount{
+				Denom:   denom,
+				Account: frozenAccount.String(),
+			},
+			actor: tokenCreator,
+		},
+		"creator is a different address": {
+			freezeAccount: &bindingtypes.FreezeAccount{
+				Denom:   denom,
+				Account: frozenAccount.String(),
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "freezing account from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: freeze account null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformFreezeAccount(&app.TokenFactoryKeeper, ctx, spec.actor, spec.freezeAccount)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, app.TokenFactoryKeeper.IsFrozen(ctx, denom, frozenAccount))
+
+			// The account can be unfrozen
+			err = wasmbinding.PerformUnfreezeAccount(&app.TokenFactoryKeeper, ctx, spec.actor, &bindingtypes.UnfreezeAccount{
+				Denom:   denom,
+				Account: frozenAccount.String(),
+			})
+			require.NoError(t, err)
+			require.False(t, app.TokenFactoryKeeper.IsFrozen(ctx, denom, frozenAccount))
+		})
+	}
+}
+
+func TestPauseDenom(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	specs := map[string]struct {
+		actor      sdk.AccAddress
+		pauseDenom *bindingtypes.PauseDenom
+
+		expPaused bool
+		expErrMsg string
+	}{
+		"pause": {
+			pauseDenom: &bindingtypes.PauseDenom{
+				Denom:  denom,
+				Paused: true,
+			},
+			actor:     tokenCreator,
+			expPaused: true,
+		},
+		"unpause": {
+			pauseDenom: &bindingtypes.PauseDenom{
+				Denom:  denom,
+				Paused: false,
+			},
+			actor:     tokenCreator,
+			expPaused: false,
+		},
+		"creator is a different address": {
+			pauseDenom: &bindingtypes.PauseDenom{
+				Denom:  denom,
+				Paused: true,
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "pausing denom from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: pause denom null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformPauseDenom(&app.TokenFactoryKeeper, ctx, spec.actor, spec.pauseDenom)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expPaused, app.TokenFactoryKeeper.IsDenomPaused(ctx, denom))
+		})
+	}
+}
+
+func TestGrantRole(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	minter := apptesting.RandomAccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	specs := map[string]struct {
+		actor     sdk.AccAddress
+		grantRole *bindingtypes.GrantRole
+
+		expErrMsg string
+	}{
+		"valid": {
+			grantRole: &bindingtypes.GrantRole{
+				Denom:   denom,
+				Role:    types.RoleMinter,
+				Address: minter.String(),
+			},
+			actor: tokenCreator,
+		},
+		"creator is a different address": {
+			grantRole: &bindingtypes.GrantRole{
+				Denom:   denom,
+				Role:    types.RoleMinter,
+				Address: minter.String(),
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "granting role from message: unauthorized account",
+		},
+		"admin role is not grantable": {
+			grantRole: &bindingtypes.GrantRole{
+				Denom:   denom,
+				Role:    types.RoleAdmin,
+				Address: minter.String(),
+			},
+			actor:     tokenCreator,
+			expErrMsg: "role must be one of [minter burner metadata force_transfer], got admin: invalid denom role",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: grant role null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformGrantRole(&app.TokenFactoryKeeper, ctx, spec.actor, spec.grantRole)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			authorityMetadata, err := app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+			require.NoError(t, err)
+			require.True(t, authorityMetadata.HasRole(types.RoleMinter, minter.String()))
+
+			// The role can be revoked
+			err = wasmbinding.PerformRevokeRole(&app.TokenFactoryKeeper, ctx, spec.actor, &bindingtypes.RevokeRole{
+				Denom:   denom,
+				Role:    types.RoleMinter,
+				Address: minter.String(),
+			})
+			require.NoError(t, err)
+			authorityMetadata, err = app.TokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+			require.NoError(t, err)
+			require.False(t, authorityMetadata.HasRole(types.RoleMinter, minter.String()))
+		})
+	}
+}
+
+func TestRenounceAdmin(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	specs := map[string]struct {
+		actor         sdk.AccAddress
+		renounceAdmin *bindingtypes.RenounceAdmin
+
+		expErrMsg string
+	}{
+		"valid": {
+			renounceAdmin: &bindingtypes.RenounceAdmin{
+				Denom: denom,
+			},
+			actor: tokenCreator,
+		},
+		"creator is a different address": {
+			renounceAdmin: &bindingtypes.RenounceAdmin{
+				Denom: denom,
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "renouncing admin from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: renounce admin null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformRenounceAdmin(&app.TokenFactoryKeeper, ctx, spec.actor, spec.renounceAdmin)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// The admin query reports the denom as immutable
+			queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+			adminRes, err := queryPlugin.GetTokenfactoryDenomAdmin(ctx, denom)
+			require.NoError(t, err)
+			require.Equal(t, &bindingtypes.AdminResponse{Admin: "", Immutable: true}, adminRes)
+
+			// The denom can't be minted anymore
+			err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, tokenCreator, &bindingtypes.MintTokens{
+				Denom:         denom,
+				Amount:        sdkmath.NewInt(100),
+				MintToAddress: tokenCreator.String(),
+			})
+			require.ErrorIs(t, err, types.ErrDenomImmutable)
+		})
+	}
+}
+
+func TestMultiMint(t *testing.T) {
+	const validDenom = "validdenom"
+
+	tokenCreator := apptesting.RandomAccountAddress()
+	firstRecipient := apptesting.RandomAccountAddress()
+	secondRecipient := apptesting.RandomAccountAddress()
+	denom := fmt.Sprintf("factory/%s/%s", tokenCreator.String(), validDenom)
+
+	specs := map[string]struct {
+		actor     sdk.AccAddress
+		multiMint *bindingtypes.MultiMint
+
+		expErrMsg string
+	}{
+		"valid": {
+			multiMint: &bindingtypes.MultiMint{
+				Denom: denom,
+				Recipients: []bindingtypes.MintRecipient{
+					{Address: firstRecipient.String(), Amount: sdkmath.NewInt(100)},
+					{Address: secondRecipient.String(), Amount: sdkmath.NewInt(200)},
+				},
+			},
+			actor: tokenCreator,
+		},
+		"creator is a different address": {
+			multiMint: &bindingtypes.MultiMint{
+				Denom: denom,
+				Recipients: []bindingtypes.MintRecipient{
+					{Address: firstRecipient.String(), Amount: sdkmath.NewInt(100)},
+				},
+			},
+			actor:     apptesting.RandomAccountAddress(),
+			expErrMsg: "multi minting coins from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     tokenCreator,
+			expErrMsg: "invalid request: multi mint null - original request: ",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, tokenCreator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, tokenCreator, actorAmount)
+
+			_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, ctx, tokenCreator, &bindingtypes.CreateDenom{
+				Subdenom: validDenom,
+			})
+			require.NoError(t, err)
+
+			err = wasmbinding.PerformMultiMint(&app.TokenFactoryKeeper, ctx, spec.actor, spec.multiMint)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "100", app.BankKeeper.GetBalance(ctx, firstRecipient, denom).Amount.String())
+			require.Equal(t, "200", app.BankKeeper.GetBalance(ctx, secondRecipient, denom).Amount.String())
+		})
+	}
+}
+
+// TestSetMetadataERC20 tests that the metadata set by a contract is propagated to the ERC20 token pair
+func TestSetMetadataERC20(t *testing.T) {
+	creator := apptesting.RandomAccountAddress()
+	other := apptesting.RandomAccountAddress()
+
+	specs := map[string]struct {
+		actor     sdk.AccAddress
+		exponent  uint32
+		expErr    error
+		expEvents int
+	}{
+		"valid metadata": {
+			actor:     creator,
+			exponent:  8,
+			expEvents: 1,
+		},
+		"decimals overflowing the erc20": {
+			actor:    creator,
+			exponent: 256,
+			expErr:   types.ErrInvalidERC20Metadata,
+		},
+		"not a metadata manager": {
+			actor:    other,
+			exponent: 8,
+			expErr:   types.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			app, ctx := helpers.SetupCustomApp(t, creator)
+
+			// Fund actor with 100 base denom creation fees
+			actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+			helpers.FundAccount(t, ctx, app, creator, actorAmount)
+
+			// Create a denom with an ERC20 token pair
+			params := app.TokenFactoryKeeper.GetParams(ctx)
+			params.EnableErc20AutoRegistration = true
+			require.NoError(t, app.TokenFactoryKeeper.SetParams(ctx, params))
+			msg := types.NewMsgCreateDenom(creator.String(), "bitcoin")
+			msg.RegisterErc20 = true
+			res, err := keeper.NewMsgServerImpl(app.TokenFactoryKeeper).CreateDenom(ctx, msg)
+			require.NoError(t, err)
+			denom := res.GetNewTokenDenom()
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err = wasmbinding.PerformSetMetadata(&app.TokenFactoryKeeper, ctx, spec.actor, denom, bindingtypes.Metadata{
+				Description: "Bitcoin",
+				DenomUnits: []bindingtypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: "BTC", Exponent: spec.exponent},
+				},
+				Display: "BTC",
+				Name:    "Bitcoin",
+				Symbol:  "BTC",
+			})
+			if spec.expErr != nil {
+				require.ErrorIs(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The metadata is stored and propagated to the ERC20 token pair
+			metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+			require.True(t, found)
+			require.Equal(t, "BTC", metadata.Symbol)
+			events := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeERC20MetadataUpdated {
+					events++
+				}
+			}
+			require.Equal(t, spec.expEvents, events)
+		})
+	}
+}
@@ -10,11 +10,13 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	tfbindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory/types"
 	"github.com/kiichain/kiichain/v3/wasmbinding/utils"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
 
 // QueryPlugin is a custom query plugin for the wasm module for the token factory
@@ -112,6 +114,31 @@ func (qp *QueryPlugin) HandleTokenFactoryQuery(ctx sdk.Context, tokenfactoryQuer
 
 		return bz, nil
 
+		// The query is a before send hook address query
+	case tokenfactoryQuery.BeforeSendHookAddress != nil:
+		res := qp.GetTokenfactoryBeforeSendHookAddress(ctx, tokenfactoryQuery.BeforeSendHookAddress.Denom)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookAddressResponse: %w", err)
+		}
+
+		return bz, nil
+
+		// The query is an all denoms query
+	case tokenfactoryQuery.AllDenoms != nil:
+		res, err := qp.GetTokenfactoryAllDenoms(ctx, tokenfactoryQuery.AllDenoms)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal AllDenomsResponse: %w", err)
+		}
+
+		return bz, nil
+
 	default:
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token factory query variant"}
 	}
@@ -123,7 +150,7 @@ func (qp QueryPlugin) GetTokenfactoryDenomAdmin(ctx context.Context, denom strin
 	if err != nil {
 		return nil, fmt.Errorf("failed to get admin for denom: %s", denom)
 	}
-	return &tfbindingtypes.AdminResponse{Admin: metadata.Admin}, nil
+	return &tfbindingtypes.AdminResponse{Admin: metadata.Admin, Immutable: metadata.Immutable}, nil
 }
 
 // GetTokenfactoryDenomsByCreator is a query to get denoms by creator
@@ -145,10 +172,48 @@ func (qp QueryPlugin) GetTokenfactoryMetadata(ctx context.Context, denom string)
 
 // GetTokenfactoryParams is a query to get token factory params
 func (qp QueryPlugin) GetTokenfactoryParams(ctx context.Context) (*tfbindingtypes.ParamsResponse, error) {
-	params := qp.tokenFactoryKeeper.GetParams(sdk.UnwrapSDKContext(ctx))
+	// Contracts get the fee actually charged, converted from the quote fee if set
+	denomCreationFee := qp.tokenFactoryKeeper.GetDenomCreationFee(sdk.UnwrapSDKContext(ctx))
 	return &tfbindingtypes.ParamsResponse{
 		Params: tfbindingtypes.Params{
-			DenomCreationFee: utils.ConvertSdkCoinsToWasmCoins(params.DenomCreationFee),
+			DenomCreationFee: utils.ConvertSdkCoinsToWasmCoins(denomCreationFee),
 		},
 	}, nil
 }
+
+// GetTokenfactoryBeforeSendHookAddress is a query to get the before send hook of a denom
+func (qp QueryPlugin) GetTokenfactoryBeforeSendHookAddress(ctx context.Context, denom string) *tfbindingtypes.BeforeSendHookAddressResponse {
+	cosmwasmAddress := qp.tokenFactoryKeeper.GetBeforeSendHook(sdk.UnwrapSDKContext(ctx), denom)
+	return &tfbindingtypes.BeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}
+}
+
+// GetTokenfactoryAllDenoms is a query to get a page of all the factory denoms
+func (qp QueryPlugin) GetTokenfactoryAllDenoms(ctx context.Context, allDenoms *tfbindingtypes.AllDenoms) (*tfbindingtypes.AllDenomsResponse, error) {
+	res, err := qp.tokenFactoryKeeper.AllDenoms(ctx, &tokenfactorytypes.QueryAllDenomsRequest{
+		Pagination: &query.PageRequest{
+			Key:   allDenoms.Key,
+			Limit: allDenoms.Limit,
+		},
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "kii all denoms query")
+	}
+
+	denoms := make([]tfbindingtypes.DenomInfo, 0, len(res.Denoms))
+	for _, denomInfo := range res.Denoms {
+		var metadata *tfbindingtypes.Metadata
+		if denomInfo.Metadata.Base != "" {
+			metadata = SdkMetadataToWasm(denomInfo.Metadata)
+		}
+
+		denoms = append(denoms, tfbindingtypes.DenomInfo{
+			Denom:     denomInfo.Denom,
+			Metadata:  metadata,
+			Admin:     denomInfo.AuthorityMetadata.Admin,
+			Immutable: denomInfo.AuthorityMetadata.Immutable,
+			Supply:    utils.ConvertSdkCoinToWasmCoin(denomInfo.Supply),
+		})
+	}
+
+	return &tfbindingtypes.AllDenomsResponse{Denoms: denoms, NextKey: res.Pagination.NextKey}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/kiichain/kiichain/v3/app/apptesting"
 	"github.com/kiichain/kiichain/v3/wasmbinding/helpers"
 	wasmbinding "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory"
+	bindingtypes "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory/types"
 )
 
 // TestFullDenom tests the GetFullDenom function of the token factory
@@ -119,3 +120,45 @@ func TestDenomAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestAllDenoms(t *testing.T) {
+	addr := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, addr)
+
+	// set token creation fee to zero to make testing easier
+	tfParams := app.TokenFactoryKeeper.GetParams(ctx)
+	tfParams.DenomCreationFee = sdk.NewCoins()
+	if err := app.TokenFactoryKeeper.SetParams(ctx, tfParams); err != nil {
+		t.Fatal(err)
+	}
+
+	// create a few subdenoms via the token factory
+	admin := sdk.AccAddress([]byte("addr1_______________"))
+	for _, subdenom := range []string{"alpha", "beta", "gamma"} {
+		_, err := app.TokenFactoryKeeper.CreateDenom(ctx, admin.String(), subdenom)
+		require.NoError(t, err)
+	}
+
+	queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+
+	// The first page is limited
+	resp, err := queryPlugin.GetTokenfactoryAllDenoms(ctx, &bindingtypes.AllDenoms{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Denoms, 2)
+	require.NotEmpty(t, resp.NextKey)
+
+	denom := resp.Denoms[0]
+	require.Equal(t, fmt.Sprintf("factory/%s/alpha", admin.String()), denom.Denom)
+	require.Equal(t, admin.String(), denom.Admin)
+	require.False(t, denom.Immutable)
+	require.NotNil(t, denom.Metadata)
+	require.Equal(t, denom.Denom, denom.Metadata.Base)
+	require.Equal(t, "0", denom.Supply.Amount)
+
+	// The next page has the remaining denom
+	resp, err = queryPlugin.GetTokenfactoryAllDenoms(ctx, &bindingtypes.AllDenoms{Key: resp.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Denoms, 1)
+	require.Empty(t, resp.NextKey)
+	require.Equal(t, fmt.Sprintf("factory/%s/gamma", admin.String()), resp.Denoms[0].Denom)
+}
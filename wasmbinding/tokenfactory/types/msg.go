@@ -19,6 +19,22 @@ type Msg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Sets the contract called before each send of a denom which the contract controls.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Freezes an account for a denom which the contract controls.
+	FreezeAccount *FreezeAccount `json:"freeze_account,omitempty"`
+	/// Unfreezes an account for a denom which the contract controls.
+	UnfreezeAccount *UnfreezeAccount `json:"unfreeze_account,omitempty"`
+	/// Pauses or unpauses all the transfers of a denom which the contract controls.
+	PauseDenom *PauseDenom `json:"pause_denom,omitempty"`
+	/// Grants a role of a denom which the contract controls.
+	GrantRole *GrantRole `json:"grant_role,omitempty"`
+	/// Revokes a role of a denom which the contract controls.
+	RevokeRole *RevokeRole `json:"revoke_role,omitempty"`
+	/// Permanently renounces the admin of a denom which the contract controls.
+	RenounceAdmin *RenounceAdmin `json:"renounce_admin,omitempty"`
+	/// Mints a denom which the contract can mint to many recipients at once.
+	MultiMint *MultiMint `json:"multi_mint,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -62,3 +78,58 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+// SetBeforeSendHook sets the before send hook contract for a factory denom.
+// If the CosmwasmAddress is empty, the hook is removed.
+type SetBeforeSendHook struct {
+	Denom           string `json:"denom"`
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+// FreezeAccount freezes an account so it can't send or receive a factory denom.
+type FreezeAccount struct {
+	Denom   string `json:"denom"`
+	Account string `json:"account"`
+}
+
+// UnfreezeAccount unfreezes an account frozen for a factory denom.
+type UnfreezeAccount struct {
+	Denom   string `json:"denom"`
+	Account string `json:"account"`
+}
+
+// PauseDenom pauses or unpauses all the transfers of a factory denom.
+type PauseDenom struct {
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+// GrantRole grants a role (minter, burner, metadata or force_transfer) of a factory denom to an address.
+type GrantRole struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
+// RevokeRole revokes a role of a factory denom from an address.
+type RevokeRole struct {
+	Denom   string `json:"denom"`
+	Role    string `json:"role"`
+	Address string `json:"address"`
+}
+
+// RenounceAdmin permanently renounces the admin of a factory denom, making it immutable.
+type RenounceAdmin struct {
+	Denom string `json:"denom"`
+}
+
+// MultiMint mints a factory denom to many recipients with a single mint.
+type MultiMint struct {
+	Denom      string          `json:"denom"`
+	Recipients []MintRecipient `json:"recipients"`
+}
+
+type MintRecipient struct {
+	Address string   `json:"address"`
+	Amount  math.Int `json:"amount"`
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type Query struct {
 	/// Given a subdenom minted by a contract via `KiiMsg::MintTokens`,
@@ -9,6 +13,10 @@ type Query struct {
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	/// Returns the before send hook contract of a denom, empty if unset.
+	BeforeSendHookAddress *BeforeSendHookAddress `json:"before_send_hook_address,omitempty"`
+	/// Returns a page of all the factory denoms with their metadata, admin and supply.
+	AllDenoms *AllDenoms `json:"all_denoms,omitempty"`
 }
 
 // query types
@@ -32,6 +40,17 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type BeforeSendHookAddress struct {
+	Denom string `json:"denom"`
+}
+
+// AllDenoms pages through the factory denoms, key is the next_key of the previous
+// page and limit defaults to 100 when zero
+type AllDenoms struct {
+	Key   []byte `json:"key,omitempty"`
+	Limit uint64 `json:"limit,omitempty"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -39,7 +58,8 @@ type FullDenomResponse struct {
 }
 
 type AdminResponse struct {
-	Admin string `json:"admin"`
+	Admin     string `json:"admin"`
+	Immutable bool   `json:"immutable"`
 }
 
 type MetadataResponse struct {
@@ -53,3 +73,20 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type BeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `json:"cosmwasm_address"`
+}
+
+type DenomInfo struct {
+	Denom     string           `json:"denom"`
+	Metadata  *Metadata        `json:"metadata,omitempty"`
+	Admin     string           `json:"admin"`
+	Immutable bool             `json:"immutable"`
+	Supply    wasmvmtypes.Coin `json:"supply"`
+}
+
+type AllDenomsResponse struct {
+	Denoms  []DenomInfo `json:"denoms"`
+	NextKey []byte      `json:"next_key,omitempty"`
+}
@@ -10,8 +10,10 @@ import (
 	"github.com/kiichain/kiichain/v3/wasmbinding/bech32"
 	evmwasmbinding "github.com/kiichain/kiichain/v3/wasmbinding/evm"
 	"github.com/kiichain/kiichain/v3/wasmbinding/oracle"
+	"github.com/kiichain/kiichain/v3/wasmbinding/rewards"
 	tfbinding "github.com/kiichain/kiichain/v3/wasmbinding/tokenfactory"
 	oraclekeeper "github.com/kiichain/kiichain/v3/x/oracle/keeper"
+	rewardskeeper "github.com/kiichain/kiichain/v3/x/rewards/keeper"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
 )
 
@@ -21,12 +23,14 @@ func RegisterCustomPlugins(
 	tokenFactory *tokenfactorykeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	rewardsKeeper rewardskeeper.Keeper,
 ) []wasmkeeper.Option {
 	// Register custom query plugins
 	tokenFactoryQueryPlugin := tfbinding.NewQueryPlugin(bank, tokenFactory)
 	evmQueryPlugin := evmwasmbinding.NewQueryPlugin(evmKeeper)
 	bech32QueryPlugin := bech32.NewQueryPlugin()
 	oracleQueryPlugin := oracle.NewQueryPlugin(oracleKeeper)
+	rewardsQueryPlugin := rewards.NewQueryPlugin(rewardsKeeper)
 
 	// Create the central query plugin
 	queryPlugin := NewQueryPlugin(
@@ -34,6 +38,7 @@ func RegisterCustomPlugins(
 		evmQueryPlugin,
 		bech32QueryPlugin,
 		oracleQueryPlugin,
+		rewardsQueryPlugin,
 	)
 
 	// Register custom message handler decorators
@@ -44,9 +49,12 @@ func RegisterCustomPlugins(
 	// Create the custom messenger to the token factory
 	tokenFactoryMessenger := tfbinding.NewCustomMessenger(bank, tokenFactory)
 
+	// Create the custom messenger to the rewards module
+	rewardsMessenger := rewards.NewCustomMessenger(rewardsKeeper)
+
 	// Initialize the decorator for the custom messenger
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactoryMessenger),
+		CustomMessageDecorator(bank, tokenFactoryMessenger, rewardsMessenger),
 	)
 
 	// Register custom message handlers
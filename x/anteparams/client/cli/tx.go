@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Governance ante transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewUpdateParamsCmd(),
+		NewAddExpeditedMsgTypeCmd(),
+		NewRemoveExpeditedMsgTypeCmd(),
+		NewAddAuthzBlockedMsgTypeCmd(),
+		NewRemoveAuthzBlockedMsgTypeCmd(),
+		NewAddGrantBlockedMsgTypeCmd(),
+		NewRemoveGrantBlockedMsgTypeCmd(),
+	)
+
+	return cmd
+}
+
+// NewUpdateParamsCmd implements the update-params tx command.
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-json]",
+		Short: "Update module parameters (gov proposal)",
+		Long: `Update module parameters through a governance proposal. Example:
+$ %s tx gov submit-proposal update-anteparams-params <path/to/params.json> --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &params); err != nil {
+				return fmt.Errorf("failed to parse params: %w", err)
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddExpeditedMsgTypeCmd implements the add-expedited-msg-type tx command.
+func NewAddExpeditedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-expedited-msg-type [msg-type-url]",
+		Short: "Allow a message type on expedited proposals (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddExpeditedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveExpeditedMsgTypeCmd implements the remove-expedited-msg-type tx command.
+func NewRemoveExpeditedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-expedited-msg-type [msg-type-url]",
+		Short: "Remove a message type from the expedited proposals whitelist (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveExpeditedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddAuthzBlockedMsgTypeCmd implements the add-authz-blocked-msg-type tx command.
+func NewAddAuthzBlockedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-authz-blocked-msg-type [msg-type-url]",
+		Short: "Block a message type inside authz MsgExec (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAuthzBlockedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveAuthzBlockedMsgTypeCmd implements the remove-authz-blocked-msg-type tx command.
+func NewRemoveAuthzBlockedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-authz-blocked-msg-type [msg-type-url]",
+		Short: "Allow a blocked message type inside authz MsgExec again (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveAuthzBlockedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddGrantBlockedMsgTypeCmd implements the add-grant-blocked-msg-type tx command.
+func NewAddGrantBlockedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-grant-blocked-msg-type [msg-type-url]",
+		Short: "Forbid authz grants of a message type (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddGrantBlockedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveGrantBlockedMsgTypeCmd implements the remove-grant-blocked-msg-type tx command.
+func NewRemoveGrantBlockedMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-grant-blocked-msg-type [msg-type-url]",
+		Short: "Allow authz grants of a blocked message type again (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveGrantBlockedMsgType(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
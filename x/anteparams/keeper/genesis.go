@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// InitGenesis sets anteparams information from genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, msgTypeURL := range data.ExpeditedMsgTypeUrls {
+		if err := k.ExpeditedWhitelist.Set(ctx, msgTypeURL); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, msgTypeURL := range data.AuthzBlockedMsgTypeUrls {
+		if err := k.AuthzBlockedMsgTypes.Set(ctx, msgTypeURL); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, msgTypeURL := range data.GrantBlockedMsgTypeUrls {
+		if err := k.GrantBlockedMsgTypes.Set(ctx, msgTypeURL); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	expeditedMsgTypeURLs := mustKeys(ctx, k.ExpeditedWhitelist)
+	authzBlockedMsgTypeURLs := mustKeys(ctx, k.AuthzBlockedMsgTypes)
+	grantBlockedMsgTypeURLs := mustKeys(ctx, k.GrantBlockedMsgTypes)
+
+	return types.NewGenesisState(params, expeditedMsgTypeURLs, authzBlockedMsgTypeURLs, grantBlockedMsgTypeURLs)
+}
+
+// mustKeys returns all the keys of a key set
+func mustKeys(ctx sdk.Context, keySet collections.KeySet[string]) []string {
+	iter, err := keySet.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		panic(err)
+	}
+
+	return keys
+}
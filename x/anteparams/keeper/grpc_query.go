@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries params of anteparams module
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ExpeditedWhitelist queries the message types allowed on expedited proposals
+func (k Querier) ExpeditedWhitelist(ctx context.Context, req *types.QueryExpeditedWhitelistRequest) (*types.QueryExpeditedWhitelistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgTypeURLs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.ExpeditedWhitelist,
+		req.Pagination,
+		func(msgTypeURL string, _ collections.NoValue) (string, error) {
+			return msgTypeURL, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpeditedWhitelistResponse{MsgTypeUrls: msgTypeURLs, Pagination: pageRes}, nil
+}
+
+// AuthzBlockedMsgTypes queries the message types that cannot be executed through an authz MsgExec
+func (k Querier) AuthzBlockedMsgTypes(ctx context.Context, req *types.QueryAuthzBlockedMsgTypesRequest) (*types.QueryAuthzBlockedMsgTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgTypeURLs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.AuthzBlockedMsgTypes,
+		req.Pagination,
+		func(msgTypeURL string, _ collections.NoValue) (string, error) {
+			return msgTypeURL, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuthzBlockedMsgTypesResponse{MsgTypeUrls: msgTypeURLs, Pagination: pageRes}, nil
+}
+
+// GrantBlockedMsgTypes queries the message types that cannot be granted through an authz MsgGrant
+func (k Querier) GrantBlockedMsgTypes(ctx context.Context, req *types.QueryGrantBlockedMsgTypesRequest) (*types.QueryGrantBlockedMsgTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	msgTypeURLs, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.GrantBlockedMsgTypes,
+		req.Pagination,
+		func(msgTypeURL string, _ collections.NoValue) (string, error) {
+			return msgTypeURL, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGrantBlockedMsgTypesResponse{MsgTypeUrls: msgTypeURLs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// TestQueries tests the anteparams queries
+func (suite *KeeperTestSuite) TestQueries() {
+	// Default params are set from genesis
+	paramsRes, err := suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	// Updated params are returned
+	params := types.NewParams(math.NewInt(10), 1, true, false, true, 2, types.DefaultMinCommissionRate)
+	suite.Require().NoError(suite.App.AnteParamsKeeper.Params.Set(suite.Ctx, params))
+	paramsRes, err = suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, paramsRes.Params)
+
+	// The default whitelist is set from genesis
+	whitelistRes, err := suite.queryClient.ExpeditedWhitelist(suite.Ctx, &types.QueryExpeditedWhitelistRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(types.DefaultExpeditedMsgTypeURLs(), whitelistRes.MsgTypeUrls)
+
+	// The whitelist can be paginated
+	whitelistRes, err = suite.queryClient.ExpeditedWhitelist(suite.Ctx, &types.QueryExpeditedWhitelistRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(whitelistRes.MsgTypeUrls, 1)
+	suite.Require().Equal(uint64(2), whitelistRes.Pagination.Total)
+
+	// The default authz policy is set from genesis
+	authzBlockedRes, err := suite.queryClient.AuthzBlockedMsgTypes(suite.Ctx, &types.QueryAuthzBlockedMsgTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(types.DefaultAuthzBlockedMsgTypeURLs(), authzBlockedRes.MsgTypeUrls)
+
+	grantBlockedRes, err := suite.queryClient.GrantBlockedMsgTypes(suite.Ctx, &types.QueryGrantBlockedMsgTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(types.DefaultGrantBlockedMsgTypeURLs(), grantBlockedRes.MsgTypeUrls)
+}
+
+// TestGenesis tests the genesis import and export
+func (suite *KeeperTestSuite) TestGenesis() {
+	params := types.NewParams(math.NewInt(10), 1, true, false, false, 2, types.DefaultMinCommissionRate)
+	suite.Require().NoError(suite.App.AnteParamsKeeper.Params.Set(suite.Ctx, params))
+	freezeURL := "/kiichain.tokenfactory.v1beta1.MsgFreezeAccount"
+	suite.Require().NoError(suite.App.AnteParamsKeeper.ExpeditedWhitelist.Set(suite.Ctx, freezeURL))
+	suite.Require().NoError(suite.App.AnteParamsKeeper.AuthzBlockedMsgTypes.Set(suite.Ctx, freezeURL))
+	suite.Require().NoError(suite.App.AnteParamsKeeper.GrantBlockedMsgTypes.Set(suite.Ctx, freezeURL))
+
+	exported := suite.App.AnteParamsKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(params, exported.Params)
+	suite.Require().ElementsMatch(append(types.DefaultExpeditedMsgTypeURLs(), freezeURL), exported.ExpeditedMsgTypeUrls)
+	suite.Require().ElementsMatch(append(types.DefaultAuthzBlockedMsgTypeURLs(), freezeURL), exported.AuthzBlockedMsgTypeUrls)
+	suite.Require().ElementsMatch(append(types.DefaultGrantBlockedMsgTypeURLs(), freezeURL), exported.GrantBlockedMsgTypeUrls)
+
+	// Import on a fresh state
+	suite.SetupTest()
+	suite.App.AnteParamsKeeper.InitGenesis(suite.Ctx, *exported)
+	suite.Require().Equal(exported, suite.App.AnteParamsKeeper.ExportGenesis(suite.Ctx))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+type (
+	Keeper struct {
+		cdc codec.BinaryCodec
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		Schema collections.Schema
+		Params collections.Item[types.Params]
+		// ExpeditedWhitelist holds the message types allowed on expedited proposals
+		ExpeditedWhitelist collections.KeySet[string]
+		// AuthzBlockedMsgTypes holds the message types that cannot be executed through an authz MsgExec
+		AuthzBlockedMsgTypes collections.KeySet[string]
+		// GrantBlockedMsgTypes holds the message types that cannot be granted through an authz MsgGrant
+		GrantBlockedMsgTypes collections.KeySet[string]
+	}
+)
+
+// NewKeeper returns a new instance of the x/anteparams keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc: cdc,
+
+		authority: authority,
+
+		Params:               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ExpeditedWhitelist:   collections.NewKeySet(sb, types.ExpeditedWhitelistKey, "expedited_whitelist", collections.StringKey),
+		AuthzBlockedMsgTypes: collections.NewKeySet(sb, types.AuthzBlockedKey, "authz_blocked_msg_types", collections.StringKey),
+		GrantBlockedMsgTypes: collections.NewKeySet(sb, types.GrantBlockedKey, "grant_blocked_msg_types", collections.StringKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/anteparams module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/anteparams module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsExpeditedAllowed checks if a message type can be included on expedited proposals
+func (k Keeper) IsExpeditedAllowed(ctx context.Context, msgTypeURL string) (bool, error) {
+	return k.ExpeditedWhitelist.Has(ctx, msgTypeURL)
+}
+
+// IsAuthzBlocked checks if a message type cannot be executed through an authz MsgExec
+func (k Keeper) IsAuthzBlocked(ctx context.Context, msgTypeURL string) (bool, error) {
+	return k.AuthzBlockedMsgTypes.Has(ctx, msgTypeURL)
+}
+
+// IsGrantBlocked checks if a message type cannot be granted through an authz MsgGrant.
+// Message types blocked inside MsgExec can't be granted either, since the grant could never be used
+func (k Keeper) IsGrantBlocked(ctx context.Context, msgTypeURL string) (bool, error) {
+	blocked, err := k.GrantBlockedMsgTypes.Has(ctx, msgTypeURL)
+	if err != nil || blocked {
+		return blocked, err
+	}
+
+	return k.IsAuthzBlocked(ctx, msgTypeURL)
+}
+
+// validateAuthority checks if address authority is valid and same as expected
+func (k Keeper) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/anteparams/keeper"
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.AnteParamsKeeper)
+}
+
+// TestIsGrantBlocked tests that msg types blocked inside authz exec can't be granted either
+func (suite *KeeperTestSuite) TestIsGrantBlocked() {
+	k := suite.App.AnteParamsKeeper
+
+	for _, msgTypeURL := range append(types.DefaultAuthzBlockedMsgTypeURLs(), types.DefaultGrantBlockedMsgTypeURLs()...) {
+		blocked, err := k.IsGrantBlocked(suite.Ctx, msgTypeURL)
+		suite.Require().NoError(err)
+		suite.Require().True(blocked, msgTypeURL)
+	}
+
+	// Grant blocked msg types can still be executed by existing grants
+	for _, msgTypeURL := range types.DefaultGrantBlockedMsgTypeURLs() {
+		blocked, err := k.IsAuthzBlocked(suite.Ctx, msgTypeURL)
+		suite.Require().NoError(err)
+		suite.Require().False(blocked, msgTypeURL)
+	}
+
+	blocked, err := k.IsGrantBlocked(suite.Ctx, "/cosmos.bank.v1beta1.MsgSend")
+	suite.Require().NoError(err)
+	suite.Require().False(blocked)
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the anteparams MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams validates a MsgUpdateParams and sets the new params
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddExpeditedMsgType allows a message type on expedited proposals
+func (k msgServer) AddExpeditedMsgType(ctx context.Context, msg *types.MsgAddExpeditedMsgType) (*types.MsgAddExpeditedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMsgTypeURL(msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	found, err := k.ExpeditedWhitelist.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrExpeditedMsgTypeExists.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.ExpeditedWhitelist.Set(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddExpeditedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgAddExpeditedMsgTypeResponse{}, nil
+}
+
+// RemoveExpeditedMsgType removes a message type from the expedited proposals whitelist
+func (k msgServer) RemoveExpeditedMsgType(ctx context.Context, msg *types.MsgRemoveExpeditedMsgType) (*types.MsgRemoveExpeditedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.ExpeditedWhitelist.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrExpeditedMsgTypeNotFound.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.ExpeditedWhitelist.Remove(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveExpeditedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRemoveExpeditedMsgTypeResponse{}, nil
+}
+
+// AddAuthzBlockedMsgType blocks a message type inside authz MsgExec
+func (k msgServer) AddAuthzBlockedMsgType(ctx context.Context, msg *types.MsgAddAuthzBlockedMsgType) (*types.MsgAddAuthzBlockedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMsgTypeURL(msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	found, err := k.AuthzBlockedMsgTypes.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrAuthzMsgTypeBlocked.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.AuthzBlockedMsgTypes.Set(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddAuthzBlockedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgAddAuthzBlockedMsgTypeResponse{}, nil
+}
+
+// RemoveAuthzBlockedMsgType allows a blocked message type inside authz MsgExec again
+func (k msgServer) RemoveAuthzBlockedMsgType(ctx context.Context, msg *types.MsgRemoveAuthzBlockedMsgType) (*types.MsgRemoveAuthzBlockedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.AuthzBlockedMsgTypes.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrAuthzMsgTypeNotBlocked.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.AuthzBlockedMsgTypes.Remove(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthzBlockedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRemoveAuthzBlockedMsgTypeResponse{}, nil
+}
+
+// AddGrantBlockedMsgType forbids authz grants of a message type
+func (k msgServer) AddGrantBlockedMsgType(ctx context.Context, msg *types.MsgAddGrantBlockedMsgType) (*types.MsgAddGrantBlockedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateMsgTypeURL(msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	found, err := k.GrantBlockedMsgTypes.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrGrantMsgTypeBlocked.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.GrantBlockedMsgTypes.Set(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddGrantBlockedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgAddGrantBlockedMsgTypeResponse{}, nil
+}
+
+// RemoveGrantBlockedMsgType allows authz grants of a blocked message type again
+func (k msgServer) RemoveGrantBlockedMsgType(ctx context.Context, msg *types.MsgRemoveGrantBlockedMsgType) (*types.MsgRemoveGrantBlockedMsgTypeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.GrantBlockedMsgTypes.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrGrantMsgTypeNotBlocked.Wrap(msg.MsgTypeUrl)
+	}
+
+	if err := k.GrantBlockedMsgTypes.Remove(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveGrantBlockedMsgType,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRemoveGrantBlockedMsgTypeResponse{}, nil
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// TestUpdateParams tests changes to the params of the module
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name         string
+		msg          *types.MsgUpdateParams
+		expectedPass bool
+	}{
+		{
+			name: "valid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.App.AnteParamsKeeper.GetAuthority(),
+				types.NewParams(math.NewInt(5_000_000), 50, true, true, true, 2, types.DefaultMinCommissionRate),
+			),
+			expectedPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.TestAccs[0].String(),
+				types.DefaultParams(),
+			),
+			expectedPass: false,
+		},
+		{
+			name: "invalid params",
+			msg: types.NewMsgUpdateParams(
+				suite.App.AnteParamsKeeper.GetAuthority(),
+				types.NewParams(math.NewInt(-1), 50, false, false, true, 2, types.DefaultMinCommissionRate),
+			),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(suite.Ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify params were updated
+				params, err := suite.App.AnteParamsKeeper.Params.Get(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestAddAndRemoveExpeditedMsgType tests the governance management of the expedited whitelist
+func (suite *KeeperTestSuite) TestAddAndRemoveExpeditedMsgType() {
+	authority := suite.App.AnteParamsKeeper.GetAuthority()
+	freezeURL := "/kiichain.tokenfactory.v1beta1.MsgFreezeAccount"
+
+	testCases := []struct {
+		name   string
+		msg    func() any
+		errMsg string
+	}{
+		{
+			name:   "add - invalid authority",
+			msg:    func() any { return types.NewMsgAddExpeditedMsgType(suite.TestAccs[0].String(), freezeURL) },
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "add - invalid msg type url",
+			msg:    func() any { return types.NewMsgAddExpeditedMsgType(authority, "MsgFreezeAccount") },
+			errMsg: types.ErrInvalidMsgTypeURL.Error(),
+		},
+		{
+			name: "add - valid",
+			msg:  func() any { return types.NewMsgAddExpeditedMsgType(authority, freezeURL) },
+		},
+		{
+			name:   "add - already allowed",
+			msg:    func() any { return types.NewMsgAddExpeditedMsgType(authority, freezeURL) },
+			errMsg: types.ErrExpeditedMsgTypeExists.Error(),
+		},
+		{
+			name:   "remove - invalid authority",
+			msg:    func() any { return types.NewMsgRemoveExpeditedMsgType(suite.TestAccs[0].String(), freezeURL) },
+			errMsg: "invalid authority",
+		},
+		{
+			name: "remove - valid",
+			msg:  func() any { return types.NewMsgRemoveExpeditedMsgType(authority, freezeURL) },
+		},
+		{
+			name:   "remove - not allowed",
+			msg:    func() any { return types.NewMsgRemoveExpeditedMsgType(authority, freezeURL) },
+			errMsg: types.ErrExpeditedMsgTypeNotFound.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg().(type) {
+			case *types.MsgAddExpeditedMsgType:
+				_, err = suite.msgServer.AddExpeditedMsgType(suite.Ctx, msg)
+				if err == nil {
+					allowed, err := suite.App.AnteParamsKeeper.IsExpeditedAllowed(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().True(allowed)
+				}
+			case *types.MsgRemoveExpeditedMsgType:
+				_, err = suite.msgServer.RemoveExpeditedMsgType(suite.Ctx, msg)
+				if err == nil {
+					allowed, err := suite.App.AnteParamsKeeper.IsExpeditedAllowed(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().False(allowed)
+				}
+			}
+
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+// TestAuthzPolicyMsgTypes tests the governance management of the authz blocked msg types
+func (suite *KeeperTestSuite) TestAuthzPolicyMsgTypes() {
+	authority := suite.App.AnteParamsKeeper.GetAuthority()
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name   string
+		msg    func() any
+		errMsg string
+	}{
+		{
+			name:   "add authz blocked - invalid authority",
+			msg:    func() any { return types.NewMsgAddAuthzBlockedMsgType(suite.TestAccs[0].String(), sendURL) },
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "add authz blocked - invalid msg type url",
+			msg:    func() any { return types.NewMsgAddAuthzBlockedMsgType(authority, "MsgSend") },
+			errMsg: types.ErrInvalidMsgTypeURL.Error(),
+		},
+		{
+			name: "add authz blocked - valid",
+			msg:  func() any { return types.NewMsgAddAuthzBlockedMsgType(authority, sendURL) },
+		},
+		{
+			name:   "add authz blocked - already blocked",
+			msg:    func() any { return types.NewMsgAddAuthzBlockedMsgType(authority, sendURL) },
+			errMsg: types.ErrAuthzMsgTypeBlocked.Error(),
+		},
+		{
+			name: "remove authz blocked - valid",
+			msg:  func() any { return types.NewMsgRemoveAuthzBlockedMsgType(authority, sendURL) },
+		},
+		{
+			name:   "remove authz blocked - not blocked",
+			msg:    func() any { return types.NewMsgRemoveAuthzBlockedMsgType(authority, sendURL) },
+			errMsg: types.ErrAuthzMsgTypeNotBlocked.Error(),
+		},
+		{
+			name:   "add grant blocked - invalid authority",
+			msg:    func() any { return types.NewMsgAddGrantBlockedMsgType(suite.TestAccs[0].String(), sendURL) },
+			errMsg: "invalid authority",
+		},
+		{
+			name: "add grant blocked - valid",
+			msg:  func() any { return types.NewMsgAddGrantBlockedMsgType(authority, sendURL) },
+		},
+		{
+			name:   "add grant blocked - already blocked",
+			msg:    func() any { return types.NewMsgAddGrantBlockedMsgType(authority, sendURL) },
+			errMsg: types.ErrGrantMsgTypeBlocked.Error(),
+		},
+		{
+			name:   "remove grant blocked - invalid authority",
+			msg:    func() any { return types.NewMsgRemoveGrantBlockedMsgType(suite.TestAccs[0].String(), sendURL) },
+			errMsg: "invalid authority",
+		},
+		{
+			name: "remove grant blocked - valid",
+			msg:  func() any { return types.NewMsgRemoveGrantBlockedMsgType(authority, sendURL) },
+		},
+		{
+			name:   "remove grant blocked - not blocked",
+			msg:    func() any { return types.NewMsgRemoveGrantBlockedMsgType(authority, sendURL) },
+			errMsg: types.ErrGrantMsgTypeNotBlocked.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg().(type) {
+			case *types.MsgAddAuthzBlockedMsgType:
+				_, err = suite.msgServer.AddAuthzBlockedMsgType(suite.Ctx, msg)
+				if err == nil {
+					blocked, err := suite.App.AnteParamsKeeper.IsAuthzBlocked(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().True(blocked)
+				}
+			case *types.MsgRemoveAuthzBlockedMsgType:
+				_, err = suite.msgServer.RemoveAuthzBlockedMsgType(suite.Ctx, msg)
+				if err == nil {
+					blocked, err := suite.App.AnteParamsKeeper.IsAuthzBlocked(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().False(blocked)
+				}
+			case *types.MsgAddGrantBlockedMsgType:
+				_, err = suite.msgServer.AddGrantBlockedMsgType(suite.Ctx, msg)
+				if err == nil {
+					blocked, err := suite.App.AnteParamsKeeper.IsGrantBlocked(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().True(blocked)
+				}
+			case *types.MsgRemoveGrantBlockedMsgType:
+				_, err = suite.msgServer.RemoveGrantBlockedMsgType(suite.Ctx, msg)
+				if err == nil {
+					blocked, err := suite.App.AnteParamsKeeper.IsGrantBlocked(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(err)
+					suite.Require().False(blocked)
+				}
+			}
+
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+// DefaultAuthzBlockedMsgTypeURLs returns the message types that cannot be executed through an authz MsgExec by default
+func DefaultAuthzBlockedMsgTypeURLs() []string {
+	return []string{
+		"/cosmos.evm.vm.v1.MsgEthereumTx",
+		"/cosmos.vesting.v1beta1.MsgCreateVestingAccount",
+	}
+}
+
+// DefaultGrantBlockedMsgTypeURLs returns the message types that cannot be granted through an authz MsgGrant by default
+func DefaultGrantBlockedMsgTypeURLs() []string {
+	return []string{
+		"/kiichain.tokenfactory.v1beta1.MsgForceTransfer",
+	}
+}
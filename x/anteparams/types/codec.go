@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces register interfaces into the app
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	// Register messages
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgAddExpeditedMsgType{},
+		&MsgRemoveExpeditedMsgType{},
+		&MsgAddAuthzBlockedMsgType{},
+		&MsgRemoveAuthzBlockedMsgType{},
+		&MsgAddGrantBlockedMsgType{},
+		&MsgRemoveGrantBlockedMsgType{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/anteparams interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "anteparams/update-params", nil)
+	cdc.RegisterConcrete(&MsgAddExpeditedMsgType{}, "anteparams/add-expedited-msg-type", nil)
+	cdc.RegisterConcrete(&MsgRemoveExpeditedMsgType{}, "anteparams/remove-expedited-msg-type", nil)
+	cdc.RegisterConcrete(&MsgAddAuthzBlockedMsgType{}, "anteparams/add-authz-blocked-msg-type", nil)
+	cdc.RegisterConcrete(&MsgRemoveAuthzBlockedMsgType{}, "anteparams/remove-authz-blocked-msg-type", nil)
+	cdc.RegisterConcrete(&MsgAddGrantBlockedMsgType{}, "anteparams/add-grant-blocked-msg-type", nil)
+	cdc.RegisterConcrete(&MsgRemoveGrantBlockedMsgType{}, "anteparams/remove-grant-blocked-msg-type", nil)
+}
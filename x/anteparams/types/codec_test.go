@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (suite *CodecTestSuite) TestRegisterInterfaces() {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface(sdk.MsgInterfaceProtoName, (*sdk.Msg)(nil))
+	RegisterInterfaces(registry)
+
+	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	suite.Require().Equal(7, len(impls))
+	suite.Require().ElementsMatch([]string{
+		"/kiichain.anteparams.v1beta1.MsgUpdateParams",
+		"/kiichain.anteparams.v1beta1.MsgAddExpeditedMsgType",
+		"/kiichain.anteparams.v1beta1.MsgRemoveExpeditedMsgType",
+		"/kiichain.anteparams.v1beta1.MsgAddAuthzBlockedMsgType",
+		"/kiichain.anteparams.v1beta1.MsgRemoveAuthzBlockedMsgType",
+		"/kiichain.anteparams.v1beta1.MsgAddGrantBlockedMsgType",
+		"/kiichain.anteparams.v1beta1.MsgRemoveGrantBlockedMsgType",
+	}, impls)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	xerrors "github.com/kiichain/kiichain/v3/x/types/errors"
+)
+
+// ValidateMinCommission rejects validators created or edited with a commission rate below the minimum
+// It is shared by the ante handler and the staking precompile, other messages are ignored
+func ValidateMinCommission(msg sdk.Msg, minRate math.LegacyDec) error {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		// The max rate is checked too, otherwise the validator could never comply with the minimum
+		if msg.Commission.MaxRate.LT(minRate) {
+			return errorsmod.Wrapf(xerrors.ErrCommissionTooLow, "max commission rate %s is below the minimum %s", msg.Commission.MaxRate, minRate)
+		}
+		if msg.Commission.Rate.LT(minRate) {
+			return errorsmod.Wrapf(xerrors.ErrCommissionTooLow, "commission rate %s is below the minimum %s", msg.Commission.Rate, minRate)
+		}
+	case *stakingtypes.MsgEditValidator:
+		// A nil commission rate keeps the current one
+		if msg.CommissionRate != nil && msg.CommissionRate.LT(minRate) {
+			return errorsmod.Wrapf(xerrors.ErrCommissionTooLow, "commission rate %s is below the minimum %s", msg.CommissionRate, minRate)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/anteparams module sentinel errors
+var (
+	ErrInvalidMsgTypeURL        = errorsmod.Register(ModuleName, 2, "invalid msg type url")
+	ErrExpeditedMsgTypeExists   = errorsmod.Register(ModuleName, 3, "msg type already allowed on expedited proposals")
+	ErrExpeditedMsgTypeNotFound = errorsmod.Register(ModuleName, 4, "msg type not allowed on expedited proposals")
+	ErrAuthzMsgTypeBlocked      = errorsmod.Register(ModuleName, 5, "msg type already blocked inside authz exec")
+	ErrAuthzMsgTypeNotBlocked   = errorsmod.Register(ModuleName, 6, "msg type not blocked inside authz exec")
+	ErrGrantMsgTypeBlocked      = errorsmod.Register(ModuleName, 7, "msg type already blocked on authz grants")
+	ErrGrantMsgTypeNotBlocked   = errorsmod.Register(ModuleName, 8, "msg type not blocked on authz grants")
+)
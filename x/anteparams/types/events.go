@@ -0,0 +1,18 @@
+package types
+
+// Anteparams module event types
+const (
+	EventTypeAddExpeditedMsgType       = "add_expedited_msg_type"
+	EventTypeRemoveExpeditedMsgType    = "remove_expedited_msg_type"
+	EventTypeAddAuthzBlockedMsgType    = "add_authz_blocked_msg_type"
+	EventTypeRemoveAuthzBlockedMsgType = "remove_authz_blocked_msg_type"
+	EventTypeAddGrantBlockedMsgType    = "add_grant_blocked_msg_type"
+	EventTypeRemoveGrantBlockedMsgType = "remove_grant_blocked_msg_type"
+)
+
+// Anteparams module attribute keys
+const (
+	AttributeKeyMsgTypeURL = "msg_type_url"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultExpeditedMsgTypeURLs returns the message types allowed on expedited proposals by default
+func DefaultExpeditedMsgTypeURLs() []string {
+	return []string{
+		"/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		"/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
+	}
+}
+
+// ValidateMsgTypeURL performs basic validation on a message type url
+func ValidateMsgTypeURL(msgTypeURL string) error {
+	if msgTypeURL == "" || !strings.HasPrefix(msgTypeURL, "/") {
+		return errorsmod.Wrapf(ErrInvalidMsgTypeURL, "invalid msg type url: %q", msgTypeURL)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import "fmt"
+
+// NewGenesisState constructs a genesis state
+func NewGenesisState(params Params, expeditedMsgTypeURLs, authzBlockedMsgTypeURLs, grantBlockedMsgTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		Params:                  params,
+		ExpeditedMsgTypeUrls:    expeditedMsgTypeURLs,
+		AuthzBlockedMsgTypeUrls: authzBlockedMsgTypeURLs,
+		GrantBlockedMsgTypeUrls: grantBlockedMsgTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of anteparams.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(
+		DefaultParams(),
+		DefaultExpeditedMsgTypeURLs(),
+		DefaultAuthzBlockedMsgTypeURLs(),
+		DefaultGrantBlockedMsgTypeURLs(),
+	)
+}
+
+// Validate validates the genesis state of anteparams genesis input
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateMsgTypeURLs("expedited", gs.ExpeditedMsgTypeUrls); err != nil {
+		return err
+	}
+
+	if err := validateMsgTypeURLs("authz blocked", gs.AuthzBlockedMsgTypeUrls); err != nil {
+		return err
+	}
+
+	return validateMsgTypeURLs("grant blocked", gs.GrantBlockedMsgTypeUrls)
+}
+
+// validateMsgTypeURLs checks that a list of message types is valid and has no duplicates
+func validateMsgTypeURLs(list string, msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := ValidateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicated %s msg type: %s", list, msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
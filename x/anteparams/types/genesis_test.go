@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// TestValidateGenesis tests the validation of the anteparams genesis
+func TestValidateGenesis(t *testing.T) {
+	freezeURL := "/kiichain.tokenfactory.v1beta1.MsgFreezeAccount"
+
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		errorMsg string
+	}{
+		{
+			name:    "default genesis",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name:    "empty whitelist",
+			genesis: types.NewGenesisState(types.DefaultParams(), nil, nil, nil),
+		},
+		{
+			name:    "valid whitelist",
+			genesis: types.NewGenesisState(types.DefaultParams(), []string{freezeURL}, nil, nil),
+		},
+		{
+			name:     "invalid msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []string{"MsgFreezeAccount"}, nil, nil),
+			errorMsg: "invalid msg type url",
+		},
+		{
+			name:     "duplicated msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []string{freezeURL, freezeURL}, nil, nil),
+			errorMsg: "duplicated expedited msg type",
+		},
+		{
+			name:     "invalid authz blocked msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, []string{"MsgFreezeAccount"}, nil),
+			errorMsg: "invalid msg type url",
+		},
+		{
+			name:     "duplicated authz blocked msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, []string{freezeURL, freezeURL}, nil),
+			errorMsg: "duplicated authz blocked msg type",
+		},
+		{
+			name:     "invalid grant blocked msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, nil, []string{"MsgFreezeAccount"}),
+			errorMsg: "invalid msg type url",
+		},
+		{
+			name:     "duplicated grant blocked msg type url",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, nil, []string{freezeURL, freezeURL}),
+			errorMsg: "duplicated grant blocked msg type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	ParamsKey             = collections.NewPrefix(0)
+	ExpeditedWhitelistKey = collections.NewPrefix(1)
+	AuthzBlockedKey       = collections.NewPrefix(2)
+	GrantBlockedKey       = collections.NewPrefix(3)
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "anteparams"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the anteparams module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgAddExpeditedMsgType)(nil)
+	_ sdk.Msg = (*MsgRemoveExpeditedMsgType)(nil)
+	_ sdk.Msg = (*MsgAddAuthzBlockedMsgType)(nil)
+	_ sdk.Msg = (*MsgRemoveAuthzBlockedMsgType)(nil)
+	_ sdk.Msg = (*MsgAddGrantBlockedMsgType)(nil)
+	_ sdk.Msg = (*MsgRemoveGrantBlockedMsgType)(nil)
+)
+
+// NewMsgUpdateParams returns a new MsgUpdateParams with the authority
+// and the new params.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgAddExpeditedMsgType returns a new MsgAddExpeditedMsgType
+func NewMsgAddExpeditedMsgType(authority, msgTypeURL string) *MsgAddExpeditedMsgType {
+	return &MsgAddExpeditedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgRemoveExpeditedMsgType returns a new MsgRemoveExpeditedMsgType
+func NewMsgRemoveExpeditedMsgType(authority, msgTypeURL string) *MsgRemoveExpeditedMsgType {
+	return &MsgRemoveExpeditedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgAddAuthzBlockedMsgType returns a new MsgAddAuthzBlockedMsgType
+func NewMsgAddAuthzBlockedMsgType(authority, msgTypeURL string) *MsgAddAuthzBlockedMsgType {
+	return &MsgAddAuthzBlockedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgRemoveAuthzBlockedMsgType returns a new MsgRemoveAuthzBlockedMsgType
+func NewMsgRemoveAuthzBlockedMsgType(authority, msgTypeURL string) *MsgRemoveAuthzBlockedMsgType {
+	return &MsgRemoveAuthzBlockedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgAddGrantBlockedMsgType returns a new MsgAddGrantBlockedMsgType
+func NewMsgAddGrantBlockedMsgType(authority, msgTypeURL string) *MsgAddGrantBlockedMsgType {
+	return &MsgAddGrantBlockedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgRemoveGrantBlockedMsgType returns a new MsgRemoveGrantBlockedMsgType
+func NewMsgRemoveGrantBlockedMsgType(authority, msgTypeURL string) *MsgRemoveGrantBlockedMsgType {
+	return &MsgRemoveGrantBlockedMsgType{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
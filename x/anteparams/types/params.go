@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultMinStakedTokens is the default minimum stake to vote, 1_000_000 akii
+	DefaultMinStakedTokens = math.NewInt(1_000_000)
+
+	// DefaultMaxDelegationsChecked is the default number of delegations checked for the minimum stake
+	DefaultMaxDelegationsChecked uint32 = 100
+
+	// DefaultMaxAuthzExecDepth is the default number of authz MsgExec that can be nested
+	DefaultMaxAuthzExecDepth uint32 = 2
+
+	// DefaultMinCommissionRate is the default minimum commission rate of the validators, 5%
+	DefaultMinCommissionRate = math.LegacyNewDecWithPrec(5, 2)
+)
+
+// NewParams returns new anteparams parameters
+func NewParams(
+	minStakedTokens math.Int,
+	maxDelegationsChecked uint32,
+	countUnbonding, countVesting bool,
+	enforceExpeditedWhitelist bool,
+	maxAuthzExecDepth uint32,
+	minCommissionRate math.LegacyDec,
+) Params {
+	return Params{
+		MinStakedTokens:           minStakedTokens,
+		MaxDelegationsChecked:     maxDelegationsChecked,
+		CountUnbonding:            countUnbonding,
+		CountVesting:              countVesting,
+		EnforceExpeditedWhitelist: enforceExpeditedWhitelist,
+		MaxAuthzExecDepth:         maxAuthzExecDepth,
+		MinCommissionRate:         minCommissionRate,
+	}
+}
+
+// DefaultParams returns default anteparams parameters, only bonded stake is counted
+// and the expedited proposals whitelist is enforced
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMinStakedTokens,
+		DefaultMaxDelegationsChecked,
+		false,
+		false,
+		true,
+		DefaultMaxAuthzExecDepth,
+		DefaultMinCommissionRate,
+	)
+}
+
+// Validate performs basic validation on the anteparams parameters
+func (p Params) Validate() error {
+	if p.MinStakedTokens.IsNil() || p.MinStakedTokens.IsNegative() {
+		return fmt.Errorf("min staked tokens must be non-negative, got %s", p.MinStakedTokens)
+	}
+
+	if p.MaxDelegationsChecked == 0 {
+		return fmt.Errorf("max delegations checked must be positive")
+	}
+
+	if p.MaxAuthzExecDepth == 0 {
+		return fmt.Errorf("max authz exec depth must be positive")
+	}
+
+	if p.MinCommissionRate.IsNil() || p.MinCommissionRate.IsNegative() || p.MinCommissionRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min commission rate must be between 0 and 1, got %s", p.MinCommissionRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/anteparams/v1beta1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the anteparams module.
+type Params struct {
+	// min_staked_tokens is the minimum amount of bond denom tokens a voter must
+	// have staked to vote, zero disables the check
+	MinStakedTokens cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_staked_tokens,json=minStakedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"min_staked_tokens"`
+	// max_delegations_checked is the number of delegations and unbonding
+	// delegations checked for the minimum stake
+	MaxDelegationsChecked uint32 `protobuf:"varint,2,opt,name=max_delegations_checked,json=maxDelegationsChecked,proto3" json:"max_delegations_checked,omitempty"`
+	// count_unbonding defines if tokens being unbonded count for the minimum
+	// stake
+	CountUnbonding bool `protobuf:"varint,3,opt,name=count_unbonding,json=countUnbonding,proto3" json:"count_unbonding,omitempty"`
+	// count_vesting defines if tokens locked in vesting accounts count for the
+	// minimum stake
+	CountVesting bool `protobuf:"varint,4,opt,name=count_vesting,json=countVesting,proto3" json:"count_vesting,omitempty"`
+	// enforce_expedited_whitelist defines if expedited proposals can only
+	// contain whitelisted message types
+	EnforceExpeditedWhitelist bool `protobuf:"varint,5,opt,name=enforce_expedited_whitelist,json=enforceExpeditedWhitelist,proto3" json:"enforce_expedited_whitelist,omitempty"`
+	// max_authz_exec_depth is the number of authz MsgExec that can be nested
+	// on a message
+	MaxAuthzExecDepth uint32 `protobuf:"varint,6,opt,name=max_authz_exec_depth,json=maxAuthzExecDepth,proto3" json:"max_authz_exec_depth,omitempty"`
+	// min_commission_rate is the minimum commission rate of the validators,
+	// enforced when a validator is created or edited
+	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d46347080a49fd99, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxDelegationsChecked() uint32 {
+	if m != nil {
+		return m.MaxDelegationsChecked
+	}
+	return 0
+}
+
+func (m *Params) GetCountUnbonding() bool {
+	if m != nil {
+		return m.CountUnbonding
+	}
+	return false
+}
+
+func (m *Params) GetCountVesting() bool {
+	if m != nil {
+		return m.CountVesting
+	}
+	return false
+}
+
+func (m *Params) GetEnforceExpeditedWhitelist() bool {
+	if m != nil {
+		return m.EnforceExpeditedWhitelist
+	}
+	return false
+}
+
+func (m *Params) GetMaxAuthzExecDepth() uint32 {
+	if m != nil {
+		return m.MaxAuthzExecDepth
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kiichain.anteparams.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/anteparams/v1beta1/params.proto", fileDescriptor_d46347080a49fd99)
+}
+
+var fileDescriptor_d46347080a49fd99 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x56, 0xa3, 0x0e, 0xd6, 0xd2, 0xb5, 0xc5, 0x6d, 0x03, 0xdb, 0xa0, 0x07, 0x03,
+	0xe2, 0x2e, 0x41, 0xf0, 0x28, 0xd8, 0xa6, 0x87, 0x80, 0x07, 0x59, 0xff, 0x14, 0xbc, 0x0c, 0x6f,
+	0x66, 0x5f, 0x77, 0x87, 0xed, 0xcc, 0x2c, 0x99, 0x37, 0x75, 0xeb, 0xa7, 0xf0, 0xc3, 0x78, 0xf3,
+	0x0b, 0xf4, 0x58, 0x3c, 0x89, 0x87, 0x22, 0xc9, 0x17, 0x91, 0x9d, 0x49, 0xd2, 0x82, 0xb7, 0x99,
+	0xe7, 0xf9, 0xbd, 0x0f, 0xcc, 0x33, 0x2f, 0x1b, 0x54, 0x52, 0x8a, 0x12, 0xa4, 0x4e, 0x41, 0x13,
+	0xd6, 0x30, 0x05, 0x65, 0xd3, 0xb3, 0xe1, 0x04, 0x09, 0x86, 0xa9, 0xbf, 0x26, 0xf5, 0xd4, 0x90,
+	0x09, 0x7b, 0x2b, 0x32, 0xb9, 0x26, 0x93, 0x25, 0xb9, 0xbf, 0x53, 0x98, 0xc2, 0x38, 0x2e, 0x6d,
+	0x4f, 0x7e, 0x64, 0x7f, 0x4f, 0x18, 0xab, 0x8c, 0xe5, 0xde, 0xf0, 0x17, 0x6f, 0x3d, 0xf9, 0xb9,
+	0xc1, 0xba, 0xef, 0x5c, 0x46, 0x78, 0xc2, 0xb6, 0x95, 0xd4, 0xdc, 0x12, 0x54, 0x98, 0x73, 0x32,
+	0x15, 0x6a, 0x1b, 0x05, 0xfd, 0x60, 0x70, 0xff, 0xf0, 0xf9, 0xc5, 0xd5, 0x41, 0xe7, 0xcf, 0xd5,
+	0xc1, 0xae, 0x9f, 0xb5, 0x79, 0x95, 0x48, 0x93, 0x2a, 0xa0, 0x32, 0x19, 0x6b, 0xfa, 0xf5, 0xe3,
+	0x05, 0x5b, 0x86, 0x8e, 0x35, 0x65, 0x5b, 0x4a, 0xea, 0xf7, 0x2e, 0xe4, 0x83, 0xcb, 0x08, 0x5f,
+	0xb1, 0xc7, 0x0a, 0x1a, 0x9e, 0xe3, 0x29, 0x16, 0x40, 0xd2, 0x68, 0xcb, 0x45, 0x89, 0xa2, 0xc2,
+	0x3c, 0xba, 0xd5, 0x0f, 0x06, 0x9b, 0xd9, 0xae, 0x82, 0x66, 0x74, 0xed, 0x1e, 0x79, 0x33, 0x7c,
+	0xc6, 0xb6, 0x84, 0x99, 0x69, 0xe2, 0x33, 0x3d, 0x31, 0x3a, 0x97, 0xba, 0x88, 0x36, 0xfa, 0xc1,
+	0xe0, 0x5e, 0xf6, 0xd0, 0xc9, 0x1f, 0x57, 0x6a, 0xf8, 0x94, 0x6d, 0x7a, 0xf0, 0x0c, 0x2d, 0xb5,
+	0xd8, 0x6d, 0x87, 0x3d, 0x70, 0xe2, 0x27, 0xaf, 0x85, 0xaf, 0x59, 0x0f, 0xf5, 0x17, 0x33, 0x15,
+	0xc8, 0xb1, 0xa9, 0x31, 0x97, 0x84, 0x39, 0xff, 0x5a, 0x4a, 0xc2, 0x53, 0x69, 0x29, 0xba, 0xe3,
+	0x46, 0xf6, 0x96, 0xc8, 0xf1, 0x8a, 0x38, 0x59, 0x01, 0x61, 0xca, 0x76, 0xda, 0x57, 0xc0, 0x8c,
+	0xca, 0x6f, 0x1c, 0x1b, 0x14, 0x3c, 0xc7, 0x9a, 0xca, 0xa8, 0xeb, 0x9e, 0xb0, 0xad, 0xa0, 0x79,
+	0xd3, 0x5a, 0xc7, 0x0d, 0x8a, 0x51, 0x6b, 0x84, 0xc0, 0x1e, 0xb5, 0x7d, 0x0a, 0xa3, 0x94, 0xb4,
+	0x56, 0x1a, 0xcd, 0xa7, 0x40, 0x18, 0xdd, 0x75, 0x8d, 0x0e, 0x97, 0x8d, 0xf6, 0xfe, 0x6f, 0xf4,
+	0x2d, 0x16, 0x20, 0xce, 0x47, 0x28, 0x6e, 0xf4, 0x3a, 0x42, 0x91, 0xb5, 0xbf, 0x73, 0xb4, 0x0e,
+	0xcb, 0x80, 0xf0, 0x70, 0x7c, 0x31, 0x8f, 0x83, 0xcb, 0x79, 0x1c, 0xfc, 0x9d, 0xc7, 0xc1, 0xf7,
+	0x45, 0xdc, 0xb9, 0x5c, 0xc4, 0x9d, 0xdf, 0x8b, 0xb8, 0xf3, 0x39, 0x2d, 0x24, 0x95, 0xb3, 0x49,
+	0x22, 0x8c, 0x4a, 0xd7, 0xab, 0xb5, 0x3e, 0x34, 0x37, 0xb7, 0x8c, 0xce, 0x6b, 0xb4, 0x93, 0xae,
+	0xdb, 0x87, 0x97, 0xff, 0x06, 0x00, 0x63, 0x19, 0x2d, 0xa3, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxAuthzExecDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAuthzExecDepth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnforceExpeditedWhitelist {
+		i--
+		if m.EnforceExpeditedWhitelist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CountVesting {
+		i--
+		if m.CountVesting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CountUnbonding {
+		i--
+		if m.CountUnbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDelegationsChecked != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDelegationsChecked))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinStakedTokens.Size()
+		i -= size
+		if _, err := m.MinStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStakedTokens.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDelegationsChecked != 0 {
+		n += 1 + sovParams(uint64(m.MaxDelegationsChecked))
+	}
+	if m.CountUnbonding {
+		n += 2
+	}
+	if m.CountVesting {
+		n += 2
+	}
+	if m.EnforceExpeditedWhitelist {
+		n += 2
+	}
+	if m.MaxAuthzExecDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxAuthzExecDepth))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationsChecked", wireType)
+			}
+			m.MaxDelegationsChecked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegationsChecked |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountUnbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountUnbonding = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CountVesting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CountVesting = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceExpeditedWhitelist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceExpeditedWhitelist = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuthzExecDepth", wireType)
+			}
+			m.MaxAuthzExecDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAuthzExecDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v3/x/anteparams/types"
+)
+
+// TestValidateParams tests the validation of the anteparams params
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   types.Params
+		errorMsg string
+	}{
+		{
+			name:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "disabled minimum stake",
+			params: types.NewParams(math.ZeroInt(), 1, true, true, true, 2, types.DefaultMinCommissionRate),
+		},
+		{
+			name:     "nil minimum stake",
+			params:   types.NewParams(math.Int{}, 1, false, false, true, 2, types.DefaultMinCommissionRate),
+			errorMsg: "min staked tokens must be non-negative",
+		},
+		{
+			name:     "negative minimum stake",
+			params:   types.NewParams(math.NewInt(-1), 1, false, false, true, 2, types.DefaultMinCommissionRate),
+			errorMsg: "min staked tokens must be non-negative",
+		},
+		{
+			name:     "zero max delegations checked",
+			params:   types.NewParams(math.OneInt(), 0, false, false, true, 2, types.DefaultMinCommissionRate),
+			errorMsg: "max delegations checked must be positive",
+		},
+		{
+			name:     "zero max authz exec depth",
+			params:   types.NewParams(math.OneInt(), 1, false, false, true, 0, types.DefaultMinCommissionRate),
+			errorMsg: "max authz exec depth must be positive",
+		},
+		{
+			name:   "disabled min commission rate",
+			params: types.NewParams(math.OneInt(), 1, false, false, true, 2, math.LegacyZeroDec()),
+		},
+		{
+			name:     "nil min commission rate",
+			params:   types.NewParams(math.OneInt(), 1, false, false, true, 2, math.LegacyDec{}),
+			errorMsg: "min commission rate must be between 0 and 1",
+		},
+		{
+			name:     "negative min commission rate",
+			params:   types.NewParams(math.OneInt(), 1, false, false, true, 2, math.LegacyNewDec(-1)),
+			errorMsg: "min commission rate must be between 0 and 1",
+		},
+		{
+			name:     "min commission rate above one",
+			params:   types.NewParams(math.OneInt(), 1, false, false, true, 2, math.LegacyNewDecWithPrec(11, 1)),
+			errorMsg: "min commission rate must be between 0 and 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
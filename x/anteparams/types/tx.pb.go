@@ -0,0 +1,2930 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/anteparams/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/anteparams parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgAddExpeditedMsgType is the Msg/AddExpeditedMsgType request type.
+type MsgAddExpeditedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type allowed on expedited proposals
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgAddExpeditedMsgType) Reset()         { *m = MsgAddExpeditedMsgType{} }
+func (m *MsgAddExpeditedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgAddExpeditedMsgType) ProtoMessage()    {}
+func (*MsgAddExpeditedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{2}
+}
+func (m *MsgAddExpeditedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddExpeditedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddExpeditedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddExpeditedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddExpeditedMsgType.Merge(m, src)
+}
+func (m *MsgAddExpeditedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddExpeditedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddExpeditedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddExpeditedMsgType proto.InternalMessageInfo
+
+func (m *MsgAddExpeditedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddExpeditedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgAddExpeditedMsgTypeResponse defines the response structure for executing a
+// MsgAddExpeditedMsgType message.
+type MsgAddExpeditedMsgTypeResponse struct {
+}
+
+func (m *MsgAddExpeditedMsgTypeResponse) Reset()         { *m = MsgAddExpeditedMsgTypeResponse{} }
+func (m *MsgAddExpeditedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddExpeditedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgAddExpeditedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{3}
+}
+func (m *MsgAddExpeditedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddExpeditedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddExpeditedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddExpeditedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddExpeditedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgAddExpeditedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddExpeditedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddExpeditedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddExpeditedMsgTypeResponse proto.InternalMessageInfo
+
+// MsgRemoveExpeditedMsgType is the Msg/RemoveExpeditedMsgType request type.
+type MsgRemoveExpeditedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type removed from the expedited proposals whitelist
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRemoveExpeditedMsgType) Reset()         { *m = MsgRemoveExpeditedMsgType{} }
+func (m *MsgRemoveExpeditedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveExpeditedMsgType) ProtoMessage()    {}
+func (*MsgRemoveExpeditedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{4}
+}
+func (m *MsgRemoveExpeditedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveExpeditedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveExpeditedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveExpeditedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveExpeditedMsgType.Merge(m, src)
+}
+func (m *MsgRemoveExpeditedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveExpeditedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveExpeditedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveExpeditedMsgType proto.InternalMessageInfo
+
+func (m *MsgRemoveExpeditedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveExpeditedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgRemoveExpeditedMsgTypeResponse defines the response structure for executing a
+// MsgRemoveExpeditedMsgType message.
+type MsgRemoveExpeditedMsgTypeResponse struct {
+}
+
+func (m *MsgRemoveExpeditedMsgTypeResponse) Reset()         { *m = MsgRemoveExpeditedMsgTypeResponse{} }
+func (m *MsgRemoveExpeditedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveExpeditedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgRemoveExpeditedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{5}
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveExpeditedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveExpeditedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveExpeditedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveExpeditedMsgTypeResponse proto.InternalMessageInfo
+
+// MsgAddAuthzBlockedMsgType is the Msg/AddAuthzBlockedMsgType request type.
+type MsgAddAuthzBlockedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type blocked inside authz MsgExec
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgAddAuthzBlockedMsgType) Reset()         { *m = MsgAddAuthzBlockedMsgType{} }
+func (m *MsgAddAuthzBlockedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthzBlockedMsgType) ProtoMessage()    {}
+func (*MsgAddAuthzBlockedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{6}
+}
+func (m *MsgAddAuthzBlockedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthzBlockedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthzBlockedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthzBlockedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthzBlockedMsgType.Merge(m, src)
+}
+func (m *MsgAddAuthzBlockedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthzBlockedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthzBlockedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAuthzBlockedMsgType proto.InternalMessageInfo
+
+func (m *MsgAddAuthzBlockedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddAuthzBlockedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgAddAuthzBlockedMsgTypeResponse defines the response structure for executing a
+// MsgAddAuthzBlockedMsgType message.
+type MsgAddAuthzBlockedMsgTypeResponse struct {
+}
+
+func (m *MsgAddAuthzBlockedMsgTypeResponse) Reset()         { *m = MsgAddAuthzBlockedMsgTypeResponse{} }
+func (m *MsgAddAuthzBlockedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthzBlockedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgAddAuthzBlockedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{7}
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthzBlockedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthzBlockedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthzBlockedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAuthzBlockedMsgTypeResponse proto.InternalMessageInfo
+
+// MsgRemoveAuthzBlockedMsgType is the Msg/RemoveAuthzBlockedMsgType request type.
+type MsgRemoveAuthzBlockedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type allowed inside authz MsgExec again
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) Reset()         { *m = MsgRemoveAuthzBlockedMsgType{} }
+func (m *MsgRemoveAuthzBlockedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthzBlockedMsgType) ProtoMessage()    {}
+func (*MsgRemoveAuthzBlockedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{8}
+}
+func (m *MsgRemoveAuthzBlockedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthzBlockedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthzBlockedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthzBlockedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthzBlockedMsgType.Merge(m, src)
+}
+func (m *MsgRemoveAuthzBlockedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthzBlockedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthzBlockedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAuthzBlockedMsgType proto.InternalMessageInfo
+
+func (m *MsgRemoveAuthzBlockedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgRemoveAuthzBlockedMsgTypeResponse defines the response structure for executing a
+// MsgRemoveAuthzBlockedMsgType message.
+type MsgRemoveAuthzBlockedMsgTypeResponse struct {
+}
+
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) Reset()         { *m = MsgRemoveAuthzBlockedMsgTypeResponse{} }
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthzBlockedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgRemoveAuthzBlockedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{9}
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthzBlockedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthzBlockedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthzBlockedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAuthzBlockedMsgTypeResponse proto.InternalMessageInfo
+
+// MsgAddGrantBlockedMsgType is the Msg/AddGrantBlockedMsgType request type.
+type MsgAddGrantBlockedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type that cannot be granted through authz
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgAddGrantBlockedMsgType) Reset()         { *m = MsgAddGrantBlockedMsgType{} }
+func (m *MsgAddGrantBlockedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgAddGrantBlockedMsgType) ProtoMessage()    {}
+func (*MsgAddGrantBlockedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{10}
+}
+func (m *MsgAddGrantBlockedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddGrantBlockedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddGrantBlockedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddGrantBlockedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddGrantBlockedMsgType.Merge(m, src)
+}
+func (m *MsgAddGrantBlockedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddGrantBlockedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddGrantBlockedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddGrantBlockedMsgType proto.InternalMessageInfo
+
+func (m *MsgAddGrantBlockedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddGrantBlockedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgAddGrantBlockedMsgTypeResponse defines the response structure for executing a
+// MsgAddGrantBlockedMsgType message.
+type MsgAddGrantBlockedMsgTypeResponse struct {
+}
+
+func (m *MsgAddGrantBlockedMsgTypeResponse) Reset()         { *m = MsgAddGrantBlockedMsgTypeResponse{} }
+func (m *MsgAddGrantBlockedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddGrantBlockedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgAddGrantBlockedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{11}
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddGrantBlockedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddGrantBlockedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddGrantBlockedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddGrantBlockedMsgTypeResponse proto.InternalMessageInfo
+
+// MsgRemoveGrantBlockedMsgType is the Msg/RemoveGrantBlockedMsgType request type.
+type MsgRemoveGrantBlockedMsgType struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// msg_type_url is the message type that can be granted through authz again
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) Reset()         { *m = MsgRemoveGrantBlockedMsgType{} }
+func (m *MsgRemoveGrantBlockedMsgType) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGrantBlockedMsgType) ProtoMessage()    {}
+func (*MsgRemoveGrantBlockedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{12}
+}
+func (m *MsgRemoveGrantBlockedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGrantBlockedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGrantBlockedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGrantBlockedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGrantBlockedMsgType.Merge(m, src)
+}
+func (m *MsgRemoveGrantBlockedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGrantBlockedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGrantBlockedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGrantBlockedMsgType proto.InternalMessageInfo
+
+func (m *MsgRemoveGrantBlockedMsgType) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgRemoveGrantBlockedMsgTypeResponse defines the response structure for executing a
+// MsgRemoveGrantBlockedMsgType message.
+type MsgRemoveGrantBlockedMsgTypeResponse struct {
+}
+
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) Reset()         { *m = MsgRemoveGrantBlockedMsgTypeResponse{} }
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveGrantBlockedMsgTypeResponse) ProtoMessage()    {}
+func (*MsgRemoveGrantBlockedMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb5537e9d0efda3b, []int{13}
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveGrantBlockedMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveGrantBlockedMsgTypeResponse.Merge(m, src)
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveGrantBlockedMsgTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveGrantBlockedMsgTypeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.anteparams.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.anteparams.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddExpeditedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgAddExpeditedMsgType")
+	proto.RegisterType((*MsgAddExpeditedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgAddExpeditedMsgTypeResponse")
+	proto.RegisterType((*MsgRemoveExpeditedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgRemoveExpeditedMsgType")
+	proto.RegisterType((*MsgRemoveExpeditedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgRemoveExpeditedMsgTypeResponse")
+	proto.RegisterType((*MsgAddAuthzBlockedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgAddAuthzBlockedMsgType")
+	proto.RegisterType((*MsgAddAuthzBlockedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgAddAuthzBlockedMsgTypeResponse")
+	proto.RegisterType((*MsgRemoveAuthzBlockedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgRemoveAuthzBlockedMsgType")
+	proto.RegisterType((*MsgRemoveAuthzBlockedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgRemoveAuthzBlockedMsgTypeResponse")
+	proto.RegisterType((*MsgAddGrantBlockedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgAddGrantBlockedMsgType")
+	proto.RegisterType((*MsgAddGrantBlockedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgAddGrantBlockedMsgTypeResponse")
+	proto.RegisterType((*MsgRemoveGrantBlockedMsgType)(nil), "kiichain.anteparams.v1beta1.MsgRemoveGrantBlockedMsgType")
+	proto.RegisterType((*MsgRemoveGrantBlockedMsgTypeResponse)(nil), "kiichain.anteparams.v1beta1.MsgRemoveGrantBlockedMsgTypeResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/anteparams/v1beta1/tx.proto", fileDescriptor_cb5537e9d0efda3b)
+}
+
+var fileDescriptor_cb5537e9d0efda3b = []byte{
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xc0, 0x73, 0xfc, 0x29, 0xea, 0x51, 0x09, 0x11, 0xaa, 0x36, 0x31, 0xc8, 0xa4, 0x69, 0x41,
+	0x51, 0xc1, 0xb6, 0xfa, 0x47, 0xfc, 0x69, 0xa5, 0x4a, 0x8e, 0x84, 0x10, 0x43, 0x24, 0x14, 0xe8,
+	0xc2, 0x52, 0x39, 0xf1, 0xe9, 0x62, 0xb5, 0xf6, 0x59, 0xbe, 0x4b, 0xd5, 0x74, 0x42, 0x2c, 0x48,
+	0x4c, 0x5d, 0xf9, 0x06, 0x4c, 0x90, 0x01, 0x06, 0xbe, 0x41, 0xc7, 0x8a, 0x89, 0x09, 0xa1, 0x64,
+	0xc8, 0x87, 0x60, 0x41, 0xf6, 0x39, 0x4e, 0x9a, 0x9c, 0xdd, 0xe0, 0x21, 0x62, 0x49, 0xec, 0xdc,
+	0x7b, 0xef, 0x7e, 0xbf, 0x7b, 0xca, 0xd3, 0xc1, 0x95, 0x7d, 0xcb, 0xaa, 0x37, 0x0c, 0xcb, 0xd1,
+	0x0c, 0x87, 0x21, 0xd7, 0xf0, 0x0c, 0x9b, 0x6a, 0x87, 0x6b, 0x35, 0xc4, 0x8c, 0x35, 0x8d, 0x1d,
+	0xa9, 0xae, 0x47, 0x18, 0xc9, 0xde, 0xee, 0x47, 0xa9, 0x83, 0x28, 0x35, 0x8c, 0x92, 0xe6, 0x31,
+	0xc1, 0x24, 0x88, 0xd3, 0xfc, 0x27, 0x9e, 0x22, 0x95, 0x92, 0x0a, 0x87, 0x15, 0x78, 0xe4, 0x62,
+	0x9d, 0x50, 0x9b, 0x50, 0xcd, 0xa6, 0x58, 0x3b, 0x5c, 0xf3, 0xbf, 0xc2, 0x85, 0x3c, 0x5f, 0xd8,
+	0xe3, 0xb5, 0xf9, 0x4b, 0xb8, 0x74, 0xd3, 0xb0, 0x2d, 0x87, 0x68, 0xc1, 0x27, 0xff, 0xa9, 0xf8,
+	0x1d, 0xc0, 0x1b, 0x15, 0x8a, 0x77, 0x5d, 0xd3, 0x60, 0xe8, 0x65, 0xb0, 0x41, 0xf6, 0x11, 0x9c,
+	0x35, 0x9a, 0xac, 0x41, 0x3c, 0x8b, 0xb5, 0x72, 0xa0, 0x00, 0x4a, 0xb3, 0xe5, 0xdc, 0x8f, 0xaf,
+	0xca, 0x7c, 0x58, 0x4b, 0x37, 0x4d, 0x0f, 0x51, 0xfa, 0x8a, 0x79, 0x96, 0x83, 0xab, 0x83, 0xd0,
+	0xac, 0x0e, 0x67, 0x38, 0x62, 0xee, 0x52, 0x01, 0x94, 0xae, 0xaf, 0x2f, 0xab, 0x09, 0x07, 0xa0,
+	0xf2, 0xcd, 0xca, 0x57, 0x4e, 0x7f, 0xdd, 0xcd, 0x54, 0xc3, 0xc4, 0xad, 0x07, 0xef, 0x7a, 0xed,
+	0xd5, 0x41, 0xc9, 0x0f, 0xbd, 0xf6, 0x6a, 0x6e, 0xe8, 0x24, 0x9a, 0x01, 0xa6, 0xc2, 0xdf, 0x8a,
+	0x79, 0xb8, 0x38, 0x82, 0x5e, 0x45, 0xd4, 0x25, 0x0e, 0x45, 0xc5, 0x4f, 0x00, 0x2e, 0x54, 0x28,
+	0xd6, 0x4d, 0xf3, 0xd9, 0x91, 0x8b, 0x4c, 0x8b, 0x21, 0xb3, 0x42, 0xf1, 0xeb, 0x96, 0x8b, 0x52,
+	0xdb, 0x15, 0xe0, 0x9c, 0x4d, 0xf1, 0x1e, 0x6b, 0xb9, 0x68, 0xaf, 0xe9, 0x1d, 0x04, 0x8e, 0xb3,
+	0x55, 0x68, 0xf3, 0xb2, 0xbb, 0xde, 0xc1, 0xd6, 0xe6, 0x38, 0xfc, 0xd2, 0x10, 0xbc, 0x61, 0x9a,
+	0x0a, 0xea, 0xd3, 0x28, 0x36, 0xc5, 0x8a, 0x5f, 0xab, 0x58, 0x80, 0xb2, 0x98, 0x34, 0x92, 0xf9,
+	0x0c, 0x60, 0xbe, 0x42, 0x71, 0x15, 0xd9, 0xe4, 0x10, 0x4d, 0xd1, 0xe7, 0xf1, 0xb8, 0xcf, 0xca,
+	0x90, 0x8f, 0x17, 0xf0, 0x88, 0x94, 0x96, 0xe1, 0x52, 0x2c, 0x6f, 0x64, 0xf5, 0x85, 0x5b, 0xe9,
+	0xa6, 0xa9, 0x37, 0x59, 0xe3, 0xb8, 0x7c, 0x40, 0xea, 0xfb, 0xd3, 0xb0, 0x7a, 0x32, 0x6e, 0x75,
+	0x6f, 0xa4, 0x4b, 0xfe, 0xe2, 0xb1, 0x52, 0xe3, 0x44, 0xa3, 0x5a, 0x62, 0xe0, 0x48, 0xeb, 0x1b,
+	0x80, 0x77, 0x22, 0xf9, 0xe9, 0x9a, 0x6d, 0x8f, 0x9b, 0x95, 0xc6, 0xfb, 0x15, 0x23, 0x77, 0x1f,
+	0xae, 0x24, 0x61, 0x0b, 0xda, 0xf6, 0xdc, 0x33, 0x1c, 0xf6, 0x3f, 0xb5, 0x0d, 0xfb, 0x40, 0x09,
+	0x6d, 0x13, 0x00, 0x8b, 0xdb, 0x36, 0x5d, 0xb3, 0xc9, 0xda, 0x16, 0x23, 0x37, 0xdc, 0xb6, 0x04,
+	0xbf, 0xf5, 0x3f, 0xd7, 0xe0, 0xe5, 0x0a, 0xc5, 0x59, 0x0f, 0xce, 0x9d, 0x9b, 0xf5, 0x0f, 0x13,
+	0x67, 0xf4, 0xc8, 0x78, 0x95, 0x36, 0xff, 0x25, 0xba, 0xbf, 0x77, 0xf6, 0x3d, 0x80, 0xb7, 0x44,
+	0x93, 0x78, 0xe3, 0xa2, 0x6a, 0x82, 0x24, 0x69, 0x3b, 0x45, 0x52, 0x44, 0x72, 0x02, 0xe0, 0x42,
+	0xdc, 0x18, 0xbd, 0xa8, 0xae, 0x38, 0x4f, 0xda, 0x49, 0x97, 0x77, 0x0e, 0x29, 0x6e, 0x06, 0x4e,
+	0xa0, 0x2a, 0xc8, 0x93, 0x76, 0xd2, 0xe5, 0x45, 0x48, 0x1f, 0x01, 0xcc, 0xc7, 0xcf, 0xaf, 0xa7,
+	0x93, 0x09, 0x8b, 0xc0, 0xf4, 0xd4, 0xa9, 0xa3, 0xc7, 0x25, 0xfc, 0x87, 0x4e, 0xa0, 0x2d, 0xc8,
+	0x93, 0x76, 0xd2, 0xe5, 0x09, 0x8e, 0x4b, 0x44, 0x35, 0xe1, 0x71, 0x89, 0xc0, 0xf4, 0xd4, 0xa9,
+	0x7d, 0x36, 0xe9, 0xea, 0xdb, 0x5e, 0x7b, 0x15, 0x94, 0x5f, 0x9c, 0x76, 0x64, 0x70, 0xd6, 0x91,
+	0xc1, 0xef, 0x8e, 0x0c, 0x4e, 0xba, 0x72, 0xe6, 0xac, 0x2b, 0x67, 0x7e, 0x76, 0xe5, 0xcc, 0x1b,
+	0x0d, 0x5b, 0xac, 0xd1, 0xac, 0xa9, 0x75, 0x62, 0x6b, 0xd1, 0xdd, 0x33, 0x7a, 0x38, 0x1a, 0xbe,
+	0x86, 0xfa, 0xf3, 0x86, 0xd6, 0x66, 0x82, 0x7b, 0xe3, 0xc6, 0xdf, 0x01, 0x00, 0xb0, 0x73, 0xab,
+	0x83, 0x03, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/anteparams
+	// module parameters, including the minimum stake to vote.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddExpeditedMsgType defines a governance operation for allowing a message
+	// type on expedited proposals
+	AddExpeditedMsgType(ctx context.Context, in *MsgAddExpeditedMsgType, opts ...grpc.CallOption) (*MsgAddExpeditedMsgTypeResponse, error)
+	// RemoveExpeditedMsgType defines a governance operation for removing a
+	// message type from the expedited proposals whitelist
+	RemoveExpeditedMsgType(ctx context.Context, in *MsgRemoveExpeditedMsgType, opts ...grpc.CallOption) (*MsgRemoveExpeditedMsgTypeResponse, error)
+	// AddAuthzBlockedMsgType defines a governance operation for blocking a
+	// message type inside authz MsgExec
+	AddAuthzBlockedMsgType(ctx context.Context, in *MsgAddAuthzBlockedMsgType, opts ...grpc.CallOption) (*MsgAddAuthzBlockedMsgTypeResponse, error)
+	// RemoveAuthzBlockedMsgType defines a governance operation for allowing a
+	// blocked message type inside authz MsgExec again
+	RemoveAuthzBlockedMsgType(ctx context.Context, in *MsgRemoveAuthzBlockedMsgType, opts ...grpc.CallOption) (*MsgRemoveAuthzBlockedMsgTypeResponse, error)
+	// AddGrantBlockedMsgType defines a governance operation for forbidding
+	// authz grants of a message type
+	AddGrantBlockedMsgType(ctx context.Context, in *MsgAddGrantBlockedMsgType, opts ...grpc.CallOption) (*MsgAddGrantBlockedMsgTypeResponse, error)
+	// RemoveGrantBlockedMsgType defines a governance operation for allowing
+	// authz grants of a blocked message type again
+	RemoveGrantBlockedMsgType(ctx context.Context, in *MsgRemoveGrantBlockedMsgType, opts ...grpc.CallOption) (*MsgRemoveGrantBlockedMsgTypeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddExpeditedMsgType(ctx context.Context, in *MsgAddExpeditedMsgType, opts ...grpc.CallOption) (*MsgAddExpeditedMsgTypeResponse, error) {
+	out := new(MsgAddExpeditedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/AddExpeditedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveExpeditedMsgType(ctx context.Context, in *MsgRemoveExpeditedMsgType, opts ...grpc.CallOption) (*MsgRemoveExpeditedMsgTypeResponse, error) {
+	out := new(MsgRemoveExpeditedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/RemoveExpeditedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthzBlockedMsgType(ctx context.Context, in *MsgAddAuthzBlockedMsgType, opts ...grpc.CallOption) (*MsgAddAuthzBlockedMsgTypeResponse, error) {
+	out := new(MsgAddAuthzBlockedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/AddAuthzBlockedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthzBlockedMsgType(ctx context.Context, in *MsgRemoveAuthzBlockedMsgType, opts ...grpc.CallOption) (*MsgRemoveAuthzBlockedMsgTypeResponse, error) {
+	out := new(MsgRemoveAuthzBlockedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/RemoveAuthzBlockedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddGrantBlockedMsgType(ctx context.Context, in *MsgAddGrantBlockedMsgType, opts ...grpc.CallOption) (*MsgAddGrantBlockedMsgTypeResponse, error) {
+	out := new(MsgAddGrantBlockedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/AddGrantBlockedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveGrantBlockedMsgType(ctx context.Context, in *MsgRemoveGrantBlockedMsgType, opts ...grpc.CallOption) (*MsgRemoveGrantBlockedMsgTypeResponse, error) {
+	out := new(MsgRemoveGrantBlockedMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.anteparams.v1beta1.Msg/RemoveGrantBlockedMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/anteparams
+	// module parameters, including the minimum stake to vote.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddExpeditedMsgType defines a governance operation for allowing a message
+	// type on expedited proposals
+	AddExpeditedMsgType(context.Context, *MsgAddExpeditedMsgType) (*MsgAddExpeditedMsgTypeResponse, error)
+	// RemoveExpeditedMsgType defines a governance operation for removing a
+	// message type from the expedited proposals whitelist
+	RemoveExpeditedMsgType(context.Context, *MsgRemoveExpeditedMsgType) (*MsgRemoveExpeditedMsgTypeResponse, error)
+	// AddAuthzBlockedMsgType defines a governance operation for blocking a
+	// message type inside authz MsgExec
+	AddAuthzBlockedMsgType(context.Context, *MsgAddAuthzBlockedMsgType) (*MsgAddAuthzBlockedMsgTypeResponse, error)
+	// RemoveAuthzBlockedMsgType defines a governance operation for allowing a
+	// blocked message type inside authz MsgExec again
+	RemoveAuthzBlockedMsgType(context.Context, *MsgRemoveAuthzBlockedMsgType) (*MsgRemoveAuthzBlockedMsgTypeResponse, error)
+	// AddGrantBlockedMsgType defines a governance operation for forbidding
+	// authz grants of a message type
+	AddGrantBlockedMsgType(context.Context, *MsgAddGrantBlockedMsgType) (*MsgAddGrantBlockedMsgTypeResponse, error)
+	// RemoveGrantBlockedMsgType defines a governance operation for allowing
+	// authz grants of a blocked message type again
+	RemoveGrantBlockedMsgType(context.Context, *MsgRemoveGrantBlockedMsgType) (*MsgRemoveGrantBlockedMsgTypeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddExpeditedMsgType(ctx context.Context, req *MsgAddExpeditedMsgType) (*MsgAddExpeditedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpeditedMsgType not implemented")
+}
+func (*UnimplementedMsgServer) RemoveExpeditedMsgType(ctx context.Context, req *MsgRemoveExpeditedMsgType) (*MsgRemoveExpeditedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveExpeditedMsgType not implemented")
+}
+func (*UnimplementedMsgServer) AddAuthzBlockedMsgType(ctx context.Context, req *MsgAddAuthzBlockedMsgType) (*MsgAddAuthzBlockedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthzBlockedMsgType not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAuthzBlockedMsgType(ctx context.Context, req *MsgRemoveAuthzBlockedMsgType) (*MsgRemoveAuthzBlockedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthzBlockedMsgType not implemented")
+}
+func (*UnimplementedMsgServer) AddGrantBlockedMsgType(ctx context.Context, req *MsgAddGrantBlockedMsgType) (*MsgAddGrantBlockedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGrantBlockedMsgType not implemented")
+}
+func (*UnimplementedMsgServer) RemoveGrantBlockedMsgType(ctx context.Context, req *MsgRemoveGrantBlockedMsgType) (*MsgRemoveGrantBlockedMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGrantBlockedMsgType not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddExpeditedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddExpeditedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddExpeditedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/AddExpeditedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddExpeditedMsgType(ctx, req.(*MsgAddExpeditedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveExpeditedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveExpeditedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveExpeditedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/RemoveExpeditedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveExpeditedMsgType(ctx, req.(*MsgRemoveExpeditedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthzBlockedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthzBlockedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthzBlockedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/AddAuthzBlockedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthzBlockedMsgType(ctx, req.(*MsgAddAuthzBlockedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthzBlockedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthzBlockedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthzBlockedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/RemoveAuthzBlockedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthzBlockedMsgType(ctx, req.(*MsgRemoveAuthzBlockedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddGrantBlockedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddGrantBlockedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddGrantBlockedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/AddGrantBlockedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddGrantBlockedMsgType(ctx, req.(*MsgAddGrantBlockedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveGrantBlockedMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveGrantBlockedMsgType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveGrantBlockedMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.anteparams.v1beta1.Msg/RemoveGrantBlockedMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveGrantBlockedMsgType(ctx, req.(*MsgRemoveGrantBlockedMsgType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.anteparams.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddExpeditedMsgType",
+			Handler:    _Msg_AddExpeditedMsgType_Handler,
+		},
+		{
+			MethodName: "RemoveExpeditedMsgType",
+			Handler:    _Msg_RemoveExpeditedMsgType_Handler,
+		},
+		{
+			MethodName: "AddAuthzBlockedMsgType",
+			Handler:    _Msg_AddAuthzBlockedMsgType_Handler,
+		},
+		{
+			MethodName: "RemoveAuthzBlockedMsgType",
+			Handler:    _Msg_RemoveAuthzBlockedMsgType_Handler,
+		},
+		{
+			MethodName: "AddGrantBlockedMsgType",
+			Handler:    _Msg_AddGrantBlockedMsgType_Handler,
+		},
+		{
+			MethodName: "RemoveGrantBlockedMsgType",
+			Handler:    _Msg_RemoveGrantBlockedMsgType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/anteparams/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddExpeditedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddExpeditedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddExpeditedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddExpeditedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddExpeditedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddExpeditedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveExpeditedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveExpeditedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveExpeditedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveExpeditedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveExpeditedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveExpeditedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthzBlockedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthzBlockedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAuthzBlockedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthzBlockedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthzBlockedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAuthzBlockedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddGrantBlockedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddGrantBlockedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddGrantBlockedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddGrantBlockedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddGrantBlockedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddGrantBlockedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddExpeditedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddExpeditedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveExpeditedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveExpeditedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAuthzBlockedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAuthzBlockedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAuthzBlockedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddGrantBlockedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddGrantBlockedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveGrantBlockedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddExpeditedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddExpeditedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddExpeditedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddExpeditedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddExpeditedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddExpeditedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveExpeditedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveExpeditedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveExpeditedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveExpeditedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveExpeditedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveExpeditedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAuthzBlockedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAuthzBlockedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAuthzBlockedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAuthzBlockedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAuthzBlockedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAuthzBlockedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthzBlockedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthzBlockedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthzBlockedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthzBlockedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthzBlockedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthzBlockedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddGrantBlockedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddGrantBlockedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddGrantBlockedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddGrantBlockedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddGrantBlockedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddGrantBlockedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveGrantBlockedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveGrantBlockedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveGrantBlockedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveGrantBlockedMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveGrantBlockedMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveGrantBlockedMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+)
+
+// IsFeeDenom checks if a denom is whitelisted to pay fees
+func (k Keeper) IsFeeDenom(ctx sdk.Context, denom string) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, found := params.GetFeeDenom(denom)
+	return found, nil
+}
+
+// ConvertToNative converts a fee in a whitelisted denom to the native denom amount
+// The result is truncated so the fee is never worth more than what was paid
+func (k Keeper) ConvertToNative(ctx sdk.Context, fee sdk.Coin) (sdkmath.Int, error) {
+	rate, err := k.GetConversionRate(ctx, fee.Denom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return rate.ToNative(fee.Amount), nil
+}
+
+// ConvertFromNative converts a native denom amount to a whitelisted fee denom
+// The result is rounded up so the fee is never worth less than the native amount
+func (k Keeper) ConvertFromNative(ctx sdk.Context, nativeAmount sdkmath.Int, denom string) (sdk.Coin, error) {
+	rate, err := k.GetConversionRate(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return rate.FromNative(nativeAmount), nil
+}
+
+// GetConversionRate returns the rate between the native denom and a whitelisted fee denom
+// Both oracle prices must have been updated within the max price age
+func (k Keeper) GetConversionRate(ctx sdk.Context, denom string) (types.ConversionRate, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ConversionRate{}, err
+	}
+
+	feeDenom, found := params.GetFeeDenom(denom)
+	if !found {
+		return types.ConversionRate{}, types.ErrFeeDenomNotAllowed.Wrap(denom)
+	}
+
+	prices, err := k.oracleKeeper.GetRecentTwaps(
+		ctx,
+		[]string{params.NativeOracleDenom, feeDenom.OracleDenom},
+		params.MaxPriceAgeSeconds,
+		params.TwapLookbackSeconds,
+	)
+	if errors.Is(err, oracletypes.ErrStaleExchangeRate) {
+		return types.ConversionRate{}, types.ErrStalePrice.Wrap(err.Error())
+	}
+	if err != nil {
+		return types.ConversionRate{}, types.ErrPriceNotFound.Wrap(err.Error())
+	}
+
+	return types.ConversionRate{
+		Denom:          denom,
+		NativePrice:    prices[params.NativeOracleDenom],
+		DenomPrice:     prices[feeDenom.OracleDenom],
+		NativeExponent: params.NativeExponent,
+		DenomExponent:  feeDenom.Exponent,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries params of feeabs module
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// EstimateFee converts a native fee amount to a whitelisted fee denom
+func (k Querier) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	nativeAmount, ok := sdkmath.NewIntFromString(req.NativeAmount)
+	if !ok || nativeAmount.IsNegative() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid native amount: %s", req.NativeAmount)
+	}
+
+	fee, err := k.Keeper.ConvertFromNative(sdk.UnwrapSDKContext(ctx), nativeAmount, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateFeeResponse{Fee: fee}, nil
+}
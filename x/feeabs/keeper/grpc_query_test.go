@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+)
+
+// TestQueries tests the feeabs queries
+func (suite *KeeperTestSuite) TestQueries() {
+	// Default params are set from genesis
+	paramsRes, err := suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	// Estimate a fee on a whitelisted denom
+	suite.setupFeeDenom()
+	estimateRes, err := suite.queryClient.EstimateFee(suite.Ctx, &types.QueryEstimateFeeRequest{
+		NativeAmount: "4000000000000000000",
+		Denom:        usdcDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(usdcDenom, 2_000_000), estimateRes.Fee)
+
+	// Invalid amounts and denoms are refused
+	_, err = suite.queryClient.EstimateFee(suite.Ctx, &types.QueryEstimateFeeRequest{NativeAmount: "abc", Denom: usdcDenom})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.EstimateFee(suite.Ctx, &types.QueryEstimateFeeRequest{NativeAmount: "1", Denom: "uatom"})
+	suite.Require().ErrorContains(err, types.ErrFeeDenomNotAllowed.Error())
+}
+
+// TestGenesis tests the genesis import and export
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.setupFeeDenom()
+	params, err := suite.App.FeeAbsKeeper.Params.Get(suite.Ctx)
+	suite.Require().NoError(err)
+
+	exported := suite.App.FeeAbsKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(params, exported.Params)
+
+	// Import on a fresh state
+	suite.SetupTest()
+	suite.App.FeeAbsKeeper.InitGenesis(suite.Ctx, *exported)
+	suite.Require().Equal(exported, suite.App.FeeAbsKeeper.ExportGenesis(suite.Ctx))
+}
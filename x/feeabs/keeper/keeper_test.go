@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/feeabs/keeper"
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	oracleutils "github.com/kiichain/kiichain/v3/x/oracle/utils"
+)
+
+// usdcDenom is the alternative fee denom used on the tests
+const usdcDenom = "ibc/usdc"
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.FeeAbsKeeper)
+}
+
+// setOraclePrices sets the oracle prices and a single snapshot for the TWAPs
+func (suite *KeeperTestSuite) setOraclePrices(prices map[string]sdkmath.LegacyDec) {
+	oracleKeeper := suite.App.OracleKeeper
+
+	snapshot := oracletypes.PriceSnapshot{SnapshotTimestamp: suite.Ctx.BlockTime().Unix() - 60}
+	for denom, price := range prices {
+		err := oracleKeeper.VoteTarget.Set(suite.Ctx, denom, oracletypes.Denom{Name: denom})
+		suite.Require().NoError(err)
+		err = oracleKeeper.SetBaseExchangeRateWithDefault(suite.Ctx, denom, price)
+		suite.Require().NoError(err)
+
+		snapshot.PriceSnapshotItems = append(snapshot.PriceSnapshotItems, oracletypes.PriceSnapshotItem{
+			Denom: denom,
+			OracleExchangeRate: oracletypes.OracleExchangeRate{
+				ExchangeRate:        price,
+				LastUpdate:          sdkmath.NewInt(suite.Ctx.BlockHeight()),
+				LastUpdateTimestamp: suite.Ctx.BlockTime().UnixMilli(),
+			},
+		})
+	}
+
+	err := oracleKeeper.AddPriceSnapshot(suite.Ctx, snapshot)
+	suite.Require().NoError(err)
+}
+
+// setupFeeDenom whitelists usdc as a fee denom with kii at 0.5 and usdc at 1
+func (suite *KeeperTestSuite) setupFeeDenom() {
+	params := types.DefaultParams()
+	params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(usdcDenom, oracleutils.MicroUsdcDenom, 6)}
+	err := suite.App.FeeAbsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	suite.setOraclePrices(map[string]sdkmath.LegacyDec{
+		oracleutils.MicroKiiDenom:  sdkmath.LegacyNewDecWithPrec(5, 1),
+		oracleutils.MicroUsdcDenom: sdkmath.LegacyOneDec(),
+	})
+}
+
+// TestConversion tests the conversion between the native and the fee denoms
+func (suite *KeeperTestSuite) TestConversion() {
+	suite.SetupTest()
+	suite.setupFeeDenom()
+	k := suite.App.FeeAbsKeeper
+
+	// Check the whitelist
+	isFeeDenom, err := k.IsFeeDenom(suite.Ctx, usdcDenom)
+	suite.Require().NoError(err)
+	suite.Require().True(isFeeDenom)
+	isFeeDenom, err = k.IsFeeDenom(suite.Ctx, "uatom")
+	suite.Require().NoError(err)
+	suite.Require().False(isFeeDenom)
+
+	// 1 usdc is worth 2 kii
+	native, err := k.ConvertToNative(suite.Ctx, sdk.NewInt64Coin(usdcDenom, 1_000_000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewIntWithDecimal(2, 18), native)
+
+	// And back
+	fee, err := k.ConvertFromNative(suite.Ctx, sdkmath.NewIntWithDecimal(2, 18), usdcDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(usdcDenom, 1_000_000), fee)
+
+	// Dust is rounded up when converting from the native denom
+	fee, err = k.ConvertFromNative(suite.Ctx, sdkmath.NewInt(1), usdcDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(usdcDenom, 1), fee)
+
+	// Denoms out of the whitelist can't be converted
+	_, err = k.ConvertToNative(suite.Ctx, sdk.NewInt64Coin("uatom", 1))
+	suite.Require().ErrorIs(err, types.ErrFeeDenomNotAllowed)
+}
+
+// TestStalePrice tests that stale or missing prices are refused
+func (suite *KeeperTestSuite) TestStalePrice() {
+	suite.SetupTest()
+	k := suite.App.FeeAbsKeeper
+
+	// No price for the denom
+	params := types.DefaultParams()
+	params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(usdcDenom, oracleutils.MicroUsdcDenom, 6)}
+	err := k.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	_, err = k.ConvertToNative(suite.Ctx, sdk.NewInt64Coin(usdcDenom, 1))
+	suite.Require().ErrorIs(err, types.ErrPriceNotFound)
+
+	// Prices older than the max age are stale
+	suite.setupFeeDenom()
+	maxAge := time.Duration(params.MaxPriceAgeSeconds) * time.Second
+	staleCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(maxAge + time.Second))
+	_, err = k.ConvertToNative(staleCtx, sdk.NewInt64Coin(usdcDenom, 1))
+	suite.Require().ErrorIs(err, types.ErrStalePrice)
+}
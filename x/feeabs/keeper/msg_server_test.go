@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+	oracleutils "github.com/kiichain/kiichain/v3/x/oracle/utils"
+)
+
+// TestUpdateParams tests changes to the params of the module
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	validParams := types.DefaultParams()
+	validParams.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(usdcDenom, oracleutils.MicroUsdcDenom, 6)}
+
+	invalidParams := types.DefaultParams()
+	invalidParams.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(usdcDenom, "", 6)}
+
+	testCases := []struct {
+		name         string
+		msg          *types.MsgUpdateParams
+		expectedPass bool
+	}{
+		{
+			name:         "valid authority",
+			msg:          types.NewMsgUpdateParams(suite.App.FeeAbsKeeper.GetAuthority(), validParams),
+			expectedPass: true,
+		},
+		{
+			name:         "invalid authority",
+			msg:          types.NewMsgUpdateParams(suite.TestAccs[0].String(), validParams),
+			expectedPass: false,
+		},
+		{
+			name:         "invalid params",
+			msg:          types.NewMsgUpdateParams(suite.App.FeeAbsKeeper.GetAuthority(), invalidParams),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(suite.Ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify params were updated
+				params, err := suite.App.FeeAbsKeeper.Params.Get(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
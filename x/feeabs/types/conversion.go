@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionRate converts amounts between the native denom and a fee denom at fixed oracle prices
+type ConversionRate struct {
+	// Denom is the fee denom
+	Denom string
+	// NativePrice is the oracle TWAP of the native denom
+	NativePrice sdkmath.LegacyDec
+	// DenomPrice is the oracle TWAP of the fee denom
+	DenomPrice sdkmath.LegacyDec
+	// NativeExponent is the decimal exponent of the native denom
+	NativeExponent uint32
+	// DenomExponent is the decimal exponent of the fee denom
+	DenomExponent uint32
+}
+
+// ToNative converts an amount of the fee denom to the native denom
+// The result is truncated so the fee is never worth more than what was paid
+func (r ConversionRate) ToNative(amount sdkmath.Int) sdkmath.Int {
+	// native = amount * denomPrice * 10^nativeExp / (nativePrice * 10^denomExp)
+	value := sdkmath.LegacyNewDecFromInt(amount.Mul(pow10(r.NativeExponent))).Mul(r.DenomPrice)
+	return value.Quo(r.NativePrice.MulInt(pow10(r.DenomExponent))).TruncateInt()
+}
+
+// FromNative converts a native denom amount to the fee denom
+// The result is rounded up so the fee is never worth less than the native amount
+func (r ConversionRate) FromNative(nativeAmount sdkmath.Int) sdk.Coin {
+	// amount = native * nativePrice * 10^denomExp / (denomPrice * 10^nativeExp)
+	value := sdkmath.LegacyNewDecFromInt(nativeAmount.Mul(pow10(r.DenomExponent))).Mul(r.NativePrice)
+	amount := value.Quo(r.DenomPrice.MulInt(pow10(r.NativeExponent))).Ceil().TruncateInt()
+	return sdk.NewCoin(r.Denom, amount)
+}
+
+// pow10 returns 10^exponent as an integer
+func pow10(exponent uint32) sdkmath.Int {
+	return sdkmath.NewIntWithDecimal(1, int(exponent))
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feeabs module sentinel errors
+var (
+	ErrFeeDenomNotAllowed = errorsmod.Register(ModuleName, 2, "fee denom not allowed")
+	ErrPriceNotFound      = errorsmod.Register(ModuleName, 3, "oracle price not found")
+	ErrStalePrice         = errorsmod.Register(ModuleName, 4, "oracle price is stale")
+	ErrInvalidFee         = errorsmod.Register(ModuleName, 5, "invalid fee")
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleKeeper defines the expected interface needed to price the fee denoms
+type OracleKeeper interface {
+	GetRecentTwaps(ctx sdk.Context, denoms []string, maxPriceAgeSeconds, lookBackSeconds uint64) (map[string]sdkmath.LegacyDec, error)
+}
@@ -0,0 +1,18 @@
+package types
+
+// NewGenesisState constructs a genesis state
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of feeabs.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate validates the genesis state of feeabs genesis input
+func (gs *GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
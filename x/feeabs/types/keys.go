@@ -0,0 +1,19 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var ParamsKey = collections.NewPrefix(0)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the feeabs module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
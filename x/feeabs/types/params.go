@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/kiichain/kiichain/v3/app/params"
+	oracleutils "github.com/kiichain/kiichain/v3/x/oracle/utils"
+)
+
+const (
+	// MaxExponent bounds the decimal exponent of the denoms
+	MaxExponent = 18
+
+	// DefaultTwapLookbackSeconds is the default oracle TWAP window
+	DefaultTwapLookbackSeconds uint64 = 600
+
+	// DefaultMaxPriceAgeSeconds is the default maximum age of the oracle prices
+	DefaultMaxPriceAgeSeconds uint64 = 300
+)
+
+// NewFeeDenom returns a new FeeDenom
+func NewFeeDenom(denom, oracleDenom string, exponent uint32) FeeDenom {
+	return FeeDenom{
+		Denom:       denom,
+		OracleDenom: oracleDenom,
+		Exponent:    exponent,
+	}
+}
+
+// DefaultParams returns default feeabs parameters, without any fee denom
+func DefaultParams() Params {
+	return Params{
+		NativeOracleDenom:   oracleutils.MicroKiiDenom,
+		NativeExponent:      appparams.BaseDenomUnit,
+		TwapLookbackSeconds: DefaultTwapLookbackSeconds,
+		MaxPriceAgeSeconds:  DefaultMaxPriceAgeSeconds,
+	}
+}
+
+// Validate performs basic validation on the feeabs parameters
+func (p Params) Validate() error {
+	if p.NativeOracleDenom == "" {
+		return fmt.Errorf("native oracle denom can't be empty")
+	}
+
+	if p.NativeExponent > MaxExponent {
+		return fmt.Errorf("native exponent must be at most %d, got %d", MaxExponent, p.NativeExponent)
+	}
+
+	if p.TwapLookbackSeconds == 0 {
+		return fmt.Errorf("twap lookback must be positive")
+	}
+
+	if p.MaxPriceAgeSeconds == 0 {
+		return fmt.Errorf("max price age must be positive")
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicated fee denom: %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+	}
+
+	return nil
+}
+
+// GetFeeDenom returns the whitelisted fee denom, the boolean is false if the
+// denom is not whitelisted
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// Validate performs basic validation on the fee denom
+func (f FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if f.OracleDenom == "" {
+		return fmt.Errorf("fee denom %s oracle denom can't be empty", f.Denom)
+	}
+
+	if f.Exponent > MaxExponent {
+		return fmt.Errorf("fee denom %s exponent must be at most %d, got %d", f.Denom, MaxExponent, f.Exponent)
+	}
+
+	return nil
+}
@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeabs/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the feeabs module.
+type Params struct {
+	// native_oracle_denom is the oracle denom with the price of the native fee
+	// denom
+	NativeOracleDenom string `protobuf:"bytes,1,opt,name=native_oracle_denom,json=nativeOracleDenom,proto3" json:"native_oracle_denom,omitempty"`
+	// native_exponent is the decimal exponent of the native fee denom
+	NativeExponent uint32 `protobuf:"varint,2,opt,name=native_exponent,json=nativeExponent,proto3" json:"native_exponent,omitempty"`
+	// twap_lookback_seconds is the oracle TWAP window used on the conversions
+	TwapLookbackSeconds uint64 `protobuf:"varint,3,opt,name=twap_lookback_seconds,json=twapLookbackSeconds,proto3" json:"twap_lookback_seconds,omitempty"`
+	// max_price_age_seconds is the maximum time since the last oracle update,
+	// fees in alternate denoms are rejected when any of the prices is older
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,4,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty"`
+	// fee_denoms are the denoms whitelisted to pay fees
+	FeeDenoms []FeeDenom `protobuf:"bytes,5,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d62adbbabeeb42d, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetNativeOracleDenom() string {
+	if m != nil {
+		return m.NativeOracleDenom
+	}
+	return ""
+}
+
+func (m *Params) GetNativeExponent() uint32 {
+	if m != nil {
+		return m.NativeExponent
+	}
+	return 0
+}
+
+func (m *Params) GetTwapLookbackSeconds() uint64 {
+	if m != nil {
+		return m.TwapLookbackSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+// FeeDenom defines an alternate denom that can be used to pay fees
+type FeeDenom struct {
+	// denom is the denom used on the transaction fee, e.g. an IBC or
+	// tokenfactory denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// oracle_denom is the oracle denom with the price of the denom
+	OracleDenom string `protobuf:"bytes,2,opt,name=oracle_denom,json=oracleDenom,proto3" json:"oracle_denom,omitempty"`
+	// exponent is the decimal exponent of the denom
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d62adbbabeeb42d, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kiichain.feeabs.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "kiichain.feeabs.v1beta1.FeeDenom")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabs/v1beta1/params.proto", fileDescriptor_7d62adbbabeeb42d)
+}
+
+var fileDescriptor_7d62adbbabeeb42d = []byte{
+	// 351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x6a, 0xfa, 0x40,
+	0x14, 0xc5, 0x13, 0xbf, 0xd0, 0xf1, 0xff, 0x41, 0x47, 0xa5, 0xc1, 0x45, 0x1a, 0xa5, 0xd0, 0x40,
+	0x21, 0x41, 0xfb, 0x04, 0x95, 0xea, 0xaa, 0x50, 0x49, 0x77, 0xdd, 0x84, 0x49, 0xbc, 0xc6, 0xa0,
+	0xc9, 0x84, 0x64, 0x6a, 0xd3, 0xb7, 0xf0, 0xb1, 0x5c, 0xba, 0xec, 0xaa, 0x14, 0x7d, 0x91, 0x92,
+	0x99, 0x24, 0xd8, 0x45, 0x77, 0xf7, 0xde, 0xdf, 0x39, 0x30, 0x73, 0x0e, 0xba, 0x5e, 0xfb, 0xbe,
+	0xbb, 0x22, 0x7e, 0x68, 0x2e, 0x01, 0x88, 0x93, 0x98, 0xdb, 0x91, 0x03, 0x8c, 0x8c, 0xcc, 0x88,
+	0xc4, 0x24, 0x48, 0x8c, 0x28, 0xa6, 0x8c, 0xe2, 0xcb, 0x42, 0x65, 0x08, 0x95, 0x91, 0xab, 0xfa,
+	0x5d, 0x8f, 0x7a, 0x94, 0x6b, 0xcc, 0x6c, 0x12, 0xf2, 0xe1, 0xae, 0x82, 0x1a, 0x73, 0xee, 0xc7,
+	0x06, 0xea, 0x84, 0x84, 0xf9, 0x5b, 0xb0, 0x69, 0x4c, 0xdc, 0x0d, 0xd8, 0x0b, 0x08, 0x69, 0xa0,
+	0xc8, 0x9a, 0xac, 0xb7, 0xac, 0x0b, 0x81, 0x9e, 0x38, 0x79, 0xc8, 0x00, 0xbe, 0x41, 0xff, 0x73,
+	0x3d, 0xa4, 0x11, 0x0d, 0x21, 0x64, 0x4a, 0x45, 0x93, 0xf5, 0xbf, 0xd6, 0x3f, 0x71, 0x9e, 0xe6,
+	0x57, 0x3c, 0x46, 0x3d, 0xf6, 0x46, 0x22, 0x7b, 0x43, 0xe9, 0xda, 0x21, 0xee, 0xda, 0x4e, 0xc0,
+	0xa5, 0xe1, 0x22, 0x51, 0xaa, 0x9a, 0xac, 0xd7, 0xac, 0x4e, 0x06, 0x1f, 0x73, 0xf6, 0x2c, 0x10,
+	0x1e, 0xa1, 0x5e, 0x40, 0x52, 0x3b, 0x8a, 0x7d, 0x17, 0x6c, 0xe2, 0x41, 0xe9, 0xa9, 0x71, 0x0f,
+	0x0e, 0x48, 0x3a, 0xcf, 0xd8, 0xbd, 0x07, 0x85, 0x65, 0x86, 0xd0, 0x12, 0xf2, 0x57, 0x27, 0x4a,
+	0x5d, 0xab, 0xea, 0xed, 0xf1, 0xc0, 0xf8, 0x25, 0x0e, 0x63, 0x06, 0xe2, 0x1b, 0x93, 0xda, 0xfe,
+	0xf3, 0x4a, 0xb2, 0x5a, 0xcb, 0x7c, 0x4f, 0x86, 0x36, 0x6a, 0x16, 0x10, 0x77, 0x51, 0xfd, 0x3c,
+	0x05, 0xb1, 0xe0, 0x01, 0xfa, 0xf3, 0x23, 0xa2, 0x0a, 0x87, 0x6d, 0x7a, 0x16, 0x4e, 0x1f, 0x35,
+	0xcb, 0x54, 0xaa, 0x3c, 0x95, 0x72, 0x9f, 0x4c, 0xf7, 0x47, 0x55, 0x3e, 0x1c, 0x55, 0xf9, 0xeb,
+	0xa8, 0xca, 0xbb, 0x93, 0x2a, 0x1d, 0x4e, 0xaa, 0xf4, 0x71, 0x52, 0xa5, 0x97, 0x5b, 0xcf, 0x67,
+	0xab, 0x57, 0xc7, 0x70, 0x69, 0x60, 0x96, 0x6d, 0x97, 0x43, 0x5a, 0x14, 0xcf, 0xde, 0x23, 0x48,
+	0x9c, 0x06, 0x6f, 0xf0, 0xee, 0x7b, 0x00, 0x8f, 0x1d, 0x70, 0x59, 0x18, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAgeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TwapLookbackSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TwapLookbackSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NativeExponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.NativeExponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NativeOracleDenom) > 0 {
+		i -= len(m.NativeOracleDenom)
+		copy(dAtA[i:], m.NativeOracleDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NativeOracleDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeOracleDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.NativeExponent != 0 {
+		n += 1 + sovParams(uint64(m.NativeExponent))
+	}
+	if m.TwapLookbackSeconds != 0 {
+		n += 1 + sovParams(uint64(m.TwapLookbackSeconds))
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAgeSeconds))
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovParams(uint64(m.Exponent))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeOracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeOracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeExponent", wireType)
+			}
+			m.NativeExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NativeExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackSeconds", wireType)
+			}
+			m.TwapLookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapLookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeSeconds", wireType)
+			}
+			m.MaxPriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
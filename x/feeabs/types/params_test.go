@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/v3/x/feeabs/types"
+)
+
+// TestValidateParams tests the validation of the feeabs params
+func TestValidateParams(t *testing.T) {
+	usdc := types.NewFeeDenom("ibc/usdc", "uusdc", 6)
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+		errorMsg string
+	}{
+		{
+			name:     "default params",
+			malleate: func(*types.Params) {},
+		},
+		{
+			name:     "valid fee denom",
+			malleate: func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{usdc} },
+		},
+		{
+			name:     "empty native oracle denom",
+			malleate: func(p *types.Params) { p.NativeOracleDenom = "" },
+			errorMsg: "native oracle denom can't be empty",
+		},
+		{
+			name:     "zero twap lookback",
+			malleate: func(p *types.Params) { p.TwapLookbackSeconds = 0 },
+			errorMsg: "twap lookback must be positive",
+		},
+		{
+			name:     "zero max price age",
+			malleate: func(p *types.Params) { p.MaxPriceAgeSeconds = 0 },
+			errorMsg: "max price age must be positive",
+		},
+		{
+			name:     "exponent too large",
+			malleate: func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("ibc/usdc", "uusdc", 19)} },
+			errorMsg: "exponent must be at most 18",
+		},
+		{
+			name:     "duplicated fee denom",
+			malleate: func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{usdc, usdc} },
+			errorMsg: "duplicated fee denom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
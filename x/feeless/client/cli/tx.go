@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feeless transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewUpdateParamsCmd(),
+		NewSetPolicyCmd(),
+		NewRemovePolicyCmd(),
+		NewRegisterRelayerCmd(),
+		NewRemoveRelayerCmd(),
+	)
+
+	return cmd
+}
+
+// NewUpdateParamsCmd implements the update-params tx command.
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-json]",
+		Short: "Update module parameters (gov proposal)",
+		Long: `Update module parameters through a governance proposal. Example:
+$ %s tx gov submit-proposal update-feeless-params <path/to/params.json> --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &params); err != nil {
+				return fmt.Errorf("failed to parse params: %w", err)
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetPolicyCmd implements the set-policy tx command.
+func NewSetPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-policy [msg-type-url] [eligibility] [max-txs-per-block]",
+		Short: "Add or replace the feeless policy of a message type (gov proposal)",
+		Long: `Add or replace the feeless policy of a message type through a governance proposal.
+The eligibility is one of oracle_feeder, validator_operator, bonded_validator or registered_relayer. Example:
+$ %s tx feeless set-policy /cosmos.slashing.v1beta1.MsgUnjail validator_operator 1 --from mykey
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			eligibility, err := types.ParseEligibility(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxTxsPerBlock, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid max txs per block: %w", err)
+			}
+
+			policy := types.NewFeelessPolicy(args[0], eligibility, maxTxsPerBlock)
+			msg := types.NewMsgSetPolicy(clientCtx.GetFromAddress().String(), policy)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemovePolicyCmd implements the remove-policy tx command.
+func NewRemovePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-policy [msg-type-url]",
+		Short: "Remove the feeless policy of a message type (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemovePolicy(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterRelayerCmd implements the register-relayer tx command.
+func NewRegisterRelayerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-relayer [address]",
+		Short: "Register a relayer for feeless policies (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relayer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid relayer address: %w", err)
+			}
+
+			msg := types.NewMsgRegisterRelayer(clientCtx.GetFromAddress().String(), relayer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveRelayerCmd implements the remove-relayer tx command.
+func NewRemoveRelayerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-relayer [address]",
+		Short: "Remove a registered relayer (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			relayer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid relayer address: %w", err)
+			}
+
+			msg := types.NewMsgRemoveRelayer(clientCtx.GetFromAddress().String(), relayer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
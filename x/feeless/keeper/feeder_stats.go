@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// GetFeederStats returns the feeless oracle vote counters of a feeder
+// Feeders without any feeless vote get empty counters
+func (k Keeper) GetFeederStats(ctx context.Context, feeder sdk.AccAddress) (types.FeederStats, error) {
+	stats, err := k.FeederStats.Get(ctx, feeder)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeederStats{Feeder: feeder.String()}, nil
+	}
+
+	return stats, err
+}
+
+// ConsumeFeederQuota registers a feeless oracle vote from the feeder on the vote period
+// It returns false without registering the vote if the feeder is penalized or
+// reached the cap of feeless votes for the vote period. The cap is the amount of
+// validators the feeder votes for, lowered by the max feeless votes per period param
+//
+// Only DeliverTx registers the vote, CheckTx and ReCheckTx just check the quota.
+// The vote is also counted as failed, the feeless post handler clears the failures
+// once the vote is executed. Feeders reaching the max failed votes are penalized
+// and pay fees for the next vote periods
+func (k Keeper) ConsumeFeederQuota(ctx sdk.Context, feeder sdk.AccAddress, votePeriod uint64, feederValidators uint64) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	stats, err := k.GetFeederStats(ctx, feeder)
+	if err != nil {
+		return false, err
+	}
+
+	// Penalized feeders always pay fees
+	if votePeriod < stats.PenalizedUntil {
+		return false, nil
+	}
+
+	// Too many failures in a row start the penalty
+	if params.MaxFailedFeelessVotes != 0 && stats.FailedVotes >= params.MaxFailedFeelessVotes {
+		if ctx.IsCheckTx() {
+			return false, nil
+		}
+
+		stats.PenalizedUntil = votePeriod + params.PenaltyVotePeriods
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePenalizeFeeder,
+				sdk.NewAttribute(types.AttributeKeyFeeder, stats.Feeder),
+				sdk.NewAttribute(types.AttributeKeyFailedVotes, strconv.FormatUint(stats.FailedVotes, 10)),
+				sdk.NewAttribute(types.AttributeKeyPenalizedUntil, strconv.FormatUint(stats.PenalizedUntil, 10)),
+			),
+		)
+		stats.FailedVotes = 0
+		return false, k.FeederStats.Set(ctx, feeder, stats)
+	}
+
+	// The votes are counted per vote period
+	if stats.VotePeriod != votePeriod {
+		stats.VotePeriod = votePeriod
+		stats.FeelessVotes = 0
+	}
+	maxVotes := feederValidators
+	if params.MaxFeelessVotesPerPeriod != 0 && params.MaxFeelessVotesPerPeriod < maxVotes {
+		maxVotes = params.MaxFeelessVotesPerPeriod
+	}
+	if stats.FeelessVotes >= maxVotes {
+		return false, nil
+	}
+
+	// ReCheckTx runs again for every pending tx after each block, the votes are only counted once delivered
+	if ctx.IsCheckTx() {
+		return true, nil
+	}
+
+	stats.FeelessVotes++
+	stats.FailedVotes++
+	return true, k.FeederStats.Set(ctx, feeder, stats)
+}
+
+// ResetFailedVotes clears the failed feeless votes of a feeder after a successful vote
+func (k Keeper) ResetFailedVotes(ctx context.Context, feeder sdk.AccAddress) error {
+	stats, err := k.FeederStats.Get(ctx, feeder)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if stats.FailedVotes == 0 {
+		return nil
+	}
+
+	stats.FailedVotes = 0
+	return k.FeederStats.Set(ctx, feeder, stats)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// InitGenesis sets feeless information from genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, policy := range data.Policies {
+		if err := k.Policies.Set(ctx, policy.MsgTypeUrl, policy); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, relayer := range data.Relayers {
+		if err := k.Relayers.Set(ctx, sdk.MustAccAddressFromBech32(relayer)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, stats := range data.FeederStats {
+		if err := k.FeederStats.Set(ctx, sdk.MustAccAddressFromBech32(stats.Feeder), stats); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	policies := []types.FeelessPolicy{}
+	err = k.Policies.Walk(ctx, nil, func(_ string, policy types.FeelessPolicy) (bool, error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	relayers := []string{}
+	err = k.Relayers.Walk(ctx, nil, func(relayer sdk.AccAddress) (bool, error) {
+		relayers = append(relayers, relayer.String())
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	feederStats := []types.FeederStats{}
+	err = k.FeederStats.Walk(ctx, nil, func(_ sdk.AccAddress, stats types.FeederStats) (bool, error) {
+		feederStats = append(feederStats, stats)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, policies, relayers, feederStats)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries params of feeless module
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Policies queries all the feeless policies
+func (k Querier) Policies(ctx context.Context, req *types.QueryPoliciesRequest) (*types.QueryPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.Policies,
+		req.Pagination,
+		func(_ string, policy types.FeelessPolicy) (types.FeelessPolicy, error) {
+			return policy, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+// Policy queries the feeless policy of a message type
+func (k Querier) Policy(ctx context.Context, req *types.QueryPolicyRequest) (*types.QueryPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	policy, found, err := k.Keeper.GetPolicy(ctx, req.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrPolicyNotFound.Wrapf("msg type url %s", req.MsgTypeUrl)
+	}
+
+	return &types.QueryPolicyResponse{Policy: policy}, nil
+}
+
+// Relayers queries the registered relayers
+func (k Querier) Relayers(ctx context.Context, req *types.QueryRelayersRequest) (*types.QueryRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	relayers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.Relayers,
+		req.Pagination,
+		func(relayer sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return relayer.String(), nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRelayersResponse{Relayers: relayers, Pagination: pageRes}, nil
+}
+
+// FeederStats queries the feeless oracle vote counters of a feeder
+func (k Querier) FeederStats(ctx context.Context, req *types.QueryFeederStatsRequest) (*types.QueryFeederStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	feeder, err := sdk.AccAddressFromBech32(req.Feeder)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid feeder address: %s", err)
+	}
+
+	stats, err := k.Keeper.GetFeederStats(ctx, feeder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeederStatsResponse{Stats: stats}, nil
+}
+
+// AllFeederStats queries the feeless oracle vote counters of all the feeders
+func (k Querier) AllFeederStats(ctx context.Context, req *types.QueryAllFeederStatsRequest) (*types.QueryAllFeederStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stats, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.FeederStats,
+		req.Pagination,
+		func(_ sdk.AccAddress, stats types.FeederStats) (types.FeederStats, error) {
+			return stats, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllFeederStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// TestQueries tests the feeless queries
+func (suite *KeeperTestSuite) TestQueries() {
+	// Default params and policies are set from genesis
+	paramsRes, err := suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	policiesRes, err := suite.queryClient.Policies(suite.Ctx, &types.QueryPoliciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultPolicies(), policiesRes.Policies)
+
+	// Add a policy and a couple relayers
+	unjailPolicy := types.NewFeelessPolicy("/cosmos.slashing.v1beta1.MsgUnjail", types.EligibilityValidatorOperator, 1)
+	err = suite.App.FeelessKeeper.Policies.Set(suite.Ctx, unjailPolicy.MsgTypeUrl, unjailPolicy)
+	suite.Require().NoError(err)
+	for _, relayer := range suite.TestAccs[:2] {
+		err = suite.App.FeelessKeeper.Relayers.Set(suite.Ctx, relayer)
+		suite.Require().NoError(err)
+	}
+
+	// Policies can be paginated
+	policiesRes, err = suite.queryClient.Policies(suite.Ctx, &types.QueryPoliciesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(policiesRes.Policies, 1)
+	suite.Require().Equal(uint64(2), policiesRes.Pagination.Total)
+
+	// Query a single policy
+	policyRes, err := suite.queryClient.Policy(suite.Ctx, &types.QueryPolicyRequest{MsgTypeUrl: unjailPolicy.MsgTypeUrl})
+	suite.Require().NoError(err)
+	suite.Require().Equal(unjailPolicy, policyRes.Policy)
+
+	_, err = suite.queryClient.Policy(suite.Ctx, &types.QueryPolicyRequest{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"})
+	suite.Require().ErrorContains(err, "feeless policy not found")
+
+	// Query the relayers
+	relayersRes, err := suite.queryClient.Relayers(suite.Ctx, &types.QueryRelayersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{suite.TestAccs[0].String(), suite.TestAccs[1].String()}, relayersRes.Relayers)
+
+	// Feeders without votes have empty counters
+	feeder := suite.TestAccs[2]
+	feederRes, err := suite.queryClient.FeederStats(suite.Ctx, &types.QueryFeederStatsRequest{Feeder: feeder.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeederStats{Feeder: feeder.String()}, feederRes.Stats)
+
+	_, err = suite.queryClient.FeederStats(suite.Ctx, &types.QueryFeederStatsRequest{Feeder: "invalid"})
+	suite.Require().ErrorContains(err, "invalid feeder address")
+
+	// Register a feeless vote and query the counters
+	ok, err := suite.App.FeelessKeeper.ConsumeFeederQuota(suite.Ctx.WithIsCheckTx(false), feeder, 7, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+
+	feederRes, err = suite.queryClient.FeederStats(suite.Ctx, &types.QueryFeederStatsRequest{Feeder: feeder.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(7), feederRes.Stats.VotePeriod)
+	suite.Require().Equal(uint64(1), feederRes.Stats.FeelessVotes)
+
+	allStatsRes, err := suite.queryClient.AllFeederStats(suite.Ctx, &types.QueryAllFeederStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeederStats{feederRes.Stats}, allStatsRes.Stats)
+}
+
+// TestGenesis tests the feeless genesis import and export
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesis := types.NewGenesisState(
+		types.Params{MaxGasPerTx: 300_000},
+		[]types.FeelessPolicy{
+			types.NewFeelessPolicy("/cosmos.slashing.v1beta1.MsgUnjail", types.EligibilityValidatorOperator, 1),
+			types.NewFeelessPolicy("/ibc.core.client.v1.MsgUpdateClient", types.EligibilityRegisteredRelayer, 10),
+		},
+		[]string{suite.TestAccs[0].String()},
+		[]types.FeederStats{
+			{Feeder: suite.TestAccs[1].String(), VotePeriod: 10, FeelessVotes: 2, FailedVotes: 1, PenalizedUntil: 5},
+		},
+	)
+
+	// Start from an empty policy set
+	err := suite.App.FeelessKeeper.Policies.Clear(suite.Ctx, nil)
+	suite.Require().NoError(err)
+
+	suite.App.FeelessKeeper.InitGenesis(suite.Ctx, *genesis)
+	exported := suite.App.FeelessKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(genesis, exported)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+type (
+	Keeper struct {
+		cdc codec.BinaryCodec
+
+		// the address capable of executing the governance messages. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		Schema   collections.Schema
+		Params   collections.Item[types.Params]
+		Policies collections.Map[string, types.FeelessPolicy]
+		Relayers collections.KeySet[sdk.AccAddress]
+
+		// FeederStats holds the feeless oracle vote counters by feeder
+		FeederStats collections.Map[sdk.AccAddress, types.FeederStats]
+
+		// BlockUsage counts the feeless txs by signer and message type, it lives
+		// on the transient store so it is reset every block
+		BlockUsage collections.Map[collections.Pair[sdk.AccAddress, string], uint64]
+	}
+)
+
+// NewKeeper returns a new instance of the x/feeless keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	transientStoreService store.TransientStoreService,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	k := Keeper{
+		cdc: cdc,
+
+		authority: authority,
+
+		Params:   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Policies: collections.NewMap(sb, types.PoliciesKey, "policies", collections.StringKey, codec.CollValue[types.FeelessPolicy](cdc)),
+		Relayers: collections.NewKeySet(sb, types.RelayersKey, "relayers", sdk.AccAddressKey),
+
+		FeederStats: collections.NewMap(sb, types.FeederStatsKey, "feeder_stats", sdk.AccAddressKey, codec.CollValue[types.FeederStats](cdc)),
+
+		BlockUsage: collections.NewMap(tsb, types.BlockUsageKey, "block_usage", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// GetAuthority returns the x/feeless module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/feeless module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetPolicy returns the feeless policy of a message type
+// The boolean is false if the message type has no policy
+func (k Keeper) GetPolicy(ctx context.Context, msgTypeURL string) (types.FeelessPolicy, bool, error) {
+	policy, err := k.Policies.Get(ctx, msgTypeURL)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeelessPolicy{}, false, nil
+	}
+	if err != nil {
+		return types.FeelessPolicy{}, false, err
+	}
+
+	return policy, true, nil
+}
+
+// IsRelayer checks if an address is a registered relayer
+func (k Keeper) IsRelayer(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.Relayers.Has(ctx, addr)
+}
+
+// ConsumeBlockQuota registers a feeless tx from the signers under the policy
+// It returns false without registering anything if any of the signers has
+// reached the policy limit for the current block
+func (k Keeper) ConsumeBlockQuota(ctx context.Context, signers []sdk.AccAddress, policy types.FeelessPolicy) (bool, error) {
+	// Zero disables the limit
+	if policy.MaxTxsPerBlock == 0 {
+		return true, nil
+	}
+
+	// Check all the signers before registering the usage
+	usage := make([]uint64, len(signers))
+	for i, signer := range signers {
+		used, err := k.BlockUsage.Get(ctx, collections.Join(signer, policy.MsgTypeUrl))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return false, err
+		}
+		if used >= policy.MaxTxsPerBlock {
+			return false, nil
+		}
+		usage[i] = used
+	}
+
+	for i, signer := range signers {
+		if err := k.BlockUsage.Set(ctx, collections.Join(signer, policy.MsgTypeUrl), usage[i]+1); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// validateAuthority checks if address authority is valid and same as expected
+func (k Keeper) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/feeless/keeper"
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.FeelessKeeper)
+}
+
+// TestConsumeBlockQuota tests the per block quota of the signers
+func (suite *KeeperTestSuite) TestConsumeBlockQuota() {
+	k := suite.App.FeelessKeeper
+	signerA, signerB := suite.TestAccs[0], suite.TestAccs[1]
+	policy := types.NewFeelessPolicy("/cosmos.slashing.v1beta1.MsgUnjail", types.EligibilityValidatorOperator, 2)
+
+	// Two txs are allowed for the first signer
+	for i := 0; i < 2; i++ {
+		ok, err := k.ConsumeBlockQuota(suite.Ctx, []sdk.AccAddress{signerA}, policy)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+	}
+
+	// The third is refused
+	ok, err := k.ConsumeBlockQuota(suite.Ctx, []sdk.AccAddress{signerA}, policy)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	// A tx signed by both signers is refused without using the second signer quota
+	ok, err = k.ConsumeBlockQuota(suite.Ctx, []sdk.AccAddress{signerB, signerA}, policy)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+	used, err := k.BlockUsage.Get(suite.Ctx, collections.Join(signerB, policy.MsgTypeUrl))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	suite.Require().Zero(used)
+
+	// Other message types have their own quota
+	otherPolicy := types.NewFeelessPolicy("/cosmos.bank.v1beta1.MsgSend", types.EligibilityValidatorOperator, 1)
+	ok, err = k.ConsumeBlockQuota(suite.Ctx, []sdk.AccAddress{signerA}, otherPolicy)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+
+	// Policies without limit are always allowed
+	otherPolicy.MaxTxsPerBlock = 0
+	for i := 0; i < 5; i++ {
+		ok, err = k.ConsumeBlockQuota(suite.Ctx, []sdk.AccAddress{signerA}, otherPolicy)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+	}
+}
+
+// TestConsumeFeederQuota tests the feeless vote counters of the feeders
+func (suite *KeeperTestSuite) TestConsumeFeederQuota() {
+	k := suite.App.FeelessKeeper
+	feeder := suite.TestAccs[0]
+	params := types.Params{MaxFeelessVotesPerPeriod: 2, MaxFailedFeelessVotes: 2, PenaltyVotePeriods: 3}
+	suite.Require().NoError(k.Params.Set(suite.Ctx, params))
+
+	// CheckTx and ReCheckTx only check the quota
+	for _, checkCtx := range []sdk.Context{suite.Ctx.WithIsCheckTx(true), suite.Ctx.WithIsReCheckTx(true)} {
+		ok, err := k.ConsumeFeederQuota(checkCtx, feeder, 1, 3)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+	}
+	stats, err := k.GetFeederStats(suite.Ctx, feeder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeederStats{Feeder: feeder.String()}, stats)
+
+	// Two votes are allowed on the vote period by the param
+	deliverCtx := suite.Ctx.WithIsCheckTx(false)
+	for i := 0; i < 2; i++ {
+		ok, err := k.ConsumeFeederQuota(deliverCtx, feeder, 1, 3)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+		suite.Require().NoError(k.ResetFailedVotes(suite.Ctx, feeder))
+	}
+	ok, err := k.ConsumeFeederQuota(deliverCtx, feeder, 1, 3)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+
+	// The counters restart on the next vote period, and each vote counts as failed
+	ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 2, 3)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+	stats, err = k.GetFeederStats(suite.Ctx, feeder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FeederStats{Feeder: feeder.String(), VotePeriod: 2, FeelessVotes: 1, FailedVotes: 1}, stats)
+
+	// A successful vote clears the failures
+	suite.Require().NoError(k.ResetFailedVotes(suite.Ctx, feeder))
+	stats, err = k.GetFeederStats(suite.Ctx, feeder)
+	suite.Require().NoError(err)
+	suite.Require().Zero(stats.FailedVotes)
+
+	// Two failed votes in a row penalize the feeder on the next vote
+	for period := uint64(3); period < 5; period++ {
+		ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, period, 3)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+	}
+
+	// CheckTx refuses the vote without starting the penalty
+	ok, err = k.ConsumeFeederQuota(suite.Ctx.WithIsCheckTx(true), feeder, 5, 3)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+	stats, err = k.GetFeederStats(suite.Ctx, feeder)
+	suite.Require().NoError(err)
+	suite.Require().Zero(stats.PenalizedUntil)
+
+	ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 5, 3)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+	stats, err = k.GetFeederStats(suite.Ctx, feeder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(8), stats.PenalizedUntil)
+	suite.Require().Zero(stats.FailedVotes)
+
+	// The feeder pays fees until the penalty ends
+	ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 7, 3)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+	ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 8, 3)
+	suite.Require().NoError(err)
+	suite.Require().True(ok)
+
+	// Zero disables the param, the feeder is still limited to a vote per validator
+	suite.Require().NoError(k.Params.Set(suite.Ctx, types.Params{}))
+	for i := 0; i < 3; i++ {
+		ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 9, 3)
+		suite.Require().NoError(err)
+		suite.Require().True(ok)
+	}
+	ok, err = k.ConsumeFeederQuota(deliverCtx, feeder, 9, 3)
+	suite.Require().NoError(err)
+	suite.Require().False(ok)
+}
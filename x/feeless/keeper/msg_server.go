@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the feeless MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams validates a MsgUpdateParams and sets the new params
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetPolicy adds or replaces the feeless policy of a message type
+func (k msgServer) SetPolicy(ctx context.Context, msg *types.MsgSetPolicy) (*types.MsgSetPolicyResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Policies.Set(ctx, msg.Policy.MsgTypeUrl, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetPolicy,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.Policy.MsgTypeUrl),
+			sdk.NewAttribute(types.AttributeKeyEligibility, msg.Policy.Eligibility.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxTxsPerBlock, strconv.FormatUint(msg.Policy.MaxTxsPerBlock, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgSetPolicyResponse{}, nil
+}
+
+// RemovePolicy removes the feeless policy of a message type
+func (k msgServer) RemovePolicy(ctx context.Context, msg *types.MsgRemovePolicy) (*types.MsgRemovePolicyResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	found, err := k.Policies.Has(ctx, msg.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrPolicyNotFound.Wrapf("msg type url %s", msg.MsgTypeUrl)
+	}
+
+	if err := k.Policies.Remove(ctx, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemovePolicy,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msg.MsgTypeUrl),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRemovePolicyResponse{}, nil
+}
+
+// RegisterRelayer adds an address to the registered relayers
+func (k msgServer) RegisterRelayer(ctx context.Context, msg *types.MsgRegisterRelayer) (*types.MsgRegisterRelayerResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid relayer address: %s", err)
+	}
+
+	found, err := k.Relayers.Has(ctx, relayer)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, types.ErrRelayerExists.Wrap(msg.Relayer)
+	}
+
+	if err := k.Relayers.Set(ctx, relayer); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterRelayer,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRegisterRelayerResponse{}, nil
+}
+
+// RemoveRelayer removes an address from the registered relayers
+func (k msgServer) RemoveRelayer(ctx context.Context, msg *types.MsgRemoveRelayer) (*types.MsgRemoveRelayerResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid relayer address: %s", err)
+	}
+
+	found, err := k.Relayers.Has(ctx, relayer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrRelayerNotFound.Wrap(msg.Relayer)
+	}
+
+	if err := k.Relayers.Remove(ctx, relayer); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveRelayer,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgRemoveRelayerResponse{}, nil
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// TestUpdateParams tests changes to the params of the module
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name         string
+		msg          *types.MsgUpdateParams
+		expectedPass bool
+	}{
+		{
+			name: "valid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.App.FeelessKeeper.GetAuthority(),
+				types.Params{MaxGasPerTx: 200_000},
+			),
+			expectedPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.TestAccs[0].String(),
+				types.DefaultParams(),
+			),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(suite.Ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify params were updated
+				params, err := suite.App.FeelessKeeper.Params.Get(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestSetAndRemovePolicy tests the governance management of the policies
+func (suite *KeeperTestSuite) TestSetAndRemovePolicy() {
+	authority := suite.App.FeelessKeeper.GetAuthority()
+	unjailURL := "/cosmos.slashing.v1beta1.MsgUnjail"
+
+	testCases := []struct {
+		name   string
+		msg    func() any
+		errMsg string
+	}{
+		{
+			name: "set policy - invalid authority",
+			msg: func() any {
+				return types.NewMsgSetPolicy(suite.TestAccs[0].String(), types.NewFeelessPolicy(unjailURL, types.EligibilityValidatorOperator, 1))
+			},
+			errMsg: "invalid authority",
+		},
+		{
+			name: "set policy - invalid type url",
+			msg: func() any {
+				return types.NewMsgSetPolicy(authority, types.NewFeelessPolicy("MsgUnjail", types.EligibilityValidatorOperator, 1))
+			},
+			errMsg: "invalid msg type url",
+		},
+		{
+			name: "set policy - unspecified eligibility",
+			msg: func() any {
+				return types.NewMsgSetPolicy(authority, types.NewFeelessPolicy(unjailURL, types.EligibilityUnspecified, 1))
+			},
+			errMsg: "unknown eligibility",
+		},
+		{
+			name: "set policy - valid",
+			msg: func() any {
+				return types.NewMsgSetPolicy(authority, types.NewFeelessPolicy(unjailURL, types.EligibilityValidatorOperator, 1))
+			},
+		},
+		{
+			name: "set policy - replace",
+			msg: func() any {
+				return types.NewMsgSetPolicy(authority, types.NewFeelessPolicy(unjailURL, types.EligibilityBondedValidator, 3))
+			},
+		},
+		{
+			name: "remove policy - invalid authority",
+			msg: func() any {
+				return types.NewMsgRemovePolicy(suite.TestAccs[0].String(), unjailURL)
+			},
+			errMsg: "invalid authority",
+		},
+		{
+			name: "remove policy - valid",
+			msg: func() any {
+				return types.NewMsgRemovePolicy(authority, unjailURL)
+			},
+		},
+		{
+			name: "remove policy - not found",
+			msg: func() any {
+				return types.NewMsgRemovePolicy(authority, unjailURL)
+			},
+			errMsg: "feeless policy not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg().(type) {
+			case *types.MsgSetPolicy:
+				_, err = suite.msgServer.SetPolicy(suite.Ctx, msg)
+				if err == nil {
+					policy, found, getErr := suite.App.FeelessKeeper.GetPolicy(suite.Ctx, msg.Policy.MsgTypeUrl)
+					suite.Require().NoError(getErr)
+					suite.Require().True(found)
+					suite.Require().Equal(msg.Policy, policy)
+				}
+			case *types.MsgRemovePolicy:
+				_, err = suite.msgServer.RemovePolicy(suite.Ctx, msg)
+				if err == nil {
+					_, found, getErr := suite.App.FeelessKeeper.GetPolicy(suite.Ctx, msg.MsgTypeUrl)
+					suite.Require().NoError(getErr)
+					suite.Require().False(found)
+				}
+			}
+
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+// TestRegisterAndRemoveRelayer tests the governance management of the relayers
+func (suite *KeeperTestSuite) TestRegisterAndRemoveRelayer() {
+	authority := suite.App.FeelessKeeper.GetAuthority()
+	relayer := suite.TestAccs[1]
+
+	// Only the authority can register relayers
+	_, err := suite.msgServer.RegisterRelayer(suite.Ctx, types.NewMsgRegisterRelayer(suite.TestAccs[0].String(), relayer))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// Register the relayer
+	_, err = suite.msgServer.RegisterRelayer(suite.Ctx, types.NewMsgRegisterRelayer(authority, relayer))
+	suite.Require().NoError(err)
+	isRelayer, err := suite.App.FeelessKeeper.IsRelayer(suite.Ctx, relayer)
+	suite.Require().NoError(err)
+	suite.Require().True(isRelayer)
+
+	// Registering twice fails
+	_, err = suite.msgServer.RegisterRelayer(suite.Ctx, types.NewMsgRegisterRelayer(authority, relayer))
+	suite.Require().ErrorIs(err, types.ErrRelayerExists)
+
+	// Only the authority can remove relayers
+	_, err = suite.msgServer.RemoveRelayer(suite.Ctx, types.NewMsgRemoveRelayer(suite.TestAccs[0].String(), relayer))
+	suite.Require().ErrorContains(err, "invalid authority")
+
+	// Remove the relayer
+	_, err = suite.msgServer.RemoveRelayer(suite.Ctx, types.NewMsgRemoveRelayer(authority, relayer))
+	suite.Require().NoError(err)
+	isRelayer, err = suite.App.FeelessKeeper.IsRelayer(suite.Ctx, relayer)
+	suite.Require().NoError(err)
+	suite.Require().False(isRelayer)
+
+	// Removing twice fails
+	_, err = suite.msgServer.RemoveRelayer(suite.Ctx, types.NewMsgRemoveRelayer(authority, relayer))
+	suite.Require().ErrorIs(err, types.ErrRelayerNotFound)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces register interfaces into the app
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	// Register messages
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgSetPolicy{},
+		&MsgRemovePolicy{},
+		&MsgRegisterRelayer{},
+		&MsgRemoveRelayer{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/feeless interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "feeless/update-params", nil)
+	cdc.RegisterConcrete(&MsgSetPolicy{}, "feeless/set-policy", nil)
+	cdc.RegisterConcrete(&MsgRemovePolicy{}, "feeless/remove-policy", nil)
+	cdc.RegisterConcrete(&MsgRegisterRelayer{}, "feeless/register-relayer", nil)
+	cdc.RegisterConcrete(&MsgRemoveRelayer{}, "feeless/remove-relayer", nil)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (suite *CodecTestSuite) TestRegisterInterfaces() {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface(sdk.MsgInterfaceProtoName, (*sdk.Msg)(nil))
+	RegisterInterfaces(registry)
+
+	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	suite.Require().Equal(5, len(impls))
+	suite.Require().ElementsMatch([]string{
+		"/kiichain.feeless.v1beta1.MsgUpdateParams",
+		"/kiichain.feeless.v1beta1.MsgSetPolicy",
+		"/kiichain.feeless.v1beta1.MsgRemovePolicy",
+		"/kiichain.feeless.v1beta1.MsgRegisterRelayer",
+		"/kiichain.feeless.v1beta1.MsgRemoveRelayer",
+	}, impls)
+}
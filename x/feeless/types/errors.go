@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/feeless module sentinel errors
+var (
+	ErrInvalidPolicy      = errorsmod.Register(ModuleName, 2, "invalid feeless policy")
+	ErrPolicyNotFound     = errorsmod.Register(ModuleName, 3, "feeless policy not found")
+	ErrRelayerExists      = errorsmod.Register(ModuleName, 4, "relayer already registered")
+	ErrRelayerNotFound    = errorsmod.Register(ModuleName, 5, "relayer not registered")
+	ErrInvalidEligibility = errorsmod.Register(ModuleName, 6, "invalid feeless eligibility")
+)
@@ -0,0 +1,23 @@
+package types
+
+// Feeless module event types
+const (
+	EventTypeSetPolicy       = "set_feeless_policy"
+	EventTypeRemovePolicy    = "remove_feeless_policy"
+	EventTypeRegisterRelayer = "register_relayer"
+	EventTypeRemoveRelayer   = "remove_relayer"
+	EventTypePenalizeFeeder  = "penalize_feeder"
+)
+
+// Feeless module attribute keys
+const (
+	AttributeKeyMsgTypeURL     = "msg_type_url"
+	AttributeKeyEligibility    = "eligibility"
+	AttributeKeyMaxTxsPerBlock = "max_txs_per_block"
+	AttributeKeyRelayer        = "relayer"
+	AttributeKeyFeeder         = "feeder"
+	AttributeKeyFailedVotes    = "failed_votes"
+	AttributeKeyPenalizedUntil = "penalized_until"
+
+	AttributeValueCategory = ModuleName
+)
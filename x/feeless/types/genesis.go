@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState constructs a genesis state
+func NewGenesisState(params Params, policies []FeelessPolicy, relayers []string, feederStats []FeederStats) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		Policies:    policies,
+		Relayers:    relayers,
+		FeederStats: feederStats,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of feeless.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultPolicies(), []string{}, []FeederStats{})
+}
+
+// Validate validates the genesis state of feeless genesis input
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenPolicies := make(map[string]bool, len(gs.Policies))
+	for _, policy := range gs.Policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if seenPolicies[policy.MsgTypeUrl] {
+			return fmt.Errorf("duplicated feeless policy: %s", policy.MsgTypeUrl)
+		}
+		seenPolicies[policy.MsgTypeUrl] = true
+	}
+
+	seenRelayers := make(map[string]bool, len(gs.Relayers))
+	for _, relayer := range gs.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return fmt.Errorf("invalid relayer address %s: %w", relayer, err)
+		}
+		if seenRelayers[relayer] {
+			return fmt.Errorf("duplicated relayer: %s", relayer)
+		}
+		seenRelayers[relayer] = true
+	}
+
+	seenFeeders := make(map[string]bool, len(gs.FeederStats))
+	for _, stats := range gs.FeederStats {
+		if _, err := sdk.AccAddressFromBech32(stats.Feeder); err != nil {
+			return fmt.Errorf("invalid feeder address %s: %w", stats.Feeder, err)
+		}
+		if seenFeeders[stats.Feeder] {
+			return fmt.Errorf("duplicated feeder stats: %s", stats.Feeder)
+		}
+		seenFeeders[stats.Feeder] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/feeless/types"
+)
+
+// TestValidateGenesis tests the validation of the feeless genesis
+func TestValidateGenesis(t *testing.T) {
+	relayer := apptesting.RandomAccountAddress().String()
+	feeder := apptesting.RandomAccountAddress().String()
+	unjailPolicy := types.NewFeelessPolicy("/cosmos.slashing.v1beta1.MsgUnjail", types.EligibilityValidatorOperator, 1)
+
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		errorMsg string
+	}{
+		{
+			name:    "default genesis",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name:    "valid genesis",
+			genesis: types.NewGenesisState(types.DefaultParams(), []types.FeelessPolicy{unjailPolicy}, []string{relayer}, []types.FeederStats{{Feeder: feeder, VotePeriod: 3, FeelessVotes: 1}}),
+		},
+		{
+			name:     "invalid policy",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.FeelessPolicy{types.NewFeelessPolicy("", types.EligibilityOracleFeeder, 0)}, nil, nil),
+			errorMsg: "invalid msg type url",
+		},
+		{
+			name:     "duplicated policy",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.FeelessPolicy{unjailPolicy, unjailPolicy}, nil, nil),
+			errorMsg: "duplicated feeless policy",
+		},
+		{
+			name:     "invalid relayer",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, []string{"invalid"}, nil),
+			errorMsg: "invalid relayer address",
+		},
+		{
+			name:     "duplicated relayer",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, []string{relayer, relayer}, nil),
+			errorMsg: "duplicated relayer",
+		},
+		{
+			name:     "invalid feeder stats",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, nil, []types.FeederStats{{Feeder: "invalid"}}),
+			errorMsg: "invalid feeder address",
+		},
+		{
+			name:     "duplicated feeder stats",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, nil, []types.FeederStats{{Feeder: feeder}, {Feeder: feeder}}),
+			errorMsg: "duplicated feeder stats",
+		},
+		{
+			name:     "penalty without vote periods",
+			genesis:  types.NewGenesisState(types.Params{MaxFailedFeelessVotes: 1}, nil, nil, nil),
+			errorMsg: "penalty vote periods must be positive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+// TestParseEligibility tests parsing the eligibility names
+func TestParseEligibility(t *testing.T) {
+	eligibility, err := types.ParseEligibility("validator_operator")
+	require.NoError(t, err)
+	require.Equal(t, types.EligibilityValidatorOperator, eligibility)
+
+	eligibility, err = types.ParseEligibility("ELIGIBILITY_REGISTERED_RELAYER")
+	require.NoError(t, err)
+	require.Equal(t, types.EligibilityRegisteredRelayer, eligibility)
+
+	_, err = types.ParseEligibility("unspecified")
+	require.ErrorIs(t, err, types.ErrInvalidEligibility)
+
+	_, err = types.ParseEligibility("anyone")
+	require.ErrorIs(t, err, types.ErrInvalidEligibility)
+}
+
+// TestParamsIsGasAllowed tests the max gas check
+func TestParamsIsGasAllowed(t *testing.T) {
+	params := types.Params{MaxGasPerTx: 100}
+	require.True(t, params.IsGasAllowed(100))
+	require.False(t, params.IsGasAllowed(101))
+
+	// Zero disables the limit
+	params.MaxGasPerTx = 0
+	require.True(t, params.IsGasAllowed(1_000_000_000))
+}
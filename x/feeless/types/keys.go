@@ -0,0 +1,31 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	ParamsKey   = collections.NewPrefix(0)
+	PoliciesKey = collections.NewPrefix(1)
+	RelayersKey = collections.NewPrefix(2)
+	// FeederStatsKey holds the feeless oracle vote counters by feeder
+	FeederStatsKey = collections.NewPrefix(3)
+
+	// BlockUsageKey is kept on the transient store and reset every block
+	BlockUsageKey = collections.NewPrefix(0)
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feeless"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the feeless module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgSetPolicy)(nil)
+	_ sdk.Msg = (*MsgRemovePolicy)(nil)
+	_ sdk.Msg = (*MsgRegisterRelayer)(nil)
+	_ sdk.Msg = (*MsgRemoveRelayer)(nil)
+)
+
+// NewMsgUpdateParams returns a new MsgUpdateParams with the authority
+// and the new params.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgSetPolicy returns a new MsgSetPolicy with the authority and the
+// policy
+func NewMsgSetPolicy(authority string, policy FeelessPolicy) *MsgSetPolicy {
+	return &MsgSetPolicy{
+		Authority: authority,
+		Policy:    policy,
+	}
+}
+
+// NewMsgRemovePolicy returns a new MsgRemovePolicy with the authority and
+// the message type of the policy
+func NewMsgRemovePolicy(authority, msgTypeURL string) *MsgRemovePolicy {
+	return &MsgRemovePolicy{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// NewMsgRegisterRelayer returns a new MsgRegisterRelayer with the authority
+// and the relayer
+func NewMsgRegisterRelayer(authority string, relayer sdk.AccAddress) *MsgRegisterRelayer {
+	return &MsgRegisterRelayer{
+		Authority: authority,
+		Relayer:   relayer.String(),
+	}
+}
+
+// NewMsgRemoveRelayer returns a new MsgRemoveRelayer with the authority and
+// the relayer
+func NewMsgRemoveRelayer(authority string, relayer sdk.AccAddress) *MsgRemoveRelayer {
+	return &MsgRemoveRelayer{
+		Authority: authority,
+		Relayer:   relayer.String(),
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+const (
+	// DefaultMaxGasPerTx is the default gas limit for feeless transactions
+	DefaultMaxGasPerTx uint64 = 1_000_000
+	// DefaultMaxFeelessVotesPerPeriod is the default amount of feeless oracle votes per feeder and vote period
+	// It's disabled, a feeder is already limited to a vote per validator it votes for
+	DefaultMaxFeelessVotesPerPeriod uint64 = 0
+	// DefaultMaxFailedFeelessVotes is the default amount of consecutive failed feeless votes before a penalty
+	DefaultMaxFailedFeelessVotes uint64 = 5
+	// DefaultPenaltyVotePeriods is the default amount of vote periods a penalized feeder pays fees
+	DefaultPenaltyVotePeriods uint64 = 100
+)
+
+// DefaultParams returns default feeless parameters
+func DefaultParams() Params {
+	return Params{
+		MaxGasPerTx:              DefaultMaxGasPerTx,
+		MaxFeelessVotesPerPeriod: DefaultMaxFeelessVotesPerPeriod,
+		MaxFailedFeelessVotes:    DefaultMaxFailedFeelessVotes,
+		PenaltyVotePeriods:       DefaultPenaltyVotePeriods,
+	}
+}
+
+// Validate performs basic validation on the feeless parameters
+func (p Params) Validate() error {
+	// Zero disables the max gas and the votes cap, a penalty must last at least a vote period
+	if p.MaxFailedFeelessVotes != 0 && p.PenaltyVotePeriods == 0 {
+		return fmt.Errorf("penalty vote periods must be positive when failed feeless votes are limited")
+	}
+
+	return nil
+}
+
+// IsGasAllowed checks if a gas limit is allowed for feeless transactions
+func (p Params) IsGasAllowed(gas uint64) bool {
+	return p.MaxGasPerTx == 0 || gas <= p.MaxGasPerTx
+}
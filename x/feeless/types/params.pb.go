@@ -0,0 +1,1057 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/feeless/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Eligibility defines the check a signer must pass for a message to be
+// feeless
+type Eligibility int32
+
+const (
+	// ELIGIBILITY_UNSPECIFIED is an invalid eligibility
+	EligibilityUnspecified Eligibility = 0
+	// ELIGIBILITY_ORACLE_FEEDER requires an oracle vote from an allowed feeder
+	// that was not casted yet on the current vote period
+	EligibilityOracleFeeder Eligibility = 1
+	// ELIGIBILITY_VALIDATOR_OPERATOR requires the signers to be validator
+	// operators, in any status
+	EligibilityValidatorOperator Eligibility = 2
+	// ELIGIBILITY_BONDED_VALIDATOR requires the signers to be operators of
+	// bonded validators
+	EligibilityBondedValidator Eligibility = 3
+	// ELIGIBILITY_REGISTERED_RELAYER requires the signers to be registered
+	// relayers
+	EligibilityRegisteredRelayer Eligibility = 4
+)
+
+var Eligibility_name = map[int32]string{
+	0: "ELIGIBILITY_UNSPECIFIED",
+	1: "ELIGIBILITY_ORACLE_FEEDER",
+	2: "ELIGIBILITY_VALIDATOR_OPERATOR",
+	3: "ELIGIBILITY_BONDED_VALIDATOR",
+	4: "ELIGIBILITY_REGISTERED_RELAYER",
+}
+
+var Eligibility_value = map[string]int32{
+	"ELIGIBILITY_UNSPECIFIED":        0,
+	"ELIGIBILITY_ORACLE_FEEDER":      1,
+	"ELIGIBILITY_VALIDATOR_OPERATOR": 2,
+	"ELIGIBILITY_BONDED_VALIDATOR":   3,
+	"ELIGIBILITY_REGISTERED_RELAYER": 4,
+}
+
+func (x Eligibility) String() string {
+	return proto.EnumName(Eligibility_name, int32(x))
+}
+
+func (Eligibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e386ac1d5f0c8544, []int{0}
+}
+
+// Params defines the parameters for the feeless module.
+type Params struct {
+	// max_gas_per_tx is the highest gas limit a transaction can request and
+	// still be processed without fees, zero disables the limit
+	MaxGasPerTx uint64 `protobuf:"varint,1,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// max_feeless_votes_per_period is the amount of feeless oracle votes a
+	// feeder can send on a single vote period, on top of the limit of a vote per
+	// validator the feeder votes for. Zero disables the limit
+	MaxFeelessVotesPerPeriod uint64 `protobuf:"varint,2,opt,name=max_feeless_votes_per_period,json=maxFeelessVotesPerPeriod,proto3" json:"max_feeless_votes_per_period,omitempty"`
+	// max_failed_feeless_votes is the amount of consecutive feeless oracle
+	// votes of a feeder that can fail before it is penalized, zero disables the
+	// penalty
+	MaxFailedFeelessVotes uint64 `protobuf:"varint,3,opt,name=max_failed_feeless_votes,json=maxFailedFeelessVotes,proto3" json:"max_failed_feeless_votes,omitempty"`
+	// penalty_vote_periods is the amount of vote periods a penalized feeder
+	// pays fees for its oracle votes
+	PenaltyVotePeriods uint64 `protobuf:"varint,4,opt,name=penalty_vote_periods,json=penaltyVotePeriods,proto3" json:"penalty_vote_periods,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e386ac1d5f0c8544, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFeelessVotesPerPeriod() uint64 {
+	if m != nil {
+		return m.MaxFeelessVotesPerPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFailedFeelessVotes() uint64 {
+	if m != nil {
+		return m.MaxFailedFeelessVotes
+	}
+	return 0
+}
+
+func (m *Params) GetPenaltyVotePeriods() uint64 {
+	if m != nil {
+		return m.PenaltyVotePeriods
+	}
+	return 0
+}
+
+// FeelessPolicy defines which message type can be executed without fees and
+// under which conditions
+type FeelessPolicy struct {
+	// msg_type_url is the type URL of the feeless message
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// eligibility is the check applied to the transaction signers
+	Eligibility Eligibility `protobuf:"varint,2,opt,name=eligibility,proto3,enum=kiichain.feeless.v1beta1.Eligibility" json:"eligibility,omitempty"`
+	// max_txs_per_block is the amount of feeless transactions of this type
+	// each signer can send on a single block, zero disables the limit
+	MaxTxsPerBlock uint64 `protobuf:"varint,3,opt,name=max_txs_per_block,json=maxTxsPerBlock,proto3" json:"max_txs_per_block,omitempty"`
+}
+
+func (m *FeelessPolicy) Reset()         { *m = FeelessPolicy{} }
+func (m *FeelessPolicy) String() string { return proto.CompactTextString(m) }
+func (*FeelessPolicy) ProtoMessage()    {}
+func (*FeelessPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e386ac1d5f0c8544, []int{1}
+}
+func (m *FeelessPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeelessPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeelessPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeelessPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeelessPolicy.Merge(m, src)
+}
+func (m *FeelessPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeelessPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeelessPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeelessPolicy proto.InternalMessageInfo
+
+func (m *FeelessPolicy) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *FeelessPolicy) GetEligibility() Eligibility {
+	if m != nil {
+		return m.Eligibility
+	}
+	return EligibilityUnspecified
+}
+
+func (m *FeelessPolicy) GetMaxTxsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTxsPerBlock
+	}
+	return 0
+}
+
+// FeederStats defines the feeless oracle vote counters of a feeder
+type FeederStats struct {
+	// feeder is the address of the oracle feeder
+	Feeder string `protobuf:"bytes,1,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	// vote_period is the vote period the feeless votes are counted on
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// feeless_votes is the amount of feeless votes on the vote period
+	FeelessVotes uint64 `protobuf:"varint,3,opt,name=feeless_votes,json=feelessVotes,proto3" json:"feeless_votes,omitempty"`
+	// failed_votes is the amount of consecutive feeless votes that failed
+	FailedVotes uint64 `protobuf:"varint,4,opt,name=failed_votes,json=failedVotes,proto3" json:"failed_votes,omitempty"`
+	// penalized_until is the vote period where the feeder can send feeless
+	// votes again
+	PenalizedUntil uint64 `protobuf:"varint,5,opt,name=penalized_until,json=penalizedUntil,proto3" json:"penalized_until,omitempty"`
+}
+
+func (m *FeederStats) Reset()         { *m = FeederStats{} }
+func (m *FeederStats) String() string { return proto.CompactTextString(m) }
+func (*FeederStats) ProtoMessage()    {}
+func (*FeederStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e386ac1d5f0c8544, []int{2}
+}
+func (m *FeederStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeederStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeederStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederStats.Merge(m, src)
+}
+func (m *FeederStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeederStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederStats proto.InternalMessageInfo
+
+func (m *FeederStats) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+func (m *FeederStats) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *FeederStats) GetFeelessVotes() uint64 {
+	if m != nil {
+		return m.FeelessVotes
+	}
+	return 0
+}
+
+func (m *FeederStats) GetFailedVotes() uint64 {
+	if m != nil {
+		return m.FailedVotes
+	}
+	return 0
+}
+
+func (m *FeederStats) GetPenalizedUntil() uint64 {
+	if m != nil {
+		return m.PenalizedUntil
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("kiichain.feeless.v1beta1.Eligibility", Eligibility_name, Eligibility_value)
+	proto.RegisterType((*Params)(nil), "kiichain.feeless.v1beta1.Params")
+	proto.RegisterType((*FeelessPolicy)(nil), "kiichain.feeless.v1beta1.FeelessPolicy")
+	proto.RegisterType((*FeederStats)(nil), "kiichain.feeless.v1beta1.FeederStats")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeless/v1beta1/params.proto", fileDescriptor_e386ac1d5f0c8544)
+}
+
+var fileDescriptor_e386ac1d5f0c8544 = []byte{
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0xc8, 0x45, 0xba, 0x13, 0xe0, 0xe6, 0x8e, 0xb8, 0xe0, 0xeb, 0x22, 0x37, 0x05,
+	0xa1, 0xfe, 0x51, 0x95, 0x94, 0x76, 0x81, 0xd4, 0x45, 0xd5, 0x04, 0x4f, 0x22, 0x4b, 0x11, 0xb1,
+	0x4c, 0x82, 0x44, 0x37, 0xd6, 0x24, 0x3e, 0x31, 0x23, 0xec, 0xd8, 0x1a, 0x0f, 0xc8, 0xe9, 0x13,
+	0x54, 0x59, 0xf5, 0x05, 0xb2, 0xaa, 0xfa, 0x0c, 0x7d, 0x85, 0x2e, 0x59, 0x55, 0x2c, 0x2b, 0x78,
+	0x91, 0xca, 0x63, 0x37, 0xb8, 0xff, 0x56, 0x99, 0x39, 0xf3, 0xfb, 0xbe, 0x39, 0xe7, 0x53, 0x3c,
+	0x68, 0xef, 0x9c, 0xb1, 0xd1, 0x19, 0x65, 0x93, 0xc6, 0x18, 0xc0, 0x87, 0x38, 0x6e, 0x5c, 0xee,
+	0x0f, 0x41, 0xd0, 0xfd, 0x46, 0x44, 0x39, 0x0d, 0xe2, 0x7a, 0xc4, 0x43, 0x11, 0x62, 0xf5, 0x3b,
+	0x56, 0xcf, 0xb1, 0x7a, 0x8e, 0x69, 0x1b, 0x5e, 0xe8, 0x85, 0x12, 0x6a, 0xa4, 0xab, 0x8c, 0xdf,
+	0xb9, 0x56, 0xd0, 0x8a, 0x25, 0x0d, 0xf0, 0x2e, 0x5a, 0x0f, 0x68, 0xe2, 0x78, 0x34, 0x76, 0x22,
+	0xe0, 0x8e, 0x48, 0x54, 0xa5, 0xa6, 0x3c, 0x2a, 0xdb, 0x95, 0x80, 0x26, 0x1d, 0x1a, 0x5b, 0xc0,
+	0xfb, 0x09, 0x7e, 0x85, 0xb6, 0x53, 0x28, 0x37, 0x77, 0x2e, 0x43, 0x01, 0x19, 0x1e, 0x01, 0x67,
+	0xa1, 0xab, 0x2e, 0x49, 0x89, 0x1a, 0xd0, 0xa4, 0x9d, 0x21, 0x27, 0x29, 0x61, 0x01, 0xb7, 0xe4,
+	0x39, 0x3e, 0x40, 0xaa, 0xd4, 0x53, 0xe6, 0x83, 0xfb, 0xa3, 0x8d, 0xba, 0x2c, 0xb5, 0xff, 0xa5,
+	0x5a, 0x79, 0x5c, 0x74, 0xc0, 0xcf, 0xd0, 0x46, 0x04, 0x13, 0xea, 0x8b, 0xa9, 0xa4, 0xf3, 0xfb,
+	0x62, 0xb5, 0x2c, 0x45, 0x38, 0x3f, 0x4b, 0xd9, 0xec, 0xa6, 0x78, 0xe7, 0xa3, 0x82, 0xd6, 0x72,
+	0x0b, 0x2b, 0xf4, 0xd9, 0x68, 0x8a, 0x6b, 0x68, 0x35, 0x88, 0x3d, 0x47, 0x4c, 0x23, 0x70, 0x2e,
+	0xb8, 0x2f, 0xe7, 0xfb, 0xdb, 0x46, 0x41, 0xec, 0xf5, 0xa7, 0x11, 0x0c, 0xb8, 0x8f, 0x3b, 0xa8,
+	0x02, 0x3e, 0xf3, 0xd8, 0x90, 0xf9, 0x4c, 0x4c, 0xe5, 0x34, 0xeb, 0xcf, 0xf7, 0xea, 0x7f, 0x0a,
+	0xb5, 0x4e, 0xee, 0x60, 0xbb, 0xa8, 0xc4, 0x8f, 0xd1, 0xbf, 0xe9, 0x9c, 0x22, 0xc9, 0xd2, 0x19,
+	0xfa, 0xe1, 0xe8, 0x3c, 0x1f, 0x30, 0x4d, 0xb9, 0x9f, 0xa4, 0x99, 0xb4, 0xd2, 0xea, 0xce, 0x27,
+	0x05, 0x55, 0xda, 0x00, 0x2e, 0xf0, 0x63, 0x41, 0x45, 0x8c, 0x37, 0xd1, 0xca, 0x58, 0x6e, 0xf3,
+	0xfe, 0xf2, 0x1d, 0xbe, 0x8f, 0x2a, 0x85, 0xc9, 0xf3, 0xa4, 0xd1, 0xe5, 0x62, 0x62, 0xbc, 0x8b,
+	0xd6, 0x7e, 0x17, 0xe8, 0xea, 0xb8, 0x98, 0xe3, 0x03, 0xb4, 0x9a, 0x87, 0x9f, 0x31, 0x59, 0x7e,
+	0x95, 0xac, 0x96, 0x21, 0x0f, 0xd1, 0x3f, 0x32, 0x4e, 0xf6, 0x16, 0x5c, 0xe7, 0x62, 0x22, 0x98,
+	0xaf, 0xfe, 0x95, 0x75, 0xbe, 0x28, 0x0f, 0xd2, 0xea, 0x93, 0x2f, 0x4b, 0xa8, 0x52, 0x48, 0x00,
+	0x1f, 0xa0, 0x2d, 0xd2, 0x35, 0x3b, 0x66, 0xcb, 0xec, 0x9a, 0xfd, 0x53, 0x67, 0x70, 0x74, 0x6c,
+	0x91, 0x43, 0xb3, 0x6d, 0x12, 0xa3, 0x5a, 0xd2, 0xb4, 0xd9, 0xbc, 0xb6, 0x59, 0xa0, 0x07, 0x93,
+	0x38, 0x82, 0x11, 0x1b, 0x33, 0x70, 0xf1, 0x4b, 0xf4, 0x7f, 0x51, 0xd8, 0xb3, 0x9b, 0x87, 0x5d,
+	0xe2, 0xb4, 0x09, 0x31, 0x88, 0x5d, 0x55, 0xb4, 0x7b, 0xb3, 0x79, 0x6d, 0xab, 0x20, 0xed, 0x71,
+	0x3a, 0xf2, 0x21, 0xcb, 0x0c, 0x1b, 0x48, 0x2f, 0x6a, 0x4f, 0x9a, 0x5d, 0xd3, 0x68, 0xf6, 0x7b,
+	0xb6, 0xd3, 0xb3, 0x88, 0x9d, 0x2e, 0xaa, 0x4b, 0x5a, 0x6d, 0x36, 0xaf, 0x6d, 0x17, 0x0c, 0x4e,
+	0xa8, 0xcf, 0x5c, 0x2a, 0x42, 0xde, 0x8b, 0x80, 0xa7, 0xbf, 0xf8, 0x35, 0xda, 0x2e, 0xba, 0xb4,
+	0x7a, 0x47, 0x06, 0x31, 0xee, 0xcc, 0xaa, 0xcb, 0x9a, 0x3e, 0x9b, 0xd7, 0xb4, 0x82, 0x47, 0x2b,
+	0x9c, 0xb8, 0xe0, 0x2e, 0x9c, 0x7e, 0xee, 0xc3, 0x26, 0x1d, 0xf3, 0xb8, 0x4f, 0x6c, 0x62, 0x38,
+	0x36, 0xe9, 0x36, 0x4f, 0x89, 0x5d, 0x2d, 0xff, 0xd2, 0x87, 0x0d, 0x1e, 0x8b, 0x05, 0x70, 0x70,
+	0x6d, 0xf0, 0xe9, 0x14, 0xb8, 0x56, 0x7e, 0xf7, 0x41, 0x2f, 0xb5, 0xda, 0x9f, 0x6f, 0x74, 0xe5,
+	0xea, 0x46, 0x57, 0xbe, 0xde, 0xe8, 0xca, 0xfb, 0x5b, 0xbd, 0x74, 0x75, 0xab, 0x97, 0xae, 0x6f,
+	0xf5, 0xd2, 0x9b, 0xa7, 0x1e, 0x13, 0x67, 0x17, 0xc3, 0xfa, 0x28, 0x0c, 0x1a, 0x8b, 0x17, 0x61,
+	0xb1, 0x48, 0x16, 0x8f, 0x43, 0xfa, 0x1f, 0x8f, 0x87, 0x2b, 0xf2, 0x23, 0x7f, 0xf1, 0x6d, 0x00,
+	0xa8, 0xa7, 0x8f, 0xd4, 0x3d, 0x04, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PenaltyVotePeriods != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenaltyVotePeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxFailedFeelessVotes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFailedFeelessVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxFeelessVotesPerPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFeelessVotesPerPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeelessPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeelessPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeelessPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTxsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Eligibility != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Eligibility))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeederStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeederStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeederStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PenalizedUntil != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PenalizedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FailedVotes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FailedVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FeelessVotes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeelessVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovParams(uint64(m.MaxGasPerTx))
+	}
+	if m.MaxFeelessVotesPerPeriod != 0 {
+		n += 1 + sovParams(uint64(m.MaxFeelessVotesPerPeriod))
+	}
+	if m.MaxFailedFeelessVotes != 0 {
+		n += 1 + sovParams(uint64(m.MaxFailedFeelessVotes))
+	}
+	if m.PenaltyVotePeriods != 0 {
+		n += 1 + sovParams(uint64(m.PenaltyVotePeriods))
+	}
+	return n
+}
+
+func (m *FeelessPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Eligibility != 0 {
+		n += 1 + sovParams(uint64(m.Eligibility))
+	}
+	if m.MaxTxsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTxsPerBlock))
+	}
+	return n
+}
+
+func (m *FeederStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovParams(uint64(m.VotePeriod))
+	}
+	if m.FeelessVotes != 0 {
+		n += 1 + sovParams(uint64(m.FeelessVotes))
+	}
+	if m.FailedVotes != 0 {
+		n += 1 + sovParams(uint64(m.FailedVotes))
+	}
+	if m.PenalizedUntil != 0 {
+		n += 1 + sovParams(uint64(m.PenalizedUntil))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeelessVotesPerPeriod", wireType)
+			}
+			m.MaxFeelessVotesPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeelessVotesPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedFeelessVotes", wireType)
+			}
+			m.MaxFailedFeelessVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedFeelessVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyVotePeriods", wireType)
+			}
+			m.PenaltyVotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyVotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeelessPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeelessPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeelessPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			m.Eligibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eligibility |= Eligibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerBlock", wireType)
+			}
+			m.MaxTxsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeederStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeederStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeederStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeelessVotes", wireType)
+			}
+			m.FeelessVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeelessVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedVotes", wireType)
+			}
+			m.FailedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenalizedUntil", wireType)
+			}
+			m.PenalizedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenalizedUntil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+)
+
+// NewFeelessPolicy returns a new FeelessPolicy
+func NewFeelessPolicy(msgTypeURL string, eligibility Eligibility, maxTxsPerBlock uint64) FeelessPolicy {
+	return FeelessPolicy{
+		MsgTypeUrl:     msgTypeURL,
+		Eligibility:    eligibility,
+		MaxTxsPerBlock: maxTxsPerBlock,
+	}
+}
+
+// DefaultPolicies returns the feeless policies available from genesis
+// Oracle votes are feeless for the allowed feeders, as a feeder can vote for
+// multiple validators there is no per block limit
+func DefaultPolicies() []FeelessPolicy {
+	return []FeelessPolicy{
+		NewFeelessPolicy(sdk.MsgTypeURL(&oracletypes.MsgAggregateExchangeRateVote{}), EligibilityOracleFeeder, 0),
+	}
+}
+
+// Validate performs basic validation on the feeless policy
+func (p FeelessPolicy) Validate() error {
+	if p.MsgTypeUrl == "" || !strings.HasPrefix(p.MsgTypeUrl, "/") {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "invalid msg type url: %q", p.MsgTypeUrl)
+	}
+
+	return ValidateEligibility(p.Eligibility)
+}
+
+// ValidateEligibility checks if the eligibility is one of the known values
+func ValidateEligibility(eligibility Eligibility) error {
+	switch eligibility {
+	case EligibilityOracleFeeder,
+		EligibilityValidatorOperator,
+		EligibilityBondedValidator,
+		EligibilityRegisteredRelayer:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidEligibility, "unknown eligibility: %s", eligibility)
+	}
+}
+
+// ParseEligibility parses an eligibility from its name, with or without the
+// ELIGIBILITY_ prefix
+func ParseEligibility(name string) (Eligibility, error) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "ELIGIBILITY_") {
+		name = "ELIGIBILITY_" + name
+	}
+
+	value, ok := Eligibility_value[name]
+	if !ok {
+		return EligibilityUnspecified, errorsmod.Wrapf(ErrInvalidEligibility, "unknown eligibility: %s", name)
+	}
+
+	eligibility := Eligibility(value)
+	return eligibility, ValidateEligibility(eligibility)
+}
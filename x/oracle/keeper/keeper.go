@@ -116,6 +116,11 @@ func (k Keeper) SetBaseExchangeRateWithDefault(ctx sdk.Context, denom string, ex
 	return k.ExchangeRate.Set(ctx, denom, rate)
 }
 
+// GetBaseExchangeRate returns the last exchange rate stored for a denom
+func (k Keeper) GetBaseExchangeRate(ctx sdk.Context, denom string) (types.OracleExchangeRate, error) {
+	return k.ExchangeRate.Get(ctx, denom)
+}
+
 // SetBaseExchangeRateWithEvent calls SetBaseExchangeRate and generate an event about that denom creation
 func (k Keeper) SetBaseExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) error {
 	// Set exchange rate by denom
@@ -161,6 +166,29 @@ func (k Keeper) GetFeederDelegationOrDefault(ctx sdk.Context, valAddr sdk.ValAdd
 	return accAddress, nil
 }
 
+// CountFeederValidators returns the amount of validators a feeder can vote for, its own validator
+// and the validators that delegated their votes to it
+func (k Keeper) CountFeederValidators(ctx sdk.Context, feeder sdk.AccAddress) (uint64, error) {
+	var count uint64
+
+	// A validator can always vote for itself
+	if _, err := k.StakingKeeper.Validator(ctx, sdk.ValAddress(feeder)); err == nil {
+		count++
+	}
+
+	err := k.FeederDelegation.Walk(ctx, nil, func(valAddr sdk.ValAddress, delegate string) (bool, error) {
+		if delegate == feeder.String() && !feeder.Equals(valAddr) {
+			count++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 // ValidateFeeder the feeder address whether is a validator or delegated address and if is allowed
 // to feed the Oracle module price
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
@@ -484,6 +512,44 @@ func (k Keeper) CalculateTwaps(ctx sdk.Context, lookBackSeconds uint64) (types.O
 	return oracleTwaps, nil
 }
 
+// GetRecentTwaps returns the twap of each denom over the lookback period. The exchange rate of every denom
+// must have been updated within the max price age and its twap must be positive
+func (k Keeper) GetRecentTwaps(ctx sdk.Context, denoms []string, maxPriceAgeSeconds, lookBackSeconds uint64) (map[string]math.LegacyDec, error) {
+	// The last updates must be recent, the timestamp is stored in milliseconds
+	for _, denom := range denoms {
+		exchangeRate, err := k.GetBaseExchangeRate(ctx, denom)
+		if err != nil {
+			return nil, cosmoserrors.Wrapf(types.ErrUnknownDenom, "%s: %s", denom, err)
+		}
+
+		priceAge := ctx.BlockTime().UnixMilli() - exchangeRate.LastUpdateTimestamp
+		if priceAge < 0 || uint64(priceAge) > maxPriceAgeSeconds*1000 {
+			return nil, cosmoserrors.Wrapf(types.ErrStaleExchangeRate, "%s last updated %dms ago", denom, priceAge)
+		}
+	}
+
+	twaps, err := k.CalculateTwaps(ctx, lookBackSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	twapByDenom := make(map[string]math.LegacyDec, len(denoms))
+	for _, twap := range twaps {
+		twapByDenom[twap.Denom] = twap.Twap
+	}
+
+	prices := make(map[string]math.LegacyDec, len(denoms))
+	for _, denom := range denoms {
+		twap, found := twapByDenom[denom]
+		if !found || !twap.IsPositive() {
+			return nil, cosmoserrors.Wrapf(types.ErrNoTwapData, "no twap for %s", denom)
+		}
+		prices[denom] = twap
+	}
+
+	return prices, nil
+}
+
 // ValidateLookBackSeconds validates the input lookbackseconds, must be lower or equan than the param lookback (because there are not longer
 // data than the param lookback param)
 func (k Keeper) ValidateLookBackSeconds(ctx sdk.Context, lookBackSeconds uint64) error {
@@ -257,6 +257,17 @@ func TestValidateFeeder(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, oracleKeeper.ValidateFeeder(ctx, sdk.AccAddress(val2Addr), val1Addr)) // Validate that Val2 is delegated by val1
 	require.Error(t, oracleKeeper.ValidateFeeder(ctx, Addrs[2], val1Addr))
+
+	// Val 2 votes for itself and val 1, val 1 can still vote for itself
+	count, err := oracleKeeper.CountFeederValidators(ctx, sdk.AccAddress(val2Addr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = oracleKeeper.CountFeederValidators(ctx, sdk.AccAddress(val1Addr))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	count, err = oracleKeeper.CountFeederValidators(ctx, Addrs[2])
+	require.NoError(t, err)
+	require.Zero(t, count)
 }
 
 func TestAggregateExchangeRateLogic(t *testing.T) {
@@ -602,3 +613,39 @@ func TestSpamPreventionLogic(t *testing.T) {
 	require.Equal(t, int64(100), spamVal1)
 	require.Equal(t, int64(200), spamVal2)
 }
+
+func TestGetRecentTwaps(t *testing.T) {
+	// Prepare the test environment
+	init := CreateTestInput(t)
+	oracleKeeper := init.OracleKeeper
+	ctx := init.Ctx.WithBlockTime(time.Unix(3600, 0))
+
+	// Set the kii price with a snapshot a minute ago
+	price := math.LegacyNewDecWithPrec(5, 1)
+	err := oracleKeeper.VoteTarget.Set(ctx, utils.MicroKiiDenom, types.Denom{Name: utils.MicroKiiDenom})
+	require.NoError(t, err)
+	err = oracleKeeper.SetBaseExchangeRateWithDefault(ctx, utils.MicroKiiDenom, price)
+	require.NoError(t, err)
+	exchangeRate := types.OracleExchangeRate{
+		ExchangeRate:        price,
+		LastUpdate:          math.NewInt(ctx.BlockHeight()),
+		LastUpdateTimestamp: ctx.BlockTime().UnixMilli(),
+	}
+	snapshot := types.NewPriceSnapshot(3540, types.PriceSnapshotItems{types.NewPriceSnapshotItem(utils.MicroKiiDenom, exchangeRate)})
+	err = oracleKeeper.AddPriceSnapshot(ctx, snapshot)
+	require.NoError(t, err)
+
+	// Recent prices are returned
+	prices, err := oracleKeeper.GetRecentTwaps(ctx, []string{utils.MicroKiiDenom}, 300, 600)
+	require.NoError(t, err)
+	require.Equal(t, price, prices[utils.MicroKiiDenom])
+
+	// Unknown denoms are refused
+	_, err = oracleKeeper.GetRecentTwaps(ctx, []string{utils.MicroKiiDenom, utils.MicroEthDenom}, 300, 600)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// Prices older than the max age are stale
+	staleCtx := ctx.WithBlockTime(ctx.BlockTime().Add(301 * time.Second))
+	_, err = oracleKeeper.GetRecentTwaps(staleCtx, []string{utils.MicroKiiDenom}, 300, 600)
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+}
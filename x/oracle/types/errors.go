@@ -26,4 +26,5 @@ var (
 	ErrUnknownKiiOracleQuery    = errors.Register(ModuleName, 23, "Error unknown kii oracle query")
 	ErrAggregateVoteExist       = errors.Register(ModuleName, 24, "aggregate vote still present in current voting window")
 	ErrAggregateVoteInvalidRate = errors.Register(ModuleName, 25, "aggregate vote has invalid exchange rate")
+	ErrStaleExchangeRate        = errors.Register(ModuleName, 26, "exchange rate is stale")
 )
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// FlagMethods is the flag with the sponsored methods
+const FlagMethods = "methods"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Paymaster transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewUpdateParamsCmd(),
+		NewSetSponsorshipCmd(),
+		NewRemoveSponsorshipCmd(),
+	)
+
+	return cmd
+}
+
+// NewUpdateParamsCmd implements the update-params tx command.
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-json]",
+		Short: "Update module parameters (gov proposal)",
+		Long: `Update module parameters through a governance proposal. Example:
+$ %s tx gov submit-proposal update-paymaster-params <path/to/params.json> --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &params); err != nil {
+				return fmt.Errorf("failed to parse params: %w", err)
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetSponsorshipCmd implements the set-sponsorship tx command.
+func NewSetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-sponsorship [contract] [daily-cap]",
+		Short: "Pay the fees of the transactions calling a contract",
+		Long: `Pay the fees of the transactions calling a contract, up to a daily cap in the native denom.
+The contract is a bech32 or hex address. Without methods every method is sponsored. Example:
+$ %s tx paymaster set-sponsorship 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd 1000000000000000000 --methods 0xa9059cbb --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseContract(args[0])
+			if err != nil {
+				return err
+			}
+
+			dailyCap, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid daily cap: %s", args[1])
+			}
+
+			methods, err := cmd.Flags().GetStringSlice(FlagMethods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSponsorship(clientCtx.GetFromAddress().String(), contract, methods, dailyCap)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagMethods, nil, "Sponsored methods, EVM selectors or CosmWasm message names")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveSponsorshipCmd implements the remove-sponsorship tx command.
+func NewRemoveSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-sponsorship [contract]",
+		Short: "Stop paying the fees of the transactions calling a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := parseContract(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveSponsorship(clientCtx.GetFromAddress().String(), contract)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseContract parses a bech32 or hex contract address into its bech32 form
+func parseContract(contract string) (string, error) {
+	if common.IsHexAddress(contract) {
+		return sdk.AccAddress(common.HexToAddress(contract).Bytes()).String(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return "", fmt.Errorf("invalid contract address %s: %w", contract, err)
+	}
+	return addr.String(), nil
+}
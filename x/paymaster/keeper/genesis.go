@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// InitGenesis sets paymaster information from genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, sponsorship := range data.Sponsorships {
+		if err := k.Sponsorships.Set(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor), sponsorship); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, usage := range data.Usages {
+		if err := k.Usages.Set(ctx, sponsorshipKey(usage.Contract, usage.Sponsor), usage); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	sponsorships := []types.Sponsorship{}
+	err = k.Sponsorships.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], sponsorship types.Sponsorship) (bool, error) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	usages := []types.SponsorshipUsage{}
+	err = k.Usages.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage types.SponsorshipUsage) (bool, error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, sponsorships, usages)
+}
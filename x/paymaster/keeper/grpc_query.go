@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries params of paymaster module
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Sponsorships queries all the sponsorships
+func (k Querier) Sponsorships(ctx context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsorships, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.Sponsorships,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], sponsorship types.Sponsorship) (types.Sponsorship, error) {
+			return sponsorship, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorshipsResponse{Sponsorships: sponsorships, Pagination: pageRes}, nil
+}
+
+// Sponsorship queries a sponsorship with its usage and the sponsor balance
+func (k Querier) Sponsorship(ctx context.Context, req *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err)
+	}
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sponsor address: %s", err)
+	}
+
+	sponsorship, found, err := k.Keeper.GetSponsorship(ctx, contract, sponsor)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSponsorshipNotFound.Wrapf("sponsor %s for contract %s", req.Sponsor, req.Contract)
+	}
+
+	usage, err := k.Keeper.GetUsage(sdk.UnwrapSDKContext(ctx), sponsorship)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorshipResponse{
+		Sponsorship:    sponsorship,
+		Usage:          usage,
+		SponsorBalance: k.bankKeeper.GetBalance(ctx, sponsor, evmtypes.GetEVMCoinDenom()),
+	}, nil
+}
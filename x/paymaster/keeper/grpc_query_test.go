@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// TestQueries tests the paymaster queries
+func (suite *KeeperTestSuite) TestQueries() {
+	k := suite.App.PaymasterKeeper
+	sponsor, contract := suite.TestAccs[0], suite.TestAccs[1]
+
+	// Default params are set from genesis
+	paramsRes, err := suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	// Add two sponsors to the contract
+	sponsorships := []types.Sponsorship{
+		types.NewSponsorship(sponsor.String(), contract.String(), nil, math.NewInt(1_000)),
+		types.NewSponsorship(suite.TestAccs[2].String(), contract.String(), []string{"increment"}, math.NewInt(500)),
+	}
+	for _, sponsorship := range sponsorships {
+		key := collections.Join(sdk.MustAccAddressFromBech32(sponsorship.Contract), sdk.MustAccAddressFromBech32(sponsorship.Sponsor))
+		suite.Require().NoError(k.Sponsorships.Set(suite.Ctx, key, sponsorship))
+	}
+
+	// Sponsorships can be paginated
+	sponsorshipsRes, err := suite.queryClient.Sponsorships(suite.Ctx, &types.QuerySponsorshipsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(sponsorshipsRes.Sponsorships, 1)
+	suite.Require().Equal(uint64(2), sponsorshipsRes.Pagination.Total)
+
+	// A sponsorship is returned with its usage and the sponsor balance
+	suite.FundAcc(sponsor, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 1_000)))
+	err = k.PayFee(suite.Ctx, sponsorships[0], sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 100)))
+	suite.Require().NoError(err)
+
+	sponsorshipRes, err := suite.queryClient.Sponsorship(suite.Ctx, &types.QuerySponsorshipRequest{
+		Contract: contract.String(),
+		Sponsor:  sponsor.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sponsorships[0], sponsorshipRes.Sponsorship)
+	suite.Require().Equal(math.NewInt(100), sponsorshipRes.Usage.DailySpent)
+	suite.Require().Equal(uint64(1), sponsorshipRes.Usage.TotalTxs)
+	suite.Require().Equal(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), 900), sponsorshipRes.SponsorBalance)
+
+	_, err = suite.queryClient.Sponsorship(suite.Ctx, &types.QuerySponsorshipRequest{
+		Contract: contract.String(),
+		Sponsor:  suite.TestAccs[1].String(),
+	})
+	suite.Require().ErrorContains(err, "sponsorship not found")
+
+	_, err = suite.queryClient.Sponsorship(suite.Ctx, &types.QuerySponsorshipRequest{Contract: "invalid", Sponsor: sponsor.String()})
+	suite.Require().ErrorContains(err, "invalid contract address")
+}
+
+// TestGenesis tests the paymaster genesis import and export
+func (suite *KeeperTestSuite) TestGenesis() {
+	sponsorship := types.NewSponsorship(suite.TestAccs[0].String(), suite.TestAccs[1].String(), []string{"0xa9059cbb"}, math.NewInt(1_000))
+	usage := types.NewSponsorshipUsage(sponsorship, 20_000)
+	usage.DailySpent = math.NewInt(10)
+	usage.TotalSpent = math.NewInt(30)
+	usage.TotalTxs = 3
+
+	genesis := types.NewGenesisState(
+		types.NewParams(1_000_000),
+		[]types.Sponsorship{sponsorship},
+		[]types.SponsorshipUsage{usage},
+	)
+
+	suite.App.PaymasterKeeper.InitGenesis(suite.Ctx, *genesis)
+	exported := suite.App.PaymasterKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(genesis, exported)
+}
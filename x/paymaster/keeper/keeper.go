@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+type (
+	Keeper struct {
+		cdc codec.BinaryCodec
+
+		bankKeeper types.BankKeeper
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		Schema collections.Schema
+		Params collections.Item[types.Params]
+
+		// Sponsorships and Usages are keyed by contract and sponsor, so the
+		// sponsors of a contract can be iterated
+		Sponsorships collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.Sponsorship]
+		Usages       collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.SponsorshipUsage]
+
+		// SponsoredFees holds the fees moved to the senders of EVM transactions
+		// by tx hash, it lives on the transient store so it is reset every block
+		SponsoredFees collections.Map[string, types.SponsoredFee]
+	}
+)
+
+// NewKeeper returns a new instance of the x/paymaster keeper
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	transientStoreService store.TransientStoreService,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	tsb := collections.NewSchemaBuilderFromAccessor(transientStoreService.OpenTransientStore)
+	sponsorshipKey := collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)
+	k := Keeper{
+		cdc: cdc,
+
+		bankKeeper: bankKeeper,
+
+		authority: authority,
+
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Sponsorships: collections.NewMap(sb, types.SponsorshipsKey, "sponsorships", sponsorshipKey, codec.CollValue[types.Sponsorship](cdc)),
+		Usages:       collections.NewMap(sb, types.UsagesKey, "usages", sponsorshipKey, codec.CollValue[types.SponsorshipUsage](cdc)),
+
+		SponsoredFees: collections.NewMap(tsb, types.SponsoredFeesKey, "sponsored_fees", collections.StringKey, codec.CollValue[types.SponsoredFee](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	if _, err := tsb.Build(); err != nil {
+		panic(err)
+	}
+
+	return k
+}
+
+// GetAuthority returns the x/paymaster module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/paymaster module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// validateAuthority checks if address authority is valid and same as expected
+func (k Keeper) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/paymaster/keeper"
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.PaymasterKeeper)
+}
+
+// fundSponsor funds a sponsor with the EVM denom
+func (suite *KeeperTestSuite) fundSponsor(sponsor sdk.AccAddress, amount int64) {
+	suite.FundAcc(sponsor, sdk.NewCoins(sdk.NewInt64Coin(evmtypes.GetEVMCoinDenom(), amount)))
+}
+
+// TestPayFee tests the fees paid by the sponsors under the daily cap
+func (suite *KeeperTestSuite) TestPayFee() {
+	k := suite.App.PaymasterKeeper
+	sponsor, contract := suite.TestAccs[0], suite.TestAccs[1]
+	denom := evmtypes.GetEVMCoinDenom()
+	suite.fundSponsor(sponsor, 1_000)
+
+	sponsorship := types.NewSponsorship(sponsor.String(), contract.String(), []string{"increment"}, math.NewInt(300))
+	suite.Require().NoError(k.Sponsorships.Set(suite.Ctx, collections.Join(contract, sponsor), sponsorship))
+
+	// Only sponsored methods are found
+	_, found, err := k.FindSponsorship(suite.Ctx, contract, "reset", math.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+	found1, found, err := k.FindSponsorship(suite.Ctx, contract, "increment", math.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(sponsorship, found1)
+
+	// Fees are sent to the fee collector
+	feeCollector := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollector, denom)
+	err = k.PayFee(suite.Ctx, sponsorship, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(800), suite.App.BankKeeper.GetBalance(suite.Ctx, sponsor, denom).Amount.Int64())
+	suite.Require().Equal(collected.Amount.AddRaw(200), suite.App.BankKeeper.GetBalance(suite.Ctx, feeCollector, denom).Amount)
+
+	usage, err := k.GetUsage(suite.Ctx, sponsorship)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(200), usage.DailySpent)
+	suite.Require().Equal(uint64(1), usage.TotalTxs)
+
+	// Other denoms are refused
+	err = k.PayFee(suite.Ctx, sponsorship, sdk.NewCoins(sdk.NewInt64Coin("other", 10)))
+	suite.Require().ErrorIs(err, types.ErrInvalidSponsoredFee)
+
+	// The daily cap is enforced
+	_, found, err = k.FindSponsorship(suite.Ctx, contract, "increment", math.NewInt(200))
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+	err = k.PayFee(suite.Ctx, sponsorship, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)))
+	suite.Require().ErrorIs(err, types.ErrDailyCapExceeded)
+
+	// The daily usage restarts on the next day
+	nextDayCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(24 * time.Hour))
+	err = k.PayFee(nextDayCtx, sponsorship, sdk.NewCoins(sdk.NewInt64Coin(denom, 200)))
+	suite.Require().NoError(err)
+	usage, err = k.GetUsage(nextDayCtx, sponsorship)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(200), usage.DailySpent)
+	suite.Require().Equal(math.NewInt(400), usage.TotalSpent)
+	suite.Require().Equal(uint64(2), usage.TotalTxs)
+}
+
+// TestPrefundFee tests the prefunded EVM fees and the return of the unspent part
+func (suite *KeeperTestSuite) TestPrefundFee() {
+	k := suite.App.PaymasterKeeper
+	sponsor, contract, sender := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	denom := evmtypes.GetEVMCoinDenom()
+	suite.fundSponsor(sponsor, 1_000)
+	senderBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount
+
+	sponsorship := types.NewSponsorship(sponsor.String(), contract.String(), nil, math.NewInt(1_000))
+	suite.Require().NoError(k.Sponsorships.Set(suite.Ctx, collections.Join(contract, sponsor), sponsorship))
+
+	// The sender receives the whole fee
+	err := k.PrefundFee(suite.Ctx, sponsorship, sender, "0x01", math.NewInt(500))
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderBalance.AddRaw(500), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	// The unspent part goes back to the sponsor
+	err = k.ReturnUnspentFee(suite.Ctx, "0x01", math.NewInt(200))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(800), suite.App.BankKeeper.GetBalance(suite.Ctx, sponsor, denom).Amount.Int64())
+	suite.Require().Equal(senderBalance.AddRaw(200), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, denom).Amount)
+
+	usage, err := k.GetUsage(suite.Ctx, sponsorship)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(200), usage.DailySpent)
+	suite.Require().Equal(math.NewInt(200), usage.TotalSpent)
+
+	// Unknown and already returned transactions are ignored
+	suite.Require().NoError(k.ReturnUnspentFee(suite.Ctx, "0x01", math.ZeroInt()))
+	suite.Require().NoError(k.ReturnUnspentFee(suite.Ctx, "0x02", math.ZeroInt()))
+	suite.Require().Equal(int64(800), suite.App.BankKeeper.GetBalance(suite.Ctx, sponsor, denom).Amount.Int64())
+
+	// The sponsor balance limits the sponsorship
+	_, found, err := k.FindSponsorship(suite.Ctx, contract, "", math.NewInt(801))
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
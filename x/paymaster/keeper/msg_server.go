@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the paymaster MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams validates a MsgUpdateParams and sets the new params
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetSponsorship adds or replaces the sponsorship of a contract by the sponsor
+// The usage is kept when a sponsorship is replaced
+func (k msgServer) SetSponsorship(ctx context.Context, msg *types.MsgSetSponsorship) (*types.MsgSetSponsorshipResponse, error) {
+	sponsorship := msg.Sponsorship()
+	if err := sponsorship.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Sponsorships.Set(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor), sponsorship); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetSponsorship,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+			sdk.NewAttribute(types.AttributeKeyMethods, strings.Join(sponsorship.Methods, ",")),
+			sdk.NewAttribute(types.AttributeKeyDailyCap, sponsorship.DailyCap.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sponsor),
+		),
+	})
+
+	return &types.MsgSetSponsorshipResponse{}, nil
+}
+
+// RemoveSponsorship removes the sponsorship of a contract by the sponsor with its usage
+func (k msgServer) RemoveSponsorship(ctx context.Context, msg *types.MsgRemoveSponsorship) (*types.MsgRemoveSponsorshipResponse, error) {
+	sponsor, err := sdk.AccAddressFromBech32(msg.Sponsor)
+	if err != nil {
+		return nil, types.ErrInvalidSponsorship.Wrapf("invalid sponsor address: %s", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, types.ErrInvalidSponsorship.Wrapf("invalid contract address: %s", err)
+	}
+
+	sponsorship, found, err := k.GetSponsorship(ctx, contract, sponsor)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrSponsorshipNotFound.Wrapf("sponsor %s for contract %s", msg.Sponsor, msg.Contract)
+	}
+
+	if err := k.Sponsorships.Remove(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor)); err != nil {
+		return nil, err
+	}
+	if err := k.Usages.Remove(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor)); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveSponsorship,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sponsor),
+		),
+	})
+
+	return &types.MsgRemoveSponsorshipResponse{}, nil
+}
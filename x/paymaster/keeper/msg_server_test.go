@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// TestUpdateParams tests changes to the params of the module
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name         string
+		msg          *types.MsgUpdateParams
+		expectedPass bool
+	}{
+		{
+			name: "valid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.App.PaymasterKeeper.GetAuthority(),
+				types.NewParams(500_000),
+			),
+			expectedPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.TestAccs[0].String(),
+				types.DefaultParams(),
+			),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(suite.Ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify params were updated
+				params, err := suite.App.PaymasterKeeper.Params.Get(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestSetAndRemoveSponsorship tests the management of the sponsorships by the sponsors
+func (suite *KeeperTestSuite) TestSetAndRemoveSponsorship() {
+	sponsor, contract := suite.TestAccs[0], suite.TestAccs[1]
+	key := collections.Join(contract, sponsor)
+
+	testCases := []struct {
+		name   string
+		msg    func() any
+		errMsg string
+	}{
+		{
+			name: "set sponsorship - invalid contract",
+			msg: func() any {
+				return types.NewMsgSetSponsorship(sponsor.String(), "invalid", nil, math.NewInt(100))
+			},
+			errMsg: "invalid contract address",
+		},
+		{
+			name: "set sponsorship - invalid method",
+			msg: func() any {
+				return types.NewMsgSetSponsorship(sponsor.String(), contract.String(), []string{"0xzz"}, math.NewInt(100))
+			},
+			errMsg: "invalid evm selector",
+		},
+		{
+			name: "set sponsorship - zero daily cap",
+			msg: func() any {
+				return types.NewMsgSetSponsorship(sponsor.String(), contract.String(), nil, math.ZeroInt())
+			},
+			errMsg: "daily cap must be positive",
+		},
+		{
+			name: "set sponsorship - valid",
+			msg: func() any {
+				return types.NewMsgSetSponsorship(sponsor.String(), contract.String(), []string{"0xa9059cbb"}, math.NewInt(100))
+			},
+		},
+		{
+			name: "remove sponsorship - not found",
+			msg: func() any {
+				return types.NewMsgRemoveSponsorship(suite.TestAccs[2].String(), contract.String())
+			},
+			errMsg: "sponsorship not found",
+		},
+		{
+			name: "remove sponsorship - valid",
+			msg: func() any {
+				return types.NewMsgRemoveSponsorship(sponsor.String(), contract.String())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg().(type) {
+			case *types.MsgSetSponsorship:
+				_, err = suite.msgServer.SetSponsorship(suite.Ctx, msg)
+				if tc.errMsg == "" {
+					suite.Require().NoError(err)
+					sponsorship, err := suite.App.PaymasterKeeper.Sponsorships.Get(suite.Ctx, key)
+					suite.Require().NoError(err)
+					suite.Require().Equal(msg.Sponsorship(), sponsorship)
+				}
+			case *types.MsgRemoveSponsorship:
+				_, err = suite.msgServer.RemoveSponsorship(suite.Ctx, msg)
+				if tc.errMsg == "" {
+					suite.Require().NoError(err)
+					has, err := suite.App.PaymasterKeeper.Sponsorships.Has(suite.Ctx, key)
+					suite.Require().NoError(err)
+					suite.Require().False(has)
+				}
+			}
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
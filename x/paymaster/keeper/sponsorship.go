@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// sponsorshipKey returns the store key of a sponsorship and its usage
+func sponsorshipKey(contract, sponsor string) collections.Pair[sdk.AccAddress, sdk.AccAddress] {
+	return collections.Join(sdk.MustAccAddressFromBech32(contract), sdk.MustAccAddressFromBech32(sponsor))
+}
+
+// GetSponsorship returns the sponsorship of a contract by a sponsor
+// The boolean is false if the sponsor does not sponsor the contract
+func (k Keeper) GetSponsorship(ctx context.Context, contract, sponsor sdk.AccAddress) (types.Sponsorship, bool, error) {
+	sponsorship, err := k.Sponsorships.Get(ctx, collections.Join(contract, sponsor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsorship{}, false, nil
+	}
+	if err != nil {
+		return types.Sponsorship{}, false, err
+	}
+
+	return sponsorship, true, nil
+}
+
+// GetUsage returns the usage of a sponsorship on the current day
+func (k Keeper) GetUsage(ctx sdk.Context, sponsorship types.Sponsorship) (types.SponsorshipUsage, error) {
+	day := types.Day(ctx.BlockTime())
+	usage, err := k.Usages.Get(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewSponsorshipUsage(sponsorship, day), nil
+	}
+	if err != nil {
+		return types.SponsorshipUsage{}, err
+	}
+
+	return usage.OnDay(day), nil
+}
+
+// CanSponsor checks if the sponsor can pay an amount of fees under the daily cap and with its balance
+func (k Keeper) CanSponsor(ctx sdk.Context, sponsorship types.Sponsorship, amount math.Int) (bool, error) {
+	usage, err := k.GetUsage(ctx, sponsorship)
+	if err != nil {
+		return false, err
+	}
+	if usage.DailySpent.Add(amount).GT(sponsorship.DailyCap) {
+		return false, nil
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(sponsorship.Sponsor))
+	return spendable.AmountOf(evmtypes.GetEVMCoinDenom()).GTE(amount), nil
+}
+
+// FindSponsorship returns the first sponsorship of a contract able to pay an amount of fees for a method
+// The boolean is false if no sponsor covers the call
+func (k Keeper) FindSponsorship(ctx sdk.Context, contract sdk.AccAddress, method string, amount math.Int) (types.Sponsorship, bool, error) {
+	var (
+		found  types.Sponsorship
+		exists bool
+	)
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](contract)
+	err := k.Sponsorships.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], sponsorship types.Sponsorship) (bool, error) {
+		if !sponsorship.IsMethodSponsored(method) {
+			return false, nil
+		}
+		canSponsor, err := k.CanSponsor(ctx, sponsorship, amount)
+		if err != nil || !canSponsor {
+			return false, err
+		}
+		found, exists = sponsorship, true
+		return true, nil
+	})
+	if err != nil {
+		return types.Sponsorship{}, false, err
+	}
+
+	return found, exists, nil
+}
+
+// PayFee pays a transaction fee from the sponsor to the fee collector
+func (k Keeper) PayFee(ctx sdk.Context, sponsorship types.Sponsorship, fee sdk.Coins) error {
+	amount := fee.AmountOf(evmtypes.GetEVMCoinDenom())
+	if !fee.Equal(sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))) {
+		return types.ErrInvalidSponsoredFee.Wrapf("sponsored fees must be paid in %s, got %s", evmtypes.GetEVMCoinDenom(), fee)
+	}
+	if err := k.chargeSponsorship(ctx, sponsorship, amount); err != nil {
+		return err
+	}
+
+	sponsor := sdk.MustAccAddressFromBech32(sponsorship.Sponsor)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsor, authtypes.FeeCollectorName, fee); err != nil {
+		return err
+	}
+
+	k.emitSponsorFeeEvent(ctx, sponsorship, "", amount)
+	return nil
+}
+
+// PrefundFee moves the fee of an EVM transaction from the sponsor to the sender
+// The EVM fee deduction and refunds work on the sender balance, so the unspent
+// part is returned to the sponsor by ReturnUnspentFee once the transaction is executed.
+// The record is kept on the transient store, transactions failing before the return leave nothing behind
+func (k Keeper) PrefundFee(ctx sdk.Context, sponsorship types.Sponsorship, sender sdk.AccAddress, txHash string, amount math.Int) error {
+	if err := k.chargeSponsorship(ctx, sponsorship, amount); err != nil {
+		return err
+	}
+
+	sponsor := sdk.MustAccAddressFromBech32(sponsorship.Sponsor)
+	fee := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), amount))
+	if err := k.bankKeeper.SendCoins(ctx, sponsor, sender, fee); err != nil {
+		return err
+	}
+
+	err := k.SponsoredFees.Set(ctx, txHash, types.SponsoredFee{
+		Sponsor:  sponsorship.Sponsor,
+		Contract: sponsorship.Contract,
+		Sender:   sender.String(),
+		Amount:   amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	k.emitSponsorFeeEvent(ctx, sponsorship, sender.String(), amount)
+	return nil
+}
+
+// ReturnUnspentFee returns the part of a prefunded fee not spent by an EVM transaction to the sponsor
+// Nothing is done if the transaction was not sponsored
+func (k Keeper) ReturnUnspentFee(ctx sdk.Context, txHash string, spent math.Int) error {
+	sponsoredFee, err := k.SponsoredFees.Get(ctx, txHash)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.SponsoredFees.Remove(ctx, txHash); err != nil {
+		return err
+	}
+
+	// The sender can only give back what it still holds
+	sender := sdk.MustAccAddressFromBech32(sponsoredFee.Sender)
+	unspent := sponsoredFee.Amount.Sub(spent)
+	unspent = math.MinInt(unspent, k.bankKeeper.SpendableCoins(ctx, sender).AmountOf(evmtypes.GetEVMCoinDenom()))
+	if !unspent.IsPositive() {
+		return nil
+	}
+
+	sponsor := sdk.MustAccAddressFromBech32(sponsoredFee.Sponsor)
+	if err := k.bankKeeper.SendCoins(ctx, sender, sponsor, sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), unspent))); err != nil {
+		return err
+	}
+
+	// Discount the returned amount from the usage, it is the same day as the prefund
+	key := sponsorshipKey(sponsoredFee.Contract, sponsoredFee.Sponsor)
+	usage, err := k.Usages.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil {
+		usage.DailySpent = math.MaxInt(usage.DailySpent.Sub(unspent), math.ZeroInt())
+		usage.TotalSpent = math.MaxInt(usage.TotalSpent.Sub(unspent), math.ZeroInt())
+		if err := k.Usages.Set(ctx, key, usage); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReturnFee,
+			sdk.NewAttribute(types.AttributeKeySponsor, sponsoredFee.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyContract, sponsoredFee.Contract),
+			sdk.NewAttribute(types.AttributeKeySender, sponsoredFee.Sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, unspent.String()),
+		),
+	)
+	return nil
+}
+
+// chargeSponsorship registers an amount paid by the sponsorship
+// It fails if the amount goes above the daily cap
+func (k Keeper) chargeSponsorship(ctx sdk.Context, sponsorship types.Sponsorship, amount math.Int) error {
+	usage, err := k.GetUsage(ctx, sponsorship)
+	if err != nil {
+		return err
+	}
+
+	usage.DailySpent = usage.DailySpent.Add(amount)
+	if usage.DailySpent.GT(sponsorship.DailyCap) {
+		return types.ErrDailyCapExceeded.Wrapf(
+			"sponsor %s for contract %s: spent %s of %s", sponsorship.Sponsor, sponsorship.Contract, usage.DailySpent, sponsorship.DailyCap,
+		)
+	}
+	usage.TotalSpent = usage.TotalSpent.Add(amount)
+	usage.TotalTxs++
+
+	return k.Usages.Set(ctx, sponsorshipKey(sponsorship.Contract, sponsorship.Sponsor), usage)
+}
+
+// emitSponsorFeeEvent emits the event of a fee paid by a sponsor
+func (k Keeper) emitSponsorFeeEvent(ctx sdk.Context, sponsorship types.Sponsorship, sender string, amount math.Int) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySponsor, sponsorship.Sponsor),
+		sdk.NewAttribute(types.AttributeKeyContract, sponsorship.Contract),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	}
+	if sender != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySender, sender))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSponsorFee, attributes...))
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (suite *CodecTestSuite) TestRegisterInterfaces() {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface(sdk.MsgInterfaceProtoName, (*sdk.Msg)(nil))
+	RegisterInterfaces(registry)
+
+	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	suite.Require().Equal(3, len(impls))
+	suite.Require().ElementsMatch([]string{
+		"/kiichain.paymaster.v1beta1.MsgUpdateParams",
+		"/kiichain.paymaster.v1beta1.MsgSetSponsorship",
+		"/kiichain.paymaster.v1beta1.MsgRemoveSponsorship",
+	}, impls)
+}
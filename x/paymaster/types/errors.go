@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/paymaster module sentinel errors
+var (
+	ErrInvalidSponsorship  = errorsmod.Register(ModuleName, 2, "invalid sponsorship")
+	ErrSponsorshipNotFound = errorsmod.Register(ModuleName, 3, "sponsorship not found")
+	ErrDailyCapExceeded    = errorsmod.Register(ModuleName, 4, "sponsorship daily cap exceeded")
+	ErrMethodNotSponsored  = errorsmod.Register(ModuleName, 5, "method not sponsored")
+	ErrInvalidSponsoredFee = errorsmod.Register(ModuleName, 6, "invalid sponsored fee")
+)
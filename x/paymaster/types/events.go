@@ -0,0 +1,21 @@
+package types
+
+// Paymaster module event types
+const (
+	EventTypeSetSponsorship    = "set_sponsorship"
+	EventTypeRemoveSponsorship = "remove_sponsorship"
+	EventTypeSponsorFee        = "sponsor_fee"
+	EventTypeReturnFee         = "return_sponsored_fee"
+)
+
+// Paymaster module attribute keys
+const (
+	AttributeKeySponsor  = "sponsor"
+	AttributeKeyContract = "contract"
+	AttributeKeyMethods  = "methods"
+	AttributeKeyDailyCap = "daily_cap"
+	AttributeKeyAmount   = "amount"
+	AttributeKeySender   = "sender"
+
+	AttributeValueCategory = ModuleName
+)
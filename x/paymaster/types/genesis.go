@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState constructs a genesis state
+func NewGenesisState(params Params, sponsorships []Sponsorship, usages []SponsorshipUsage) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		Sponsorships: sponsorships,
+		Usages:       usages,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of paymaster.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Sponsorship{}, []SponsorshipUsage{})
+}
+
+// Validate validates the genesis state of paymaster genesis input
+func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.Sponsorships))
+	for _, sponsorship := range gs.Sponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return err
+		}
+		key := sponsorship.Contract + "/" + sponsorship.Sponsor
+		if seen[key] {
+			return fmt.Errorf("duplicated sponsorship: %s", key)
+		}
+		seen[key] = true
+	}
+
+	seenUsages := make(map[string]bool, len(gs.Usages))
+	for _, usage := range gs.Usages {
+		key := usage.Contract + "/" + usage.Sponsor
+		if !seen[key] {
+			return fmt.Errorf("usage without sponsorship: %s", key)
+		}
+		if seenUsages[key] {
+			return fmt.Errorf("duplicated usage: %s", key)
+		}
+		if usage.DailySpent.IsNil() || usage.DailySpent.IsNegative() || usage.TotalSpent.IsNil() || usage.TotalSpent.IsNegative() {
+			return fmt.Errorf("invalid usage amounts: %s", key)
+		}
+		seenUsages[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/paymaster/types"
+)
+
+// TestValidateGenesis tests the validation of the paymaster genesis
+func TestValidateGenesis(t *testing.T) {
+	sponsor := apptesting.RandomAccountAddress().String()
+	contract := apptesting.RandomAccountAddress().String()
+	sponsorship := types.NewSponsorship(sponsor, contract, []string{"increment", "0xa9059cbb"}, math.NewInt(100))
+	usage := types.NewSponsorshipUsage(sponsorship, 1)
+
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		errorMsg string
+	}{
+		{
+			name:    "default genesis",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name:    "valid genesis",
+			genesis: types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship}, []types.SponsorshipUsage{usage}),
+		},
+		{
+			name:     "invalid sponsor",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{types.NewSponsorship("invalid", contract, nil, math.NewInt(100))}, nil),
+			errorMsg: "invalid sponsor address",
+		},
+		{
+			name:     "invalid evm selector",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{types.NewSponsorship(sponsor, contract, []string{"0xA9059CBB"}, math.NewInt(100))}, nil),
+			errorMsg: "invalid evm selector",
+		},
+		{
+			name:     "invalid method name",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{types.NewSponsorship(sponsor, contract, []string{"do it"}, math.NewInt(100))}, nil),
+			errorMsg: "invalid method name",
+		},
+		{
+			name:     "duplicated method",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{types.NewSponsorship(sponsor, contract, []string{"increment", "increment"}, math.NewInt(100))}, nil),
+			errorMsg: "duplicated method",
+		},
+		{
+			name:     "duplicated sponsorship",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship, sponsorship}, nil),
+			errorMsg: "duplicated sponsorship",
+		},
+		{
+			name:     "usage without sponsorship",
+			genesis:  types.NewGenesisState(types.DefaultParams(), nil, []types.SponsorshipUsage{usage}),
+			errorMsg: "usage without sponsorship",
+		},
+		{
+			name:     "duplicated usage",
+			genesis:  types.NewGenesisState(types.DefaultParams(), []types.Sponsorship{sponsorship}, []types.SponsorshipUsage{usage, usage}),
+			errorMsg: "duplicated usage",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+// TestMethods tests the method names of EVM calldata and CosmWasm messages
+func TestMethods(t *testing.T) {
+	require.Equal(t, "0xa9059cbb", types.EVMMethod([]byte{0xa9, 0x05, 0x9c, 0xbb, 0x01}))
+	require.Equal(t, "", types.EVMMethod([]byte{0xa9}))
+
+	require.Equal(t, "increment", types.WasmMethod([]byte(`{"increment":{}}`)))
+	require.Equal(t, "", types.WasmMethod([]byte(`{"increment":{},"reset":{}}`)))
+	require.Equal(t, "", types.WasmMethod([]byte(`"increment"`)))
+
+	// Sponsorships without methods cover every call
+	sponsorship := types.NewSponsorship("", "", nil, math.NewInt(1))
+	require.True(t, sponsorship.IsMethodSponsored(""))
+	sponsorship.Methods = []string{"increment"}
+	require.True(t, sponsorship.IsMethodSponsored("increment"))
+	require.False(t, sponsorship.IsMethodSponsored("reset"))
+}
@@ -0,0 +1,29 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	ParamsKey       = collections.NewPrefix(0)
+	SponsorshipsKey = collections.NewPrefix(1)
+	UsagesKey       = collections.NewPrefix(2)
+
+	// SponsoredFeesKey is kept on the transient store and reset every block
+	SponsoredFeesKey = collections.NewPrefix(0)
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "paymaster"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the paymaster module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
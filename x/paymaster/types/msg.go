@@ -0,0 +1,46 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgSetSponsorship)(nil)
+	_ sdk.Msg = (*MsgRemoveSponsorship)(nil)
+)
+
+// NewMsgUpdateParams returns a new MsgUpdateParams with the authority
+// and the new params.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgSetSponsorship returns a new MsgSetSponsorship
+func NewMsgSetSponsorship(sponsor, contract string, methods []string, dailyCap math.Int) *MsgSetSponsorship {
+	return &MsgSetSponsorship{
+		Sponsor:  sponsor,
+		Contract: contract,
+		Methods:  methods,
+		DailyCap: dailyCap,
+	}
+}
+
+// NewMsgRemoveSponsorship returns a new MsgRemoveSponsorship
+func NewMsgRemoveSponsorship(sponsor, contract string) *MsgRemoveSponsorship {
+	return &MsgRemoveSponsorship{
+		Sponsor:  sponsor,
+		Contract: contract,
+	}
+}
+
+// Sponsorship returns the sponsorship set by the message
+func (msg MsgSetSponsorship) Sponsorship() Sponsorship {
+	return NewSponsorship(msg.Sponsor, msg.Contract, msg.Methods, msg.DailyCap)
+}
@@ -0,0 +1,27 @@
+package types
+
+// DefaultMaxGasPerTx is the default gas limit for sponsored transactions
+const DefaultMaxGasPerTx uint64 = 3_000_000
+
+// NewParams returns new paymaster parameters
+func NewParams(maxGasPerTx uint64) Params {
+	return Params{
+		MaxGasPerTx: maxGasPerTx,
+	}
+}
+
+// DefaultParams returns default paymaster parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxGasPerTx)
+}
+
+// Validate performs basic validation on the paymaster parameters
+func (p Params) Validate() error {
+	// Every value of max gas is valid, zero disables the limit
+	return nil
+}
+
+// IsGasAllowed checks if a gas limit is allowed for sponsored transactions
+func (p Params) IsGasAllowed(gas uint64) bool {
+	return p.MaxGasPerTx == 0 || gas <= p.MaxGasPerTx
+}
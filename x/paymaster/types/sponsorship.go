@@ -0,0 +1,127 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxMethodLength is the longest method name accepted on a sponsorship
+	MaxMethodLength = 128
+
+	// secondsPerDay is used to count the days of the daily caps
+	secondsPerDay = 24 * 60 * 60
+)
+
+// NewSponsorship returns a new Sponsorship
+func NewSponsorship(sponsor, contract string, methods []string, dailyCap math.Int) Sponsorship {
+	return Sponsorship{
+		Sponsor:  sponsor,
+		Contract: contract,
+		Methods:  methods,
+		DailyCap: dailyCap,
+	}
+}
+
+// Validate performs basic validation on the sponsorship
+func (s Sponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid sponsor address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid contract address: %s", err)
+	}
+	if s.DailyCap.IsNil() || !s.DailyCap.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "daily cap must be positive, got %s", s.DailyCap)
+	}
+
+	seen := make(map[string]bool, len(s.Methods))
+	for _, method := range s.Methods {
+		if err := ValidateMethod(method); err != nil {
+			return err
+		}
+		if seen[method] {
+			return errorsmod.Wrapf(ErrInvalidSponsorship, "duplicated method: %s", method)
+		}
+		seen[method] = true
+	}
+
+	return nil
+}
+
+// IsMethodSponsored checks if a method is covered by the sponsorship
+// Sponsorships without methods cover every method
+func (s Sponsorship) IsMethodSponsored(method string) bool {
+	return len(s.Methods) == 0 || slices.Contains(s.Methods, method)
+}
+
+// ValidateMethod checks if a method is an EVM selector or a CosmWasm message name
+func ValidateMethod(method string) error {
+	if strings.HasPrefix(method, "0x") {
+		selector, err := hex.DecodeString(method[2:])
+		if err != nil || len(selector) != 4 || method != strings.ToLower(method) {
+			return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid evm selector %q, expected 4 lowercase hex bytes", method)
+		}
+		return nil
+	}
+
+	if method == "" || len(method) > MaxMethodLength || strings.ContainsAny(method, " \t\n") {
+		return errorsmod.Wrapf(ErrInvalidSponsorship, "invalid method name %q", method)
+	}
+
+	return nil
+}
+
+// EVMMethod returns the method selector of EVM calldata in the sponsorship format
+func EVMMethod(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(data[:4])
+}
+
+// WasmMethod returns the method name of a CosmWasm execute message
+// The name is the single top level key of the JSON message
+func WasmMethod(msg []byte) string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &fields); err != nil || len(fields) != 1 {
+		return ""
+	}
+	for method := range fields {
+		return method
+	}
+	return ""
+}
+
+// Day returns the day of a time counted from the unix epoch
+func Day(t time.Time) uint64 {
+	return uint64(t.Unix()) / secondsPerDay
+}
+
+// NewSponsorshipUsage returns an empty usage for a sponsorship
+func NewSponsorshipUsage(sponsorship Sponsorship, day uint64) SponsorshipUsage {
+	return SponsorshipUsage{
+		Sponsor:    sponsorship.Sponsor,
+		Contract:   sponsorship.Contract,
+		Day:        day,
+		DailySpent: math.ZeroInt(),
+		TotalSpent: math.ZeroInt(),
+	}
+}
+
+// OnDay returns the usage with the daily values reset if the day changed
+func (u SponsorshipUsage) OnDay(day uint64) SponsorshipUsage {
+	if u.Day != day {
+		u.Day = day
+		u.DailySpent = math.ZeroInt()
+	}
+	return u
+}
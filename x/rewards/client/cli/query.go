@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryReleaseSchedule(),
 		GetCmdQueryRewardPool(),
+		GetCmdQueryReleaseHistory(),
+		GetCmdQueryEmissionStats(),
 	)
 
 	return cmd
@@ -111,3 +113,65 @@ func GetCmdQueryRewardPool() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryReleaseHistory implements the release-history query command.
+func GetCmdQueryReleaseHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-history",
+		Short: "Query the latest releases from the rewards pool",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReleaseHistory(context.Background(), &types.QueryReleaseHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "release-history")
+	return cmd
+}
+
+// GetCmdQueryEmissionStats implements the emission-stats query command.
+func GetCmdQueryEmissionStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-stats",
+		Short: "Query the released totals and the estimated annual emission relative to bonded tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EmissionStats(context.Background(), &types.QueryEmissionStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
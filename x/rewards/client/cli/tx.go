@@ -27,6 +27,9 @@ func GetTxCmd() *cobra.Command {
 		NewFundPoolCmd(),
 		NewUpdateParamsCmd(),
 		NewChangeScheduleCmd(),
+		NewPauseScheduleCmd(),
+		NewResumeScheduleCmd(),
+		NewTerminateScheduleCmd(),
 	)
 
 	return cmd
@@ -118,3 +121,78 @@ $ %s tx gov submit-proposal change-schedule <path/to/schedule.json> --from mykey
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewPauseScheduleCmd implements the pause-schedule tx command.
+func NewPauseScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-schedule",
+		Short: "Pause the current release schedule (gov proposal)",
+		Long: `Pause the current release schedule through a governance proposal. Example:
+$ %s tx gov submit-proposal pause-schedule --from mykey
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseSchedule(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewResumeScheduleCmd implements the resume-schedule tx command.
+func NewResumeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-schedule",
+		Short: "Resume the paused release schedule (gov proposal)",
+		Long: `Resume the paused release schedule through a governance proposal. Example:
+$ %s tx gov submit-proposal resume-schedule --from mykey
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResumeSchedule(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTerminateScheduleCmd implements the terminate-schedule tx command.
+func NewTerminateScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate-schedule",
+		Short: "Terminate the current release schedule (gov proposal)",
+		Long: `Permanently terminate the current release schedule through a governance proposal. Example:
+$ %s tx gov submit-proposal terminate-schedule --from mykey
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTerminateSchedule(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
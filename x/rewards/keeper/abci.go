@@ -19,14 +19,14 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		return nil
 	}
 
-	// If active and there is no previous time stamp, set it as current block's and skip this time
-	if schedule.LastReleaseTime.IsZero() {
-		schedule.LastReleaseTime = ctx.BlockTime()
+	// If active and there is no previous release, set it as current block's and skip this time
+	if !schedule.HasReleased() {
+		schedule = schedule.MarkReleased(ctx.BlockTime(), ctx.BlockHeight())
 		return k.ReleaseSchedule.Set(ctx, schedule)
 	}
 
 	// Calculate the amount to distribute this block
-	amountToDistribute, err := types.CalculateReward(ctx.BlockTime(), schedule)
+	amountToDistribute, err := types.CalculateScheduleReward(ctx.BlockTime(), ctx.BlockHeight(), schedule)
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,14 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	// If nothing to distribute, sets up as inactive for early exit next time
 	if amountToDistribute.IsZero() {
 		schedule.Active = false
-		return k.ReleaseSchedule.Set(ctx, schedule)
+		if err := k.ReleaseSchedule.Set(ctx, schedule); err != nil {
+			return err
+		}
+
+		return ctx.EventManager().EmitTypedEvent(&types.EventScheduleCompleted{
+			ReleasedAmount: schedule.ReleasedAmount,
+			Height:         ctx.BlockHeight(),
+		})
 	}
 
 	// Get the current RewardPool from state
@@ -60,7 +67,27 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	}
 
 	// Update release schedule
-	schedule.LastReleaseTime = ctx.BlockTime()
+	schedule = schedule.MarkReleased(ctx.BlockTime(), ctx.BlockHeight())
 	schedule.ReleasedAmount = schedule.ReleasedAmount.Add(amountToDistribute)
-	return k.ReleaseSchedule.Set(ctx, schedule)
+	if err := k.ReleaseSchedule.Set(ctx, schedule); err != nil {
+		return err
+	}
+
+	// Keep track of the release on the history
+	record := types.NewReleaseRecord(ctx.BlockHeight(), ctx.BlockTime(), amountToDistribute)
+	if err := k.AddReleaseRecord(ctx, record); err != nil {
+		return err
+	}
+
+	totalReleased, err := k.GetTotalReleased(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRelease{
+		Amount:         amountToDistribute,
+		ReleasedAmount: schedule.ReleasedAmount,
+		TotalReleased:  totalReleased,
+		Height:         ctx.BlockHeight(),
+	})
 }
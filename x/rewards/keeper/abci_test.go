@@ -189,3 +189,59 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 		})
 	}
 }
+
+// TestBeginBlockerHeightBasedDeterminism tests that height based schedules release the
+// same amounts on replay, no matter the block times
+func (suite *KeeperTestSuite) TestBeginBlockerHeightBasedDeterminism() {
+	params := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	denom := params.TokenDenom
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(1000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Release over 100 blocks, starting on the next block
+	startHeight := suite.Ctx.BlockHeight()
+	err = suite.App.RewardsKeeper.ReleaseSchedule.Set(suite.Ctx, types.ReleaseSchedule{
+		Active:         true,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(1000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndHeight:      startHeight + 100,
+	})
+	suite.Require().NoError(err)
+
+	// Replay the same blocks with different block times
+	replay := func(blockTimes func(i int64) time.Time) (types.ReleaseSchedule, []types.ReleaseRecord) {
+		ctx, _ := suite.Ctx.CacheContext()
+		for i := int64(1); i <= 60; i++ {
+			ctx = ctx.WithBlockHeight(startHeight + i).WithBlockTime(blockTimes(i))
+			err := suite.App.RewardsKeeper.BeginBlocker(ctx)
+			suite.Require().NoError(err)
+		}
+
+		schedule, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+		suite.Require().NoError(err)
+		history, err := suite.App.RewardsKeeper.GetReleaseHistory(ctx)
+		suite.Require().NoError(err)
+		return schedule, history
+	}
+
+	now := time.Now().UTC()
+	regular, regularHistory := replay(func(i int64) time.Time { return now.Add(time.Duration(i) * 5 * time.Second) })
+	irregular, irregularHistory := replay(func(i int64) time.Time { return now.Add(time.Duration(i*i) * time.Minute) })
+
+	// The schedule only moves with the height
+	suite.Require().Equal(regular, irregular)
+	suite.Require().Equal(startHeight+60, regular.LastReleaseHeight)
+	suite.Require().True(regular.LastReleaseTime.IsZero())
+	suite.Require().True(regular.ReleasedAmount.IsPositive())
+
+	// The released amounts are the same on every block
+	suite.Require().Len(regularHistory, len(irregularHistory))
+	for i := range regularHistory {
+		suite.Require().Equal(regularHistory[i].Height, irregularHistory[i].Height)
+		suite.Require().Equal(regularHistory[i].Amount, irregularHistory[i].Amount)
+	}
+}
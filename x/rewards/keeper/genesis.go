@@ -19,6 +19,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	if err := k.ReleaseSchedule.Set(ctx, data.ReleaseSchedule); err != nil {
 		panic(err)
 	}
+
+	// Records are renumbered starting from zero
+	for _, record := range data.ReleaseHistory {
+		id, err := k.ReleaseSequence.Next(ctx)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.ReleaseHistory.Set(ctx, id, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if data.TotalReleased.Denom != "" {
+		if err := k.TotalReleased.Set(ctx, data.TotalReleased); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -38,5 +55,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, rewardPool, releaseSchedule)
+	releaseHistory, err := k.GetReleaseHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totalReleased, err := k.GetTotalReleased(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, rewardPool, releaseSchedule, releaseHistory, totalReleased)
 }
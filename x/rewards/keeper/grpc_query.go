@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/kiichain/kiichain/v3/x/rewards/types"
 )
 
@@ -43,3 +45,24 @@ func (k Querier) ReleaseSchedule(ctx context.Context, _ *types.QueryReleaseSched
 	}
 	return &types.QueryReleaseScheduleResponse{ReleaseSchedule: schedule}, nil
 }
+
+// ReleaseHistory queries the latest releases from the reward pool
+func (k Querier) ReleaseHistory(ctx context.Context, req *types.QueryReleaseHistoryRequest) (*types.QueryReleaseHistoryResponse, error) {
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.ReleaseHistory,
+		req.Pagination,
+		func(_ uint64, record types.ReleaseRecord) (types.ReleaseRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryReleaseHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// EmissionStats queries the released totals and the estimated annualized emission
+func (k Querier) EmissionStats(ctx context.Context, _ *types.QueryEmissionStatsRequest) (*types.QueryEmissionStatsResponse, error) {
+	return k.Keeper.EmissionStats(ctx)
+}
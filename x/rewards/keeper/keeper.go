@@ -18,7 +18,8 @@ type (
 	Keeper struct {
 		cdc codec.BinaryCodec
 
-		bankKeeper types.BankKeeper
+		bankKeeper    types.BankKeeper
+		stakingKeeper types.StakingKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -29,6 +30,9 @@ type (
 		Params          collections.Item[types.Params]
 		RewardPool      collections.Item[types.RewardPool]
 		ReleaseSchedule collections.Item[types.ReleaseSchedule]
+		ReleaseHistory  collections.Map[uint64, types.ReleaseRecord]
+		ReleaseSequence collections.Sequence
+		TotalReleased   collections.Item[sdk.Coin]
 	}
 )
 
@@ -37,13 +41,15 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
 	authority, feeCollectorName string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc: cdc,
 
-		bankKeeper: bankKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
 
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
@@ -51,6 +57,9 @@ func NewKeeper(
 		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		RewardPool:      collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
 		ReleaseSchedule: collections.NewItem(sb, types.ReleaseScheduleKey, "release_schedule", codec.CollValue[types.ReleaseSchedule](cdc)),
+		ReleaseHistory:  collections.NewMap(sb, types.ReleaseHistoryKey, "release_history", collections.Uint64Key, codec.CollValue[types.ReleaseRecord](cdc)),
+		ReleaseSequence: collections.NewSequence(sb, types.ReleaseSequenceKey, "release_sequence"),
+		TotalReleased:   collections.NewItem(sb, types.TotalReleasedKey, "total_released", codec.CollValue[sdk.Coin](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,6 +37,11 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	// Prune right away, an inactive schedule would never release to prune the history
+	if err := k.pruneReleaseHistory(ctx, msg.Params.MaxReleaseHistory); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
@@ -90,3 +96,125 @@ func (k msgServer) ChangeSchedule(ctx context.Context, msg *types.MsgChangeSched
 
 	return &types.MsgChangeScheduleResponse{}, nil
 }
+
+// PauseSchedule pauses the current release schedule
+func (k msgServer) PauseSchedule(ctx context.Context, msg *types.MsgPauseSchedule) (*types.MsgPauseScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	schedule, err := k.Keeper.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pause the schedule at the current block
+	schedule, err = schedule.Pause(sdkCtx.BlockTime(), sdkCtx.BlockHeight())
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause schedule: %w", err)
+	}
+
+	if err := k.Keeper.ReleaseSchedule.Set(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseSchedule,
+			sdk.NewAttribute(types.AttributeKeyPausedTime, schedule.PausedTime.String()),
+			sdk.NewAttribute(types.AttributeKeyPausedHeight, strconv.FormatInt(schedule.PausedHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyReleasedAmount, schedule.ReleasedAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgPauseScheduleResponse{}, nil
+}
+
+// ResumeSchedule resumes a paused release schedule, shifting its times by the paused duration
+func (k msgServer) ResumeSchedule(ctx context.Context, msg *types.MsgResumeSchedule) (*types.MsgResumeScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	schedule, err := k.Keeper.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resume the schedule at the current block
+	schedule, err = schedule.Resume(sdkCtx.BlockTime(), sdkCtx.BlockHeight())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume schedule: %w", err)
+	}
+
+	if err := k.Keeper.ReleaseSchedule.Set(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResumeSchedule,
+			sdk.NewAttribute(types.AttributeKeyEndTime, schedule.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyLastReleaseTime, schedule.LastReleaseTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(schedule.EndHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyLastReleaseHeight, strconv.FormatInt(schedule.LastReleaseHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgResumeScheduleResponse{}, nil
+}
+
+// TerminateSchedule permanently ends the current release schedule, keeping the
+// unreleased funds in the pool
+func (k msgServer) TerminateSchedule(ctx context.Context, msg *types.MsgTerminateSchedule) (*types.MsgTerminateScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	schedule, err := k.Keeper.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Terminate the schedule at the current block
+	schedule, err = schedule.Terminate(sdkCtx.BlockTime(), sdkCtx.BlockHeight())
+	if err != nil {
+		return nil, fmt.Errorf("failed to terminate schedule: %w", err)
+	}
+
+	if err := k.Keeper.ReleaseSchedule.Set(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTerminateSchedule,
+			sdk.NewAttribute(types.AttributeKeyEndTime, schedule.EndTime.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(schedule.EndHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyReleasedAmount, schedule.ReleasedAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgTerminateScheduleResponse{}, nil
+}
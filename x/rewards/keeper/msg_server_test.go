@@ -243,6 +243,75 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			},
 			expectedPass: false,
 		},
+		{
+			name:      "valid height based schedule",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.EndTime = time.Time{}
+				s.EndHeight = suite.Ctx.BlockHeight() + 100
+				return s
+			},
+			expectedPass: true,
+		},
+		{
+			name:      "height based schedule with end height in the past",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.EndTime = time.Time{}
+				s.EndHeight = suite.Ctx.BlockHeight()
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "height based schedule with last release in the future",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.EndTime = time.Time{}
+				s.EndHeight = suite.Ctx.BlockHeight() + 100
+				s.LastReleaseHeight = suite.Ctx.BlockHeight() + 1
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "height based schedule mixing times",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.EndHeight = suite.Ctx.BlockHeight() + 100
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "end time before the block time",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.EndTime = suite.Ctx.BlockTime().Add(-time.Second)
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "backdated paused time",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Active = false
+				s.PausedTime = suite.Ctx.BlockTime().Add(-time.Hour * 24 * 365)
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "paused height",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Active = false
+				s.PausedHeight = 1
+				return s
+			},
+			expectedPass: false,
+		},
 		{
 			name:      "insufficient funds",
 			authority: authority,
@@ -278,9 +347,91 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 				suite.Require().Equal(modifiedSchedule.TotalAmount, storedSchedule.TotalAmount)
 				suite.Require().True(modifiedSchedule.LastReleaseTime.Equal(storedSchedule.LastReleaseTime))
 				suite.Require().True(modifiedSchedule.EndTime.Equal(storedSchedule.EndTime))
+				suite.Require().Equal(modifiedSchedule.EndHeight, storedSchedule.EndHeight)
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+// TestPauseResumeTerminateSchedule tests pausing, resuming and terminating the schedule
+func (suite *KeeperTestSuite) TestPauseResumeTerminateSchedule() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	denom := defaultParams.TokenDenom
+	now := time.Now().UTC()
+	ctx := suite.Ctx.WithBlockTime(now)
+
+	// Set an active schedule
+	schedule := types.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(100)),
+		EndTime:         now.Add(time.Hour),
+		LastReleaseTime: now.Add(-time.Minute),
+		Active:          true,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedule.Set(ctx, schedule)
+	suite.Require().NoError(err)
+
+	// Only the authority can pause, resume or terminate
+	_, err = suite.msgServer.PauseSchedule(ctx, types.NewMsgPauseSchedule(suite.TestAccs[0].String()))
+	suite.Require().Error(err)
+	_, err = suite.msgServer.ResumeSchedule(ctx, types.NewMsgResumeSchedule(suite.TestAccs[0].String()))
+	suite.Require().Error(err)
+	_, err = suite.msgServer.TerminateSchedule(ctx, types.NewMsgTerminateSchedule(suite.TestAccs[0].String()))
+	suite.Require().Error(err)
+
+	// Resume fails if not paused
+	_, err = suite.msgServer.ResumeSchedule(ctx, types.NewMsgResumeSchedule(authority))
+	suite.Require().Error(err)
+
+	// Pause the schedule
+	_, err = suite.msgServer.PauseSchedule(ctx, types.NewMsgPauseSchedule(authority))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.EventTypePauseSchedule, 1)
+
+	paused, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(paused.Active)
+	suite.Require().True(paused.PausedTime.Equal(now))
+
+	// Nothing is released while paused
+	ctx = ctx.WithBlockTime(now.Add(time.Hour * 3))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	stored, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(schedule.ReleasedAmount, stored.ReleasedAmount)
+
+	// Resume after three hours, times are shifted by the paused duration
+	_, err = suite.msgServer.ResumeSchedule(ctx, types.NewMsgResumeSchedule(authority))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.EventTypeResumeSchedule, 1)
+
+	resumed, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(resumed.Active)
+	suite.Require().True(resumed.PausedTime.IsZero())
+	suite.Require().True(resumed.EndTime.Equal(schedule.EndTime.Add(time.Hour * 3)))
+	suite.Require().True(resumed.LastReleaseTime.Equal(schedule.LastReleaseTime.Add(time.Hour * 3)))
+
+	// Terminate the schedule
+	_, err = suite.msgServer.TerminateSchedule(ctx, types.NewMsgTerminateSchedule(authority))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.EventTypeTerminateSchedule, 1)
+
+	terminated, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(terminated.Active)
+	suite.Require().Equal(schedule.ReleasedAmount, terminated.TotalAmount)
+	suite.Require().True(terminated.EndTime.Equal(ctx.BlockTime()))
+
+	// Terminated schedules can't be paused
+	_, err = suite.msgServer.PauseSchedule(ctx, types.NewMsgPauseSchedule(authority))
+	suite.Require().Error(err)
+}
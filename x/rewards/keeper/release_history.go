@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// GetTotalReleased returns the amount released across all schedules
+func (k Keeper) GetTotalReleased(ctx context.Context) (sdk.Coin, error) {
+	totalReleased, err := k.TotalReleased.Get(ctx)
+	if err == nil {
+		return totalReleased, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{}, err
+	}
+
+	// Nothing released yet, return zero on the module denom
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(params.TokenDenom, math.ZeroInt()), nil
+}
+
+// AddReleaseRecord stores a new release on the history and prunes the records
+// exceeding the max history length set on params
+func (k Keeper) AddReleaseRecord(ctx context.Context, record types.ReleaseRecord) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Track the total released across all schedules
+	totalReleased, err := k.GetTotalReleased(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.TotalReleased.Set(ctx, totalReleased.Add(record.Amount)); err != nil {
+		return err
+	}
+
+	// Store the record if the history is enabled
+	if params.MaxReleaseHistory > 0 {
+		id, err := k.ReleaseSequence.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if err := k.ReleaseHistory.Set(ctx, id, record); err != nil {
+			return err
+		}
+	}
+
+	return k.pruneReleaseHistory(ctx, params.MaxReleaseHistory)
+}
+
+// pruneReleaseHistory removes the oldest records until only maxHistory remain
+func (k Keeper) pruneReleaseHistory(ctx context.Context, maxHistory uint64) error {
+	next, err := k.ReleaseSequence.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	if next <= maxHistory {
+		return nil
+	}
+
+	// Everything below the cutoff is out of the history window
+	rng := new(collections.Range[uint64]).EndExclusive(next - maxHistory)
+	return k.ReleaseHistory.Clear(ctx, rng)
+}
+
+// GetReleaseHistory returns the full release history, oldest first
+func (k Keeper) GetReleaseHistory(ctx context.Context) ([]types.ReleaseRecord, error) {
+	history := []types.ReleaseRecord{}
+	err := k.ReleaseHistory.Walk(ctx, nil, func(_ uint64, record types.ReleaseRecord) (bool, error) {
+		history = append(history, record)
+		return false, nil
+	})
+	return history, err
+}
+
+// EmissionStats returns the released totals and the estimated annualized emission of
+// the current schedule, relative to the bonded tokens
+func (k Keeper) EmissionStats(ctx context.Context) (*types.QueryEmissionStatsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	totalReleased, err := k.GetTotalReleased(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.ReleaseSchedule.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The remaining amount only makes sense if the schedule has a denom
+	denom := totalReleased.Denom
+	remaining := sdk.NewCoin(denom, math.ZeroInt())
+	if schedule.TotalAmount.Denom != "" {
+		denom = schedule.TotalAmount.Denom
+		remaining = sdk.NewCoin(denom, schedule.TotalAmount.Amount.Sub(schedule.ReleasedAmount.Amount))
+	}
+
+	avgBlockTime, err := k.averageBlockTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	annualEmission := sdk.NewCoin(denom, types.CalculateAnnualEmission(sdkCtx.BlockTime(), sdkCtx.BlockHeight(), avgBlockTime, schedule))
+
+	// Emission relative to the bonded stake, like an APR
+	emissionRate := math.LegacyZeroDec()
+	if totalBonded.IsPositive() {
+		emissionRate = math.LegacyNewDecFromInt(annualEmission.Amount).QuoInt(totalBonded)
+	}
+
+	return &types.QueryEmissionStatsResponse{
+		TotalReleased:     totalReleased,
+		RemainingAmount:   remaining,
+		AnnualEmission:    annualEmission,
+		TotalBondedTokens: totalBonded,
+		EmissionRate:      emissionRate,
+	}, nil
+}
+
+// averageBlockTime estimates the block time from the oldest and newest records of the
+// release history, it is zero if there are not enough records
+func (k Keeper) averageBlockTime(ctx context.Context) (time.Duration, error) {
+	first, err := k.ReleaseHistory.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer first.Close()
+	if !first.Valid() {
+		return 0, nil
+	}
+	oldest, err := first.Value()
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := k.ReleaseHistory.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return 0, err
+	}
+	defer last.Close()
+	newest, err := last.Value()
+	if err != nil {
+		return 0, err
+	}
+
+	blocks := newest.Height - oldest.Height
+	if blocks <= 0 {
+		return 0, nil
+	}
+	return newest.Time.Sub(oldest.Time) / time.Duration(blocks), nil
+}
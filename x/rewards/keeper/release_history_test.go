@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/kiichain/kiichain/v3/x/rewards/types"
+)
+
+// TestReleaseHistory tests that releases are recorded and the history is pruned
+func (suite *KeeperTestSuite) TestReleaseHistory() {
+	// Keep at most three records
+	params := types.DefaultParams()
+	params.MaxReleaseHistory = 3
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	denom := params.TokenDenom
+	now := time.Now().UTC()
+
+	// Record five releases
+	for i := int64(1); i <= 5; i++ {
+		record := types.NewReleaseRecord(i, now.Add(time.Duration(i)*time.Second), sdk.NewCoin(denom, math.NewInt(i*10)))
+		err := suite.App.RewardsKeeper.AddReleaseRecord(suite.Ctx, record)
+		suite.Require().NoError(err)
+	}
+
+	// Only the latest three are kept
+	history, err := suite.App.RewardsKeeper.GetReleaseHistory(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 3)
+	suite.Require().Equal(int64(3), history[0].Height)
+	suite.Require().Equal(int64(5), history[2].Height)
+
+	// The total keeps track of every release
+	totalReleased, err := suite.App.RewardsKeeper.GetTotalReleased(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(150)), totalReleased)
+
+	// Shrinking the history prunes on the next release
+	params.MaxReleaseHistory = 1
+	err = suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.AddReleaseRecord(suite.Ctx, types.NewReleaseRecord(6, now, sdk.NewCoin(denom, math.NewInt(60))))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.ReleaseHistory(suite.Ctx, &types.QueryReleaseHistoryRequest{
+		Pagination: &query.PageRequest{Limit: 10},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 1)
+	suite.Require().Equal(int64(6), res.Records[0].Height)
+
+	// Disabling the history removes all the records but still tracks the total
+	params.MaxReleaseHistory = 0
+	err = suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.AddReleaseRecord(suite.Ctx, types.NewReleaseRecord(7, now, sdk.NewCoin(denom, math.NewInt(70))))
+	suite.Require().NoError(err)
+
+	history, err = suite.App.RewardsKeeper.GetReleaseHistory(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(history)
+
+	totalReleased, err = suite.App.RewardsKeeper.GetTotalReleased(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(280)), totalReleased)
+}
+
+// TestUpdateParamsPrunesHistory tests that shrinking the history through governance prunes it right away
+func (suite *KeeperTestSuite) TestUpdateParamsPrunesHistory() {
+	params := types.DefaultParams()
+	denom := params.TokenDenom
+	now := time.Now().UTC()
+
+	// Record three releases
+	for i := int64(1); i <= 3; i++ {
+		record := types.NewReleaseRecord(i, now.Add(time.Duration(i)*time.Second), sdk.NewCoin(denom, math.NewInt(i*10)))
+		err := suite.App.RewardsKeeper.AddReleaseRecord(suite.Ctx, record)
+		suite.Require().NoError(err)
+	}
+
+	// Shrinking the history keeps the latest record without a new release
+	params.MaxReleaseHistory = 1
+	_, err := suite.msgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(suite.App.RewardsKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	history, err := suite.App.RewardsKeeper.GetReleaseHistory(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 1)
+	suite.Require().Equal(int64(3), history[0].Height)
+
+	// The exported genesis is valid again
+	suite.Require().NoError(suite.App.RewardsKeeper.ExportGenesis(suite.Ctx).Validate())
+}
+
+// TestBeginBlockerReleaseEvents tests the typed events and history written on release
+func (suite *KeeperTestSuite) TestBeginBlockerReleaseEvents() {
+	params := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	denom := params.TokenDenom
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(1000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	now := time.Now().UTC()
+	err = suite.App.RewardsKeeper.ReleaseSchedule.Set(suite.Ctx, types.ReleaseSchedule{
+		Active:          true,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+		LastReleaseTime: now,
+		EndTime:         now.Add(time.Hour * 2),
+	})
+	suite.Require().NoError(err)
+
+	// Release half of the schedule
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour)).WithBlockHeight(10)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, "kiichain.rewards.v1beta1.EventRelease", 1)
+
+	history, err := suite.App.RewardsKeeper.GetReleaseHistory(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(history, 1)
+	suite.Require().Equal(int64(10), history[0].Height)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(500)), history[0].Amount)
+
+	// Release the rest and complete the schedule
+	ctx = ctx.WithBlockTime(now.Add(time.Hour * 3)).WithBlockHeight(11)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	ctx = ctx.WithBlockTime(now.Add(time.Hour * 4)).WithBlockHeight(12)
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, "kiichain.rewards.v1beta1.EventScheduleCompleted", 1)
+
+	totalReleased, err := suite.App.RewardsKeeper.GetTotalReleased(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(1000)), totalReleased)
+}
+
+// TestEmissionStats tests the annualized emission query
+func (suite *KeeperTestSuite) TestEmissionStats() {
+	params := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	denom := params.TokenDenom
+	now := time.Now().UTC()
+	ctx := suite.Ctx.WithBlockTime(now)
+
+	totalBonded, err := suite.App.StakingKeeper.TotalBondedTokens(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(totalBonded.IsPositive())
+
+	// Inactive schedule emits nothing
+	res, err := suite.queryClient.EmissionStats(ctx, &types.QueryEmissionStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.AnnualEmission.IsZero())
+	suite.Require().True(res.EmissionRate.IsZero())
+	suite.Require().Equal(totalBonded, res.TotalBondedTokens)
+
+	// A schedule releasing 1000 in half a year emits 2000 per year
+	halfYear := time.Duration(types.SecondsPerYear/2) * time.Second
+	err = suite.App.RewardsKeeper.ReleaseSchedule.Set(ctx, types.ReleaseSchedule{
+		Active:          true,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(1500)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(500)),
+		LastReleaseTime: now,
+		EndTime:         now.Add(halfYear),
+	})
+	suite.Require().NoError(err)
+
+	res, err = suite.App.RewardsKeeper.EmissionStats(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(1000)), res.RemainingAmount)
+	suite.Require().Equal(sdk.NewCoin(denom, math.NewInt(2000)), res.AnnualEmission)
+	suite.Require().Equal(math.LegacyNewDec(2000).QuoInt(totalBonded), res.EmissionRate)
+}
@@ -36,10 +36,47 @@ func validateAmount(amount sdk.Coin) error {
 	return nil
 }
 
-// validateEndTime checks if time is in the past
-func validateEndTime(endTime time.Time) error {
-	if endTime.Before(time.Now()) {
-		return fmt.Errorf("end time %s is not in the future", endTime)
+// validateScheduleTimes checks the times of a time based schedule against the block time
+func validateScheduleTimes(blockTime time.Time, schedule types.ReleaseSchedule) error {
+	if schedule.LastReleaseHeight != 0 || schedule.PausedHeight != 0 {
+		return fmt.Errorf("time based schedule cannot have release heights")
+	}
+	if schedule.EndTime.IsZero() {
+		return fmt.Errorf("end time cannot be zero")
+	}
+	if !schedule.EndTime.After(blockTime) {
+		return fmt.Errorf("end time %s is not in the future", schedule.EndTime)
+	}
+
+	if !schedule.LastReleaseTime.IsZero() {
+		if schedule.LastReleaseTime.After(blockTime) {
+			return fmt.Errorf("last release time %s cannot be in the future",
+				schedule.LastReleaseTime)
+		}
+		if schedule.LastReleaseTime.After(schedule.EndTime) {
+			return fmt.Errorf("last release time %s cannot be after end time %s",
+				schedule.LastReleaseTime, schedule.EndTime)
+		}
+	}
+
+	return nil
+}
+
+// validateScheduleHeights checks the heights of a height based schedule against the block height
+func validateScheduleHeights(blockHeight int64, schedule types.ReleaseSchedule) error {
+	if !schedule.EndTime.IsZero() || !schedule.LastReleaseTime.IsZero() {
+		return fmt.Errorf("height based schedule cannot have end time or last release time")
+	}
+	if schedule.LastReleaseHeight < 0 || schedule.PausedHeight < 0 {
+		return fmt.Errorf("release heights cannot be negative")
+	}
+	if schedule.EndHeight <= blockHeight {
+		return fmt.Errorf("end height %d is not in the future", schedule.EndHeight)
+	}
+
+	if schedule.LastReleaseHeight != 0 && schedule.LastReleaseHeight > blockHeight {
+		return fmt.Errorf("last release height %d cannot be in the future",
+			schedule.LastReleaseHeight)
 	}
 
 	return nil
@@ -94,32 +131,27 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 		}
 	}
 
-	// Time validations
-	currentTime := time.Now()
-	if schedule.EndTime.IsZero() {
-		return fmt.Errorf("end time cannot be zero")
-	}
-	if err = validateEndTime(schedule.EndTime); err != nil {
-		return err
-	}
-
-	if !schedule.LastReleaseTime.IsZero() {
-		if schedule.LastReleaseTime.After(currentTime) {
-			return fmt.Errorf("last release time %s cannot be in the future",
-				schedule.LastReleaseTime)
+	// Time and height validations, always against the block and never the local clock
+	if schedule.IsHeightBased() {
+		if err := validateScheduleHeights(sdk.UnwrapSDKContext(ctx).BlockHeight(), schedule); err != nil {
+			return err
 		}
-		if schedule.LastReleaseTime.After(schedule.EndTime) {
-			return fmt.Errorf("last release time %s cannot be after end time %s",
-				schedule.LastReleaseTime, schedule.EndTime)
+	} else {
+		if err := validateScheduleTimes(sdk.UnwrapSDKContext(ctx).BlockTime(), schedule); err != nil {
+			return err
 		}
 	}
 
-	// 6. Active state consistency
+	// 6. Active state consistency, the pause is only set by MsgPauseSchedule so a
+	// backdated pause can't shift the schedule on resume
+	if schedule.IsPaused() {
+		return fmt.Errorf("paused time and height can only be set by pausing the schedule")
+	}
 	if schedule.Active {
 		if schedule.TotalAmount.IsZero() {
 			return fmt.Errorf("active schedule cannot have zero total amount")
 		}
-		if schedule.EndTime.IsZero() {
+		if schedule.EndTime.IsZero() && !schedule.IsHeightBased() {
 			return fmt.Errorf("active schedule must have an end time")
 		}
 	}
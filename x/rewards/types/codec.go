@@ -15,6 +15,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgFundPool{},
 		&MsgChangeSchedule{},
+		&MsgPauseSchedule{},
+		&MsgResumeSchedule{},
+		&MsgTerminateSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -28,4 +31,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "rewards/update-params", nil)
 	cdc.RegisterConcrete(&MsgFundPool{}, "rewards/fund-pool", nil)
 	cdc.RegisterConcrete(&MsgChangeSchedule{}, "rewards/change-schedule", nil)
+	cdc.RegisterConcrete(&MsgPauseSchedule{}, "rewards/pause-schedule", nil)
+	cdc.RegisterConcrete(&MsgResumeSchedule{}, "rewards/resume-schedule", nil)
+	cdc.RegisterConcrete(&MsgTerminateSchedule{}, "rewards/terminate-schedule", nil)
 }
@@ -23,10 +23,13 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(3, len(impls))
+	suite.Require().Equal(6, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/kiichain.rewards.v1beta1.MsgChangeSchedule",
 		"/kiichain.rewards.v1beta1.MsgFundPool",
 		"/kiichain.rewards.v1beta1.MsgUpdateParams",
+		"/kiichain.rewards.v1beta1.MsgPauseSchedule",
+		"/kiichain.rewards.v1beta1.MsgResumeSchedule",
+		"/kiichain.rewards.v1beta1.MsgTerminateSchedule",
 	}, impls)
 }
@@ -0,0 +1,21 @@
+package types
+
+// Rewards module event types
+const (
+	EventTypePauseSchedule     = "pause_schedule"
+	EventTypeResumeSchedule    = "resume_schedule"
+	EventTypeTerminateSchedule = "terminate_schedule"
+)
+
+// Rewards module attribute keys
+const (
+	AttributeKeyPausedTime        = "paused_time"
+	AttributeKeyEndTime           = "end_time"
+	AttributeKeyLastReleaseTime   = "last_release_time"
+	AttributeKeyReleasedAmount    = "released_amount"
+	AttributeKeyPausedHeight      = "paused_height"
+	AttributeKeyEndHeight         = "end_height"
+	AttributeKeyLastReleaseHeight = "last_release_height"
+
+	AttributeValueCategory = ModuleName
+)
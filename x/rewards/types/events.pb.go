@@ -0,0 +1,689 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/rewards/v1beta1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventRelease is emitted every time rewards are released to the fee collector
+type EventRelease struct {
+	// Amount released on this block
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// Amount released so far by the current schedule
+	ReleasedAmount types.Coin `protobuf:"bytes,2,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount"`
+	// Amount released across all schedules
+	TotalReleased types.Coin `protobuf:"bytes,3,opt,name=total_released,json=totalReleased,proto3" json:"total_released"`
+	// Height of the release
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventRelease) Reset()         { *m = EventRelease{} }
+func (m *EventRelease) String() string { return proto.CompactTextString(m) }
+func (*EventRelease) ProtoMessage()    {}
+func (*EventRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{0}
+}
+func (m *EventRelease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRelease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRelease.Merge(m, src)
+}
+func (m *EventRelease) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRelease proto.InternalMessageInfo
+
+func (m *EventRelease) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventRelease) GetReleasedAmount() types.Coin {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventRelease) GetTotalReleased() types.Coin {
+	if m != nil {
+		return m.TotalReleased
+	}
+	return types.Coin{}
+}
+
+func (m *EventRelease) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// EventScheduleCompleted is emitted when the release schedule has nothing
+// else to release and goes inactive
+type EventScheduleCompleted struct {
+	// Amount released by the schedule
+	ReleasedAmount types.Coin `protobuf:"bytes,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount"`
+	// Height at which the schedule completed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventScheduleCompleted) Reset()         { *m = EventScheduleCompleted{} }
+func (m *EventScheduleCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventScheduleCompleted) ProtoMessage()    {}
+func (*EventScheduleCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35ab8578051c3715, []int{1}
+}
+func (m *EventScheduleCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduleCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduleCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduleCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduleCompleted.Merge(m, src)
+}
+func (m *EventScheduleCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduleCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduleCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduleCompleted proto.InternalMessageInfo
+
+func (m *EventScheduleCompleted) GetReleasedAmount() types.Coin {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventScheduleCompleted) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventRelease)(nil), "kiichain.rewards.v1beta1.EventRelease")
+	proto.RegisterType((*EventScheduleCompleted)(nil), "kiichain.rewards.v1beta1.EventScheduleCompleted")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/rewards/v1beta1/events.proto", fileDescriptor_35ab8578051c3715)
+}
+
+var fileDescriptor_35ab8578051c3715 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0xc7, 0xe3, 0xf6, 0x53, 0xa5, 0xcf, 0x40, 0x11, 0x11, 0xaa, 0x42, 0x07, 0x53, 0x55, 0x42,
+	0xaa, 0x10, 0xb2, 0x55, 0x58, 0x59, 0x68, 0x05, 0x0b, 0x62, 0x29, 0x1b, 0x4b, 0xe5, 0x24, 0xa7,
+	0xc4, 0x22, 0x89, 0xab, 0xd8, 0x2d, 0x30, 0xc1, 0x23, 0xf0, 0x18, 0x8c, 0x3c, 0x46, 0xc7, 0x8e,
+	0x4c, 0x08, 0xb5, 0x03, 0xaf, 0x81, 0x92, 0x38, 0x81, 0x81, 0xa5, 0x62, 0x89, 0x2e, 0xe7, 0xff,
+	0xfd, 0xee, 0xfe, 0xf6, 0xe1, 0x83, 0x5b, 0x21, 0xbc, 0x90, 0x8b, 0x84, 0xa5, 0x70, 0xc7, 0x53,
+	0x5f, 0xb1, 0x59, 0xdf, 0x05, 0xcd, 0xfb, 0x0c, 0x66, 0x90, 0x68, 0x45, 0x27, 0xa9, 0xd4, 0xd2,
+	0x76, 0x4a, 0x19, 0x35, 0x32, 0x6a, 0x64, 0xed, 0xdd, 0x40, 0x06, 0x32, 0x17, 0xb1, 0x2c, 0x2a,
+	0xf4, 0x6d, 0xe2, 0x49, 0x15, 0x4b, 0xc5, 0x5c, 0xae, 0xa0, 0x22, 0x7a, 0x52, 0x24, 0xe6, 0x7c,
+	0x87, 0xc7, 0x22, 0x91, 0x2c, 0xff, 0x16, 0xa9, 0xee, 0x53, 0x0d, 0x6f, 0x9e, 0x67, 0x3d, 0x47,
+	0x10, 0x01, 0x57, 0x60, 0x9f, 0xe2, 0x06, 0x8f, 0xe5, 0x34, 0xd1, 0x0e, 0xea, 0xa0, 0xde, 0xc6,
+	0xf1, 0x1e, 0x2d, 0xa0, 0x34, 0x83, 0x96, 0xfd, 0xe9, 0x50, 0x8a, 0x64, 0xf0, 0x7f, 0xfe, 0xbe,
+	0x6f, 0xbd, 0x7c, 0xbe, 0x1e, 0xa2, 0x91, 0xa9, 0xb1, 0xaf, 0xf0, 0x76, 0x5a, 0x80, 0xfc, 0xb1,
+	0xc1, 0xd4, 0xd6, 0xc0, 0x34, 0xcb, 0xe2, 0xb3, 0x02, 0x77, 0x89, 0x9b, 0x5a, 0x6a, 0x1e, 0x8d,
+	0xcb, 0xbc, 0x53, 0x5f, 0x83, 0xb6, 0x95, 0xd7, 0x1a, 0x63, 0xbe, 0xdd, 0xc2, 0x8d, 0x10, 0x44,
+	0x10, 0x6a, 0xe7, 0x5f, 0x07, 0xf5, 0xea, 0x23, 0xf3, 0xd7, 0x7d, 0xc4, 0xad, 0xfc, 0x06, 0xae,
+	0xbd, 0x10, 0xfc, 0x69, 0x04, 0x43, 0x19, 0x4f, 0x22, 0xd0, 0xe0, 0xff, 0xe6, 0x06, 0xfd, 0xc1,
+	0xcd, 0xf7, 0x00, 0xb5, 0x9f, 0x03, 0x0c, 0x2e, 0xe6, 0x4b, 0x82, 0x16, 0x4b, 0x82, 0x3e, 0x96,
+	0x04, 0x3d, 0xaf, 0x88, 0xb5, 0x58, 0x11, 0xeb, 0x6d, 0x45, 0xac, 0x9b, 0xa3, 0x40, 0xe8, 0x70,
+	0xea, 0x52, 0x4f, 0xc6, 0xac, 0x5a, 0x99, 0x2a, 0xb8, 0xaf, 0xb6, 0x47, 0x3f, 0x4c, 0x40, 0xb9,
+	0x8d, 0xfc, 0x49, 0x4f, 0xbe, 0x06, 0x00, 0xbf, 0x8d, 0xcd, 0x47, 0x5e, 0x02, 0x00, 0x00,
+}
+
+func (m *EventRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TotalReleased.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduleCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduleCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduleCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TotalReleased.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *EventScheduleCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventRelease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRelease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRelease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalReleased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduleCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduleCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduleCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
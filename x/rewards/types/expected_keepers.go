@@ -3,6 +3,8 @@ package types
 import (
 	context "context"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -12,3 +14,8 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// StakingKeeper is used to fetch the bonded tokens for the emission stats
+type StakingKeeper interface {
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+}
@@ -1,22 +1,36 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState constructs a genesis state
 func NewGenesisState(
 	params Params, rp RewardPool, release ReleaseSchedule,
+	history []ReleaseRecord, totalReleased sdk.Coin,
 ) *GenesisState {
 	return &GenesisState{
 		Params:          params,
 		RewardPool:      rp,
 		ReleaseSchedule: release,
+		ReleaseHistory:  history,
+		TotalReleased:   totalReleased,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state of rewards.
 func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
 		RewardPool:      InitialRewardPool(),
-		Params:          DefaultParams(),
+		Params:          params,
 		ReleaseSchedule: InitialReleaseSchedule(),
+		ReleaseHistory:  []ReleaseRecord{},
+		TotalReleased:   sdk.NewCoin(params.TokenDenom, math.ZeroInt()),
 	}
 }
 
@@ -29,5 +43,16 @@ func (gs *GenesisState) Validate() error {
 	if err := gs.RewardPool.ValidateGenesis(); err != nil {
 		return err
 	}
+
+	if err := ValidateReleaseHistory(gs.ReleaseHistory, gs.Params.MaxReleaseHistory); err != nil {
+		return err
+	}
+
+	// Total released can be empty on genesis files created before it existed
+	if gs.TotalReleased.Denom != "" {
+		if err := gs.TotalReleased.Validate(); err != nil {
+			return fmt.Errorf("invalid total released: %w", err)
+		}
+	}
 	return gs.ReleaseSchedule.ValidateGenesis()
 }
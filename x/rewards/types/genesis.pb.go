@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -32,6 +32,10 @@ type GenesisState struct {
 	ReleaseSchedule ReleaseSchedule `protobuf:"bytes,2,opt,name=release_schedule,json=releaseSchedule,proto3" json:"release_schedule"`
 	// reward_pool has information on the community pool
 	RewardPool RewardPool `protobuf:"bytes,3,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool"`
+	// release_history has the latest releases, oldest first
+	ReleaseHistory []ReleaseRecord `protobuf:"bytes,4,rep,name=release_history,json=releaseHistory,proto3" json:"release_history"`
+	// total_released is the amount released across all schedules
+	TotalReleased types.Coin `protobuf:"bytes,5,opt,name=total_released,json=totalReleased,proto3" json:"total_released"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +92,20 @@ func (m *GenesisState) GetRewardPool() RewardPool {
 	return RewardPool{}
 }
 
+func (m *GenesisState) GetReleaseHistory() []ReleaseRecord {
+	if m != nil {
+		return m.ReleaseHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalReleased() types.Coin {
+	if m != nil {
+		return m.TotalReleased
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.rewards.v1beta1.GenesisState")
 }
@@ -97,26 +115,30 @@ func init() {
 }
 
 var fileDescriptor_96ab53dc25b7c542 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x63, 0x40, 0x1d, 0x5c, 0x24, 0x50, 0xc4, 0x10, 0x75, 0x30, 0x15, 0x2a, 0x08, 0x24,
-	0x64, 0xab, 0xb0, 0x33, 0x30, 0xc0, 0xc0, 0x52, 0xb5, 0x5b, 0x97, 0xca, 0x49, 0x4f, 0xa9, 0x45,
-	0x9a, 0x8b, 0x6c, 0x97, 0x3f, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x10, 0xac,
-	0xa8, 0x8e, 0x09, 0x62, 0x08, 0x9b, 0x73, 0xf9, 0x7d, 0xbf, 0x3b, 0x7d, 0xf4, 0xec, 0x51, 0xa9,
-	0x64, 0x21, 0x55, 0x2e, 0x34, 0x3c, 0x4b, 0x3d, 0x37, 0xe2, 0x69, 0x18, 0x83, 0x95, 0x43, 0x91,
-	0x42, 0x0e, 0x46, 0x19, 0x5e, 0x68, 0xb4, 0x18, 0x46, 0x3f, 0x1c, 0xf7, 0x1c, 0xf7, 0x5c, 0xef,
-	0x28, 0xc5, 0x14, 0x1d, 0x24, 0xb6, 0xaf, 0x9a, 0xef, 0xb1, 0x04, 0xcd, 0x12, 0x8d, 0x88, 0xa5,
-	0x81, 0x46, 0x99, 0xa0, 0xca, 0xfd, 0xff, 0xd3, 0xd6, 0xbd, 0x85, 0xd4, 0x72, 0xe9, 0xd7, 0xf6,
-	0x06, 0xad, 0x98, 0x7d, 0x2d, 0xc0, 0x53, 0x27, 0x5f, 0x84, 0xee, 0xdf, 0xd7, 0xe7, 0x4e, 0xac,
-	0xb4, 0x10, 0xde, 0xd0, 0x4e, 0xad, 0x89, 0x48, 0x9f, 0x9c, 0x77, 0xaf, 0xfa, 0xbc, 0xed, 0x7c,
-	0x3e, 0x72, 0xdc, 0xed, 0xde, 0xfa, 0xe3, 0x38, 0x18, 0xfb, 0x54, 0x38, 0xa5, 0x87, 0x1a, 0x32,
-	0x90, 0x06, 0x66, 0x26, 0x59, 0xc0, 0x7c, 0x95, 0x41, 0xb4, 0xe3, 0x4c, 0x17, 0xed, 0xa6, 0x71,
-	0x9d, 0x98, 0xf8, 0x80, 0x57, 0x1e, 0xe8, 0xbf, 0xe3, 0xf0, 0x81, 0x76, 0xeb, 0xe4, 0xac, 0x40,
-	0xcc, 0xa2, 0x5d, 0xa7, 0x1d, 0xfc, 0xa7, 0xdd, 0x7e, 0x8f, 0x10, 0x33, 0x6f, 0xa4, 0xfa, 0x77,
-	0x72, 0xb7, 0x2e, 0x19, 0xd9, 0x94, 0x8c, 0x7c, 0x96, 0x8c, 0xbc, 0x55, 0x2c, 0xd8, 0x54, 0x2c,
-	0x78, 0xaf, 0x58, 0x30, 0xbd, 0x4c, 0x95, 0x5d, 0xac, 0x62, 0x9e, 0xe0, 0x52, 0x34, 0x25, 0x36,
-	0x8f, 0x97, 0xa6, 0x4f, 0xd7, 0x63, 0xdc, 0x71, 0x45, 0x5e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x22, 0xbd, 0xae, 0x98, 0x0f, 0x02, 0x00, 0x00,
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0xdb, 0x0b, 0x97, 0xc5, 0x70, 0x2f, 0xf7, 0xa6, 0x71, 0x51, 0x59, 0x54, 0x62, 0x50,
+	0x31, 0x31, 0xd3, 0x80, 0x7b, 0x17, 0x98, 0xa0, 0x89, 0x1b, 0x02, 0x89, 0x0b, 0x36, 0xcd, 0xb4,
+	0x9d, 0xb4, 0x13, 0x4b, 0x4f, 0x33, 0x33, 0xa8, 0xbc, 0x85, 0x3b, 0x5f, 0x89, 0x25, 0x4b, 0x57,
+	0xc6, 0xc0, 0x8b, 0x18, 0x66, 0x86, 0x26, 0x2e, 0xaa, 0xbb, 0xe9, 0xe9, 0xf7, 0x7f, 0xe7, 0x24,
+	0x3f, 0x3a, 0x7d, 0x60, 0x2c, 0x4a, 0x09, 0xcb, 0x7d, 0x4e, 0x9f, 0x08, 0x8f, 0x85, 0xff, 0xd8,
+	0x0f, 0xa9, 0x24, 0x7d, 0x3f, 0xa1, 0x39, 0x15, 0x4c, 0xe0, 0x82, 0x83, 0x04, 0xc7, 0xdd, 0x73,
+	0xd8, 0x70, 0xd8, 0x70, 0xed, 0x83, 0x04, 0x12, 0x50, 0x90, 0xbf, 0x7b, 0x69, 0xbe, 0xed, 0x45,
+	0x20, 0xe6, 0x20, 0xfc, 0x90, 0x08, 0x5a, 0x2a, 0x23, 0x60, 0xb9, 0xf9, 0x7f, 0x52, 0xb9, 0xb7,
+	0x20, 0x9c, 0xcc, 0xcd, 0xda, 0x76, 0xb7, 0x12, 0x93, 0xcb, 0x82, 0x1a, 0xea, 0xf8, 0xb5, 0x86,
+	0xfe, 0xdc, 0xe8, 0x73, 0xa7, 0x92, 0x48, 0xea, 0x5c, 0xa1, 0x86, 0xd6, 0xb8, 0x76, 0xc7, 0xee,
+	0x35, 0x07, 0x1d, 0x5c, 0x75, 0x3e, 0x1e, 0x2b, 0x6e, 0x58, 0x5f, 0xbd, 0x1f, 0x59, 0x13, 0x93,
+	0x72, 0x66, 0xe8, 0x3f, 0xa7, 0x19, 0x25, 0x82, 0x06, 0x22, 0x4a, 0x69, 0xbc, 0xc8, 0xa8, 0xfb,
+	0x4b, 0x99, 0xce, 0xab, 0x4d, 0x13, 0x9d, 0x98, 0x9a, 0x80, 0x51, 0xfe, 0xe3, 0x5f, 0xc7, 0xce,
+	0x1d, 0x6a, 0xea, 0x64, 0x50, 0x00, 0x64, 0x6e, 0x4d, 0x69, 0xbb, 0xdf, 0x69, 0x77, 0xdf, 0x63,
+	0x80, 0xcc, 0x18, 0x11, 0x2f, 0x27, 0xce, 0x3d, 0xda, 0xfb, 0x83, 0x94, 0x09, 0x09, 0x7c, 0xe9,
+	0xd6, 0x3b, 0xb5, 0x5e, 0x73, 0x70, 0xf6, 0xe3, 0x9d, 0x13, 0x1a, 0x01, 0x8f, 0x8d, 0xb3, 0x65,
+	0x2c, 0xb7, 0x5a, 0xe2, 0x8c, 0x50, 0x4b, 0x82, 0x24, 0x59, 0x60, 0xe6, 0xb1, 0xfb, 0x5b, 0xdd,
+	0x79, 0x88, 0x75, 0xaf, 0x78, 0xd7, 0x6b, 0x69, 0xbc, 0x06, 0x96, 0x1b, 0xd1, 0x5f, 0x15, 0x33,
+	0x2b, 0xe2, 0xe1, 0x68, 0xb5, 0xf1, 0xec, 0xf5, 0xc6, 0xb3, 0x3f, 0x36, 0x9e, 0xfd, 0xb2, 0xf5,
+	0xac, 0xf5, 0xd6, 0xb3, 0xde, 0xb6, 0x9e, 0x35, 0xbb, 0x48, 0x98, 0x4c, 0x17, 0x21, 0x8e, 0x60,
+	0xee, 0x97, 0x25, 0x97, 0x8f, 0xe7, 0xb2, 0x6f, 0xd5, 0x73, 0xd8, 0x50, 0x45, 0x5f, 0x7e, 0x0e,
+	0x00, 0xfc, 0x15, 0x18, 0x25, 0xaf, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +161,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TotalReleased.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ReleaseHistory) > 0 {
+		for iNdEx := len(m.ReleaseHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -195,6 +241,14 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.RewardPool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReleaseHistory) > 0 {
+		for _, e := range m.ReleaseHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TotalReleased.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -332,6 +386,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseHistory = append(m.ReleaseHistory, ReleaseRecord{})
+			if err := m.ReleaseHistory[len(m.ReleaseHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalReleased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -26,13 +26,17 @@ func (suite *GenesisTestSuite) TestNewGenesisState() {
 	params := types.DefaultParams()
 	pool := types.InitialRewardPool()
 	schedule := types.InitialReleaseSchedule()
+	history := []types.ReleaseRecord{types.NewReleaseRecord(1, time.Now(), sdk.NewCoin("akii", math.NewInt(10)))}
+	totalReleased := sdk.NewCoin("akii", math.NewInt(10))
 
 	// Test creation
-	genesis := types.NewGenesisState(params, pool, schedule)
+	genesis := types.NewGenesisState(params, pool, schedule, history, totalReleased)
 
 	suite.Require().Equal(params, genesis.Params)
 	suite.Require().Equal(pool, genesis.RewardPool)
 	suite.Require().Equal(schedule, genesis.ReleaseSchedule)
+	suite.Require().Equal(history, genesis.ReleaseHistory)
+	suite.Require().Equal(totalReleased, genesis.TotalReleased)
 }
 
 func (suite *GenesisTestSuite) TestDefaultGenesisState() {
@@ -81,9 +85,56 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			expectedPass: false,
 		},
 		{
-			name: "invalid release schedule - past end time",
+			name: "invalid release schedule - last release after end time",
 			modifyFn: func(gs *types.GenesisState) {
 				gs.ReleaseSchedule.EndTime = time.Now().Add(-time.Hour)
+				gs.ReleaseSchedule.LastReleaseTime = time.Now()
+			},
+			expectedPass: false,
+		},
+		{
+			name: "valid release history",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(1, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+					types.NewReleaseRecord(2, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+				gs.TotalReleased = sdk.NewCoin("akii", math.NewInt(20))
+			},
+			expectedPass: true,
+		},
+		{
+			name: "invalid release history - unordered heights",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(2, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+					types.NewReleaseRecord(1, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "invalid release history - over the max",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.Params.MaxReleaseHistory = 1
+				gs.ReleaseHistory = []types.ReleaseRecord{
+					types.NewReleaseRecord(1, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+					types.NewReleaseRecord(2, time.Now(), sdk.NewCoin("akii", math.NewInt(10))),
+				}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "missing total released - valid",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.TotalReleased = sdk.Coin{}
+			},
+			expectedPass: true,
+		},
+		{
+			name: "invalid total released",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.TotalReleased = sdk.Coin{Denom: "akii", Amount: math.NewInt(-1)}
 			},
 			expectedPass: false,
 		},
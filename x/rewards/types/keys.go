@@ -6,6 +6,9 @@ var (
 	ParamsKey          = collections.NewPrefix(0)
 	RewardPoolKey      = collections.NewPrefix(1)
 	ReleaseScheduleKey = collections.NewPrefix(2)
+	ReleaseHistoryKey  = collections.NewPrefix(3)
+	ReleaseSequenceKey = collections.NewPrefix(4)
+	TotalReleasedKey   = collections.NewPrefix(5)
 )
 
 const (
@@ -9,6 +9,9 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgFundPool)(nil)
 	_ sdk.Msg = (*MsgChangeSchedule)(nil)
+	_ sdk.Msg = (*MsgPauseSchedule)(nil)
+	_ sdk.Msg = (*MsgResumeSchedule)(nil)
+	_ sdk.Msg = (*MsgTerminateSchedule)(nil)
 )
 
 // NewMsgUpdateParams returns a new MsgUpdateParams with the authority
@@ -37,3 +40,24 @@ func NewMsgChangeSchedule(authority string, schedule ReleaseSchedule) *MsgChange
 		Schedule:  schedule,
 	}
 }
+
+// NewMsgPauseSchedule returns a new MsgPauseSchedule with the authority
+func NewMsgPauseSchedule(authority string) *MsgPauseSchedule {
+	return &MsgPauseSchedule{
+		Authority: authority,
+	}
+}
+
+// NewMsgResumeSchedule returns a new MsgResumeSchedule with the authority
+func NewMsgResumeSchedule(authority string) *MsgResumeSchedule {
+	return &MsgResumeSchedule{
+		Authority: authority,
+	}
+}
+
+// NewMsgTerminateSchedule returns a new MsgTerminateSchedule with the authority
+func NewMsgTerminateSchedule(authority string) *MsgTerminateSchedule {
+	return &MsgTerminateSchedule{
+		Authority: authority,
+	}
+}
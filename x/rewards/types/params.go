@@ -6,10 +6,14 @@ import (
 	"github.com/kiichain/kiichain/v3/app/params"
 )
 
+// DefaultMaxReleaseHistory is the default amount of release records kept in state
+const DefaultMaxReleaseHistory uint64 = 1000
+
 // DefaultParams returns default rewards parameters
 func DefaultParams() Params {
 	return Params{
-		TokenDenom: params.BaseDenom, // akii base denom
+		TokenDenom:        params.BaseDenom, // akii base denom
+		MaxReleaseHistory: DefaultMaxReleaseHistory,
 	}
 }
 
@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// Denom used
 	TokenDenom string `protobuf:"bytes,1,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"`
+	// Maximum number of release records kept in the history, zero disables it
+	MaxReleaseHistory uint64 `protobuf:"varint,2,opt,name=max_release_history,json=maxReleaseHistory,proto3" json:"max_release_history,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +71,13 @@ func (m *Params) GetTokenDenom() string {
 	return ""
 }
 
+func (m *Params) GetMaxReleaseHistory() uint64 {
+	if m != nil {
+		return m.MaxReleaseHistory
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.rewards.v1beta1.Params")
 }
@@ -78,19 +87,21 @@ func init() {
 }
 
 var fileDescriptor_54abd846c753e163 = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
+	// 223 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xce, 0xcc, 0x4c,
 	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x33, 0x4c,
 	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x80, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x54, 0x70, 0x1a, 0x5b, 0x52, 0x59, 0x90,
-	0x0a, 0x35, 0x55, 0x49, 0x93, 0x8b, 0x2d, 0x00, 0x6c, 0x8b, 0x90, 0x3c, 0x17, 0x77, 0x49, 0x7e,
+	0x0a, 0x35, 0x55, 0x29, 0x92, 0x8b, 0x2d, 0x00, 0x6c, 0x8b, 0x90, 0x3c, 0x17, 0x77, 0x49, 0x7e,
 	0x76, 0x6a, 0x5e, 0x7c, 0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x17, 0x58, 0xc8, 0x05, 0x24, 0xe2, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x70, 0x5b,
-	0xe1, 0x8c, 0x0a, 0xb8, 0x03, 0xc0, 0x16, 0x27, 0xb1, 0x81, 0x6d, 0x36, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x46, 0xe3, 0xb3, 0x7a, 0xf8, 0x00, 0x00, 0x00,
+	0x17, 0x58, 0xc8, 0x05, 0x24, 0x22, 0xa4, 0xc7, 0x25, 0x9c, 0x9b, 0x58, 0x11, 0x5f, 0x94, 0x9a,
+	0x93, 0x9a, 0x58, 0x9c, 0x1a, 0x9f, 0x91, 0x59, 0x5c, 0x92, 0x5f, 0x54, 0x29, 0xc1, 0xa4, 0xc0,
+	0xa8, 0xc1, 0x12, 0x24, 0x98, 0x9b, 0x58, 0x11, 0x04, 0x91, 0xf1, 0x80, 0x48, 0x38, 0xb9, 0x9d,
+	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
+	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4e, 0x7a, 0x66, 0x49, 0x46, 0x69,
+	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xdc, 0x95, 0x70, 0x46, 0x05, 0xdc, 0xc1, 0x60, 0x87, 0x26,
+	0xb1, 0x81, 0x5d, 0x6a, 0x0c, 0x18, 0x00, 0x7c, 0x90, 0x5f, 0xce, 0x28, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +124,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxReleaseHistory != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReleaseHistory))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.TokenDenom) > 0 {
 		i -= len(m.TokenDenom)
 		copy(dAtA[i:], m.TokenDenom)
@@ -144,6 +160,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxReleaseHistory != 0 {
+		n += 1 + sovParams(uint64(m.MaxReleaseHistory))
+	}
 	return n
 }
 
@@ -214,6 +233,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleaseHistory", wireType)
+			}
+			m.MaxReleaseHistory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReleaseHistory |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
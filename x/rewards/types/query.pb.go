@@ -5,8 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -281,6 +285,216 @@ func (m *QueryRewardPoolResponse) GetRewardPool() RewardPool {
 	return RewardPool{}
 }
 
+// QueryReleaseHistoryRequest defines the request structure for the
+// ReleaseHistory gRPC query.
+type QueryReleaseHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReleaseHistoryRequest) Reset()         { *m = QueryReleaseHistoryRequest{} }
+func (m *QueryReleaseHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseHistoryRequest) ProtoMessage()    {}
+func (*QueryReleaseHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{6}
+}
+func (m *QueryReleaseHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseHistoryRequest.Merge(m, src)
+}
+func (m *QueryReleaseHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryReleaseHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReleaseHistoryResponse defines the response structure for the
+// ReleaseHistory gRPC query.
+type QueryReleaseHistoryResponse struct {
+	Records []ReleaseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records" yaml:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReleaseHistoryResponse) Reset()         { *m = QueryReleaseHistoryResponse{} }
+func (m *QueryReleaseHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseHistoryResponse) ProtoMessage()    {}
+func (*QueryReleaseHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{7}
+}
+func (m *QueryReleaseHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseHistoryResponse.Merge(m, src)
+}
+func (m *QueryReleaseHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseHistoryResponse) GetRecords() []ReleaseRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryReleaseHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEmissionStatsRequest defines the request structure for the
+// EmissionStats gRPC query.
+type QueryEmissionStatsRequest struct {
+}
+
+func (m *QueryEmissionStatsRequest) Reset()         { *m = QueryEmissionStatsRequest{} }
+func (m *QueryEmissionStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionStatsRequest) ProtoMessage()    {}
+func (*QueryEmissionStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{8}
+}
+func (m *QueryEmissionStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionStatsRequest.Merge(m, src)
+}
+func (m *QueryEmissionStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionStatsRequest proto.InternalMessageInfo
+
+// QueryEmissionStatsResponse defines the response structure for the
+// EmissionStats gRPC query.
+type QueryEmissionStatsResponse struct {
+	// total_released is the amount released across all schedules
+	TotalReleased types.Coin `protobuf:"bytes,1,opt,name=total_released,json=totalReleased,proto3" json:"total_released"`
+	// remaining_amount is what is left to release in the current schedule
+	RemainingAmount types.Coin `protobuf:"bytes,2,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount"`
+	// annual_emission is the estimated amount released over a year at the
+	// current schedule rate
+	AnnualEmission types.Coin `protobuf:"bytes,3,opt,name=annual_emission,json=annualEmission,proto3" json:"annual_emission"`
+	// total_bonded_tokens is the amount currently bonded on staking
+	TotalBondedTokens cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=total_bonded_tokens,json=totalBondedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_bonded_tokens"`
+	// emission_rate is the annual emission relative to the bonded tokens
+	EmissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=emission_rate,json=emissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_rate"`
+}
+
+func (m *QueryEmissionStatsResponse) Reset()         { *m = QueryEmissionStatsResponse{} }
+func (m *QueryEmissionStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionStatsResponse) ProtoMessage()    {}
+func (*QueryEmissionStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{9}
+}
+func (m *QueryEmissionStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionStatsResponse.Merge(m, src)
+}
+func (m *QueryEmissionStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionStatsResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionStatsResponse) GetTotalReleased() types.Coin {
+	if m != nil {
+		return m.TotalReleased
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEmissionStatsResponse) GetRemainingAmount() types.Coin {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return types.Coin{}
+}
+
+func (m *QueryEmissionStatsResponse) GetAnnualEmission() types.Coin {
+	if m != nil {
+		return m.AnnualEmission
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.rewards.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.rewards.v1beta1.QueryParamsResponse")
@@ -288,6 +502,10 @@ func init() {
 	proto.RegisterType((*QueryReleaseScheduleResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleResponse")
 	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolRequest")
 	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryReleaseHistoryRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseHistoryRequest")
+	proto.RegisterType((*QueryReleaseHistoryResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseHistoryResponse")
+	proto.RegisterType((*QueryEmissionStatsRequest)(nil), "kiichain.rewards.v1beta1.QueryEmissionStatsRequest")
+	proto.RegisterType((*QueryEmissionStatsResponse)(nil), "kiichain.rewards.v1beta1.QueryEmissionStatsResponse")
 }
 
 func init() {
@@ -295,39 +513,64 @@ func init() {
 }
 
 var fileDescriptor_12435df56ac62847 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0xfc, 0xc9, 0x61, 0x7b, 0x28, 0x5a, 0x2a, 0x1a, 0x99, 0xe2, 0x44, 0xab, 0x56,
-	0x94, 0x2a, 0xb1, 0x49, 0x10, 0x1c, 0x38, 0x70, 0xc8, 0x81, 0x73, 0x31, 0xe2, 0xc2, 0xa5, 0xda,
-	0xa4, 0x2b, 0xc7, 0xc2, 0xf1, 0xb8, 0x5e, 0x1b, 0x88, 0xc4, 0x89, 0x17, 0x00, 0x09, 0x71, 0xe7,
-	0x21, 0x78, 0x88, 0x1e, 0x2b, 0x71, 0xe1, 0x54, 0xa1, 0x84, 0x27, 0x80, 0x17, 0x40, 0xde, 0x1d,
-	0xbb, 0xaa, 0x5b, 0x37, 0xe4, 0xe6, 0xec, 0x7c, 0xf3, 0x7d, 0xbf, 0xec, 0x8c, 0x96, 0x6e, 0xbf,
-	0x09, 0x82, 0xf1, 0x44, 0x04, 0x91, 0x9b, 0xc8, 0x77, 0x22, 0x39, 0x54, 0xee, 0xdb, 0xfe, 0x48,
-	0xa6, 0xa2, 0xef, 0x1e, 0x65, 0x32, 0x99, 0x39, 0x71, 0x02, 0x29, 0xb0, 0x56, 0xa1, 0x72, 0x50,
-	0xe5, 0xa0, 0xca, 0xda, 0xf0, 0xc1, 0x07, 0x2d, 0x72, 0xf3, 0x2f, 0xa3, 0xb7, 0xb6, 0x7c, 0x00,
-	0x3f, 0x94, 0xae, 0x88, 0x03, 0x57, 0x44, 0x11, 0xa4, 0x22, 0x0d, 0x20, 0x52, 0x58, 0xdd, 0x1b,
-	0x83, 0x9a, 0x82, 0x72, 0x47, 0x42, 0x49, 0x13, 0x53, 0x86, 0xc6, 0xc2, 0x0f, 0x22, 0x2d, 0x46,
-	0x6d, 0x3d, 0x5f, 0x3a, 0x8b, 0x65, 0xe1, 0xb8, 0x53, 0xab, 0x8a, 0x45, 0x22, 0xa6, 0x28, 0xe3,
-	0x1b, 0x94, 0xbd, 0xc8, 0xe3, 0xf6, 0xf5, 0xa1, 0x27, 0x8f, 0x32, 0xa9, 0x52, 0xfe, 0x8a, 0xde,
-	0x3e, 0x77, 0xaa, 0x62, 0x88, 0x94, 0x64, 0xcf, 0x68, 0xd3, 0x34, 0xb7, 0x48, 0x87, 0xec, 0xae,
-	0x0d, 0x3a, 0x4e, 0xdd, 0x25, 0x38, 0xa6, 0x73, 0x78, 0xe3, 0xf8, 0xb4, 0xdd, 0xf0, 0xb0, 0x8b,
-	0xdf, 0xa3, 0x77, 0xb5, 0xad, 0x27, 0x43, 0x29, 0x94, 0x7c, 0x39, 0x9e, 0xc8, 0xc3, 0x2c, 0x94,
-	0x45, 0xea, 0x57, 0x42, 0xb7, 0x2e, 0xaf, 0x63, 0x7e, 0x46, 0x6f, 0x25, 0xa6, 0x74, 0xa0, 0xb0,
-	0x86, 0x24, 0x0f, 0xea, 0x49, 0x2a, 0x66, 0xc3, 0x76, 0x8e, 0xf4, 0xe7, 0xb4, 0xbd, 0x39, 0x13,
-	0xd3, 0xf0, 0x29, 0xaf, 0x1a, 0x72, 0x6f, 0x3d, 0x39, 0xdf, 0xc1, 0x5b, 0xf4, 0x0e, 0x62, 0xe5,
-	0xce, 0xfb, 0x00, 0x61, 0x41, 0xfc, 0x81, 0x6e, 0x5e, 0xa8, 0x20, 0xab, 0xa0, 0x6b, 0x86, 0xe4,
-	0x20, 0x06, 0x08, 0x11, 0x73, 0xfb, 0x2a, 0xcc, 0xc2, 0x62, 0x68, 0x21, 0x21, 0x2b, 0x08, 0x4b,
-	0x1b, 0xee, 0xd1, 0xa4, 0xd4, 0x0d, 0xfe, 0x5e, 0xa7, 0x37, 0x75, 0x3c, 0xfb, 0x44, 0x68, 0xd3,
-	0xdc, 0x38, 0xeb, 0xd6, 0x47, 0x5c, 0x1c, 0xb4, 0xd5, 0xfb, 0x4f, 0xb5, 0xf9, 0x53, 0x7c, 0xf7,
-	0xe3, 0x8f, 0xdf, 0x5f, 0xae, 0x71, 0xd6, 0x71, 0x97, 0x6c, 0x17, 0xfb, 0x4e, 0xe8, 0x7a, 0xe5,
-	0xe6, 0xd9, 0xe3, 0x25, 0x61, 0x97, 0xaf, 0x85, 0xf5, 0x64, 0xd5, 0x36, 0x84, 0x1d, 0x68, 0xd8,
-	0x2e, 0xdb, 0xab, 0x87, 0xc5, 0x49, 0xf7, 0x8a, 0xe1, 0xb3, 0x6f, 0x84, 0xd2, 0xb3, 0x49, 0xb0,
-	0x87, 0x4b, 0xa3, 0x2b, 0x1b, 0x61, 0xf5, 0x57, 0xe8, 0x40, 0xce, 0x9e, 0xe6, 0xbc, 0xcf, 0x76,
-	0xae, 0xe2, 0xcc, 0x7f, 0xf7, 0xf2, 0x15, 0x18, 0x3e, 0x3f, 0x9e, 0xdb, 0xe4, 0x64, 0x6e, 0x93,
-	0x5f, 0x73, 0x9b, 0x7c, 0x5e, 0xd8, 0x8d, 0x93, 0x85, 0xdd, 0xf8, 0xb9, 0xb0, 0x1b, 0xaf, 0xbb,
-	0x7e, 0x90, 0x4e, 0xb2, 0x91, 0x33, 0x86, 0xe9, 0x99, 0x55, 0xf9, 0xf1, 0xbe, 0x74, 0xd5, 0xcf,
-	0xc4, 0xa8, 0xa9, 0x1f, 0x80, 0x47, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x94, 0x24, 0x7b,
-	0xef, 0x04, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xd7, 0xbb, 0xed, 0xa2, 0xce, 0xb2, 0xbb, 0x74, 0x5a, 0xda, 0xac, 0xb7, 0x24, 0x91,
+	0xd5, 0xb2, 0x69, 0xba, 0xb1, 0x9b, 0xb4, 0xe5, 0xc0, 0x01, 0x09, 0x53, 0x0a, 0x15, 0x20, 0x8a,
+	0x0b, 0x48, 0xc0, 0xc1, 0x9a, 0xd8, 0x23, 0x67, 0xb4, 0xf6, 0x4c, 0xea, 0x99, 0x00, 0x91, 0x38,
+	0xf1, 0x0f, 0x80, 0x84, 0xb8, 0x73, 0xe4, 0xc0, 0xa1, 0x87, 0x9e, 0x91, 0xb8, 0xf5, 0x58, 0x81,
+	0x90, 0x10, 0x87, 0x15, 0xda, 0x45, 0xe2, 0xce, 0x5f, 0x50, 0x79, 0x7e, 0x24, 0x71, 0x36, 0x69,
+	0x9a, 0x4b, 0x94, 0xe4, 0xbd, 0xf7, 0x7d, 0x9f, 0xef, 0xcc, 0xf3, 0x93, 0xc1, 0xe5, 0x03, 0x42,
+	0xa2, 0x1e, 0x22, 0xd4, 0xcb, 0xf1, 0x57, 0x28, 0x8f, 0xb9, 0xf7, 0x65, 0xbb, 0x8b, 0x05, 0x6a,
+	0x7b, 0x0f, 0x06, 0x38, 0x1f, 0xba, 0xfd, 0x9c, 0x09, 0x06, 0x2b, 0x26, 0xcb, 0xd5, 0x59, 0xae,
+	0xce, 0xb2, 0xcf, 0x27, 0x2c, 0x61, 0x32, 0xc9, 0x2b, 0xbe, 0xa9, 0x7c, 0xfb, 0x52, 0xc2, 0x58,
+	0x92, 0x62, 0x0f, 0xf5, 0x89, 0x87, 0x28, 0x65, 0x02, 0x09, 0xc2, 0x28, 0xd7, 0xd1, 0x66, 0xc4,
+	0x78, 0xc6, 0xb8, 0xd7, 0x45, 0x1c, 0xab, 0x36, 0xa3, 0xa6, 0x7d, 0x94, 0x10, 0x2a, 0x93, 0x75,
+	0xee, 0x7c, 0x3e, 0x31, 0xec, 0x63, 0xa3, 0x78, 0x65, 0x6e, 0x56, 0x1f, 0xe5, 0x28, 0x33, 0x69,
+	0xd5, 0xc9, 0xc6, 0x26, 0x23, 0x62, 0xc4, 0x34, 0xdb, 0x51, 0xf1, 0x50, 0xf9, 0x51, 0x3f, 0x74,
+	0xe8, 0x2c, 0xca, 0x08, 0x65, 0x9e, 0xfc, 0x54, 0x7f, 0x39, 0xe7, 0x01, 0xfc, 0xa8, 0x80, 0xbf,
+	0x27, 0x5b, 0x04, 0xf8, 0xc1, 0x00, 0x73, 0xe1, 0x7c, 0x02, 0xce, 0x95, 0xfe, 0xe5, 0x7d, 0x46,
+	0x39, 0x86, 0x6f, 0x80, 0x75, 0x85, 0x52, 0xb1, 0xea, 0x56, 0x63, 0xa3, 0x53, 0x77, 0xe7, 0x1d,
+	0xa9, 0xab, 0x2a, 0xfd, 0x53, 0x8f, 0x0f, 0x6b, 0x2b, 0x81, 0xae, 0x72, 0x5e, 0x01, 0xbb, 0x52,
+	0x36, 0xc0, 0x29, 0x46, 0x1c, 0xdf, 0x8f, 0x7a, 0x38, 0x1e, 0xa4, 0xd8, 0x74, 0xfd, 0xd1, 0x02,
+	0x97, 0x66, 0xc7, 0x75, 0xff, 0x01, 0x78, 0x29, 0x57, 0xa1, 0x90, 0xeb, 0x98, 0x26, 0xb9, 0x3a,
+	0x9f, 0x64, 0x4a, 0xcc, 0xaf, 0x15, 0x48, 0xff, 0x1f, 0xd6, 0x2e, 0x0e, 0x51, 0x96, 0xbe, 0xee,
+	0x4c, 0x0b, 0x3a, 0xc1, 0x76, 0x5e, 0xae, 0x70, 0x2a, 0xe0, 0x82, 0xc6, 0x2a, 0x94, 0xef, 0x31,
+	0x96, 0x1a, 0xe2, 0x6f, 0xc0, 0xc5, 0x13, 0x11, 0xcd, 0x8a, 0xc0, 0x86, 0x22, 0x09, 0xfb, 0x8c,
+	0xa5, 0x1a, 0xf3, 0xf2, 0xb3, 0x30, 0x8d, 0x84, 0x6f, 0x6b, 0x42, 0x68, 0x08, 0x47, 0x32, 0x4e,
+	0x00, 0xf2, 0x51, 0x9e, 0x13, 0x03, 0x7b, 0xf2, 0xb8, 0xde, 0x25, 0x5c, 0xb0, 0x7c, 0xa8, 0xd9,
+	0xe0, 0x1d, 0x00, 0xc6, 0x83, 0xa8, 0xfb, 0xbf, 0xea, 0xea, 0x79, 0x28, 0x86, 0xc7, 0x55, 0x0f,
+	0xc7, 0xf8, 0xc6, 0x12, 0x73, 0x13, 0xc1, 0x44, 0xa5, 0xf3, 0x9b, 0x05, 0x76, 0x67, 0xb6, 0xd1,
+	0x46, 0x3f, 0x03, 0x2f, 0xe4, 0x38, 0x62, 0x79, 0x5c, 0x4c, 0xc5, 0x5a, 0x63, 0xa3, 0xb3, 0xb7,
+	0xf0, 0x2e, 0x02, 0x99, 0xef, 0x5f, 0xd0, 0x3e, 0xb7, 0x8c, 0x4f, 0xa9, 0xe2, 0x04, 0x46, 0x0f,
+	0xbe, 0x53, 0xb2, 0xb0, 0x2a, 0x2d, 0xec, 0x2d, 0xb4, 0xa0, 0xb8, 0x4a, 0x1e, 0x76, 0xc1, 0x8e,
+	0xb4, 0xf0, 0x76, 0x46, 0x38, 0x27, 0x8c, 0xde, 0x17, 0x48, 0x8c, 0x86, 0xfd, 0xd7, 0x35, 0x60,
+	0xcf, 0x8a, 0x6a, 0x7f, 0xef, 0x81, 0x2d, 0xc1, 0x04, 0x4a, 0x43, 0x3d, 0x16, 0xb1, 0x3e, 0xcb,
+	0x9d, 0x12, 0x88, 0x41, 0x78, 0x8b, 0x11, 0xea, 0x9f, 0x29, 0x8c, 0xfd, 0xfc, 0xdf, 0xc3, 0xa6,
+	0x15, 0x6c, 0xca, 0x5a, 0xed, 0x3b, 0x86, 0x1f, 0x16, 0x13, 0x9c, 0x21, 0x42, 0x09, 0x4d, 0x42,
+	0x94, 0xb1, 0x01, 0x15, 0x95, 0xd5, 0x25, 0xe4, 0xb6, 0x47, 0xd5, 0x6f, 0xca, 0x62, 0xf8, 0x01,
+	0xd8, 0x46, 0x94, 0x0e, 0x50, 0x1a, 0x62, 0x4d, 0x5f, 0x59, 0x5b, 0x42, 0x6f, 0x4b, 0x15, 0x1b,
+	0xe7, 0xf0, 0x0b, 0x70, 0x4e, 0x99, 0xed, 0x32, 0x1a, 0xe3, 0x38, 0x14, 0xec, 0x00, 0x53, 0x5e,
+	0x39, 0x55, 0xb7, 0x1a, 0x67, 0xfc, 0x6b, 0x45, 0xdd, 0xdf, 0x87, 0xb5, 0x97, 0x95, 0x32, 0x8f,
+	0x0f, 0x5c, 0xc2, 0xbc, 0x0c, 0x89, 0x9e, 0x7b, 0x97, 0x8a, 0xdf, 0x1f, 0xb5, 0x80, 0x6e, 0x79,
+	0x97, 0x8a, 0xe0, 0xac, 0xd4, 0xf1, 0xa5, 0xcc, 0xc7, 0x52, 0x05, 0x7e, 0x0a, 0x36, 0x0d, 0x64,
+	0x98, 0x23, 0x81, 0x2b, 0xa7, 0xa5, 0x6c, 0x5b, 0xcb, 0xee, 0x9e, 0x94, 0x7d, 0x1f, 0x27, 0x28,
+	0x1a, 0xde, 0xc6, 0xd1, 0x84, 0xf8, 0x6d, 0x1c, 0x05, 0x2f, 0x1a, 0x9d, 0x00, 0x09, 0xdc, 0xf9,
+	0x73, 0x1d, 0x9c, 0x96, 0x17, 0x08, 0xbf, 0xb3, 0xc0, 0xba, 0xda, 0x3c, 0x70, 0x7f, 0xfe, 0x14,
+	0x9e, 0x5c, 0x78, 0x76, 0xeb, 0x39, 0xb3, 0xd5, 0x4c, 0x38, 0x8d, 0x6f, 0xff, 0xf8, 0xf7, 0x87,
+	0x55, 0x07, 0xd6, 0xbd, 0x05, 0x3b, 0x1b, 0x3e, 0xb2, 0xc0, 0xf6, 0xd4, 0x06, 0x82, 0xb7, 0x16,
+	0x34, 0x9b, 0xbd, 0x1e, 0xed, 0xd7, 0x96, 0x2d, 0xd3, 0xb0, 0x1d, 0x09, 0xbb, 0x0f, 0x9b, 0xf3,
+	0x61, 0xf5, 0x68, 0xb7, 0xcc, 0x12, 0x84, 0x3f, 0x59, 0x00, 0x8c, 0x37, 0x12, 0xbc, 0xbe, 0xb0,
+	0xf5, 0xd4, 0x66, 0xb4, 0xdb, 0x4b, 0x54, 0x68, 0xce, 0x96, 0xe4, 0xdc, 0x83, 0x57, 0x9e, 0xc5,
+	0x59, 0xfc, 0x6e, 0x15, 0xab, 0x10, 0x3e, 0xb4, 0xc0, 0x56, 0x79, 0x25, 0xc1, 0x9b, 0xcf, 0x77,
+	0x42, 0xe5, 0x45, 0x69, 0xdf, 0x5a, 0xb2, 0x4a, 0xe3, 0xb6, 0x25, 0xee, 0x35, 0x78, 0x75, 0xf1,
+	0xb1, 0xf6, 0x34, 0xdf, 0x2f, 0x16, 0xd8, 0x2c, 0x2d, 0x19, 0x78, 0x63, 0x41, 0xef, 0x59, 0x0b,
+	0xcb, 0xbe, 0xb9, 0x5c, 0x91, 0xe6, 0xbd, 0x2e, 0x79, 0x9b, 0xb0, 0x31, 0x9f, 0xd7, 0x3c, 0x55,
+	0x2d, 0x5e, 0x54, 0xfa, 0x77, 0x1e, 0x1f, 0x55, 0xad, 0x27, 0x47, 0x55, 0xeb, 0x9f, 0xa3, 0xaa,
+	0xf5, 0xfd, 0x71, 0x75, 0xe5, 0xc9, 0x71, 0x75, 0xe5, 0xaf, 0xe3, 0xea, 0xca, 0xe7, 0xfb, 0x09,
+	0x11, 0xbd, 0x41, 0xd7, 0x8d, 0x58, 0x36, 0x56, 0x1b, 0x7d, 0xf9, 0x7a, 0x24, 0x2c, 0x5f, 0x6f,
+	0xba, 0xeb, 0xf2, 0x55, 0xe3, 0xc6, 0xd3, 0x01, 0x00, 0xf4, 0x78, 0xbd, 0x49, 0xa7, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -351,6 +594,12 @@ type QueryClient interface {
 	// RewardPool defines a gRPC query method for fetching
 	// RewardPool data.
 	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// ReleaseHistory defines a gRPC query method for fetching the latest
+	// releases from the reward pool.
+	ReleaseHistory(ctx context.Context, in *QueryReleaseHistoryRequest, opts ...grpc.CallOption) (*QueryReleaseHistoryResponse, error)
+	// EmissionStats defines a gRPC query method for fetching the released
+	// totals and the estimated annualized emission relative to bonded stake.
+	EmissionStats(ctx context.Context, in *QueryEmissionStatsRequest, opts ...grpc.CallOption) (*QueryEmissionStatsResponse, error)
 }
 
 type queryClient struct {
@@ -388,6 +637,24 @@ func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest
 	return out, nil
 }
 
+func (c *queryClient) ReleaseHistory(ctx context.Context, in *QueryReleaseHistoryRequest, opts ...grpc.CallOption) (*QueryReleaseHistoryResponse, error) {
+	out := new(QueryReleaseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionStats(ctx context.Context, in *QueryEmissionStatsRequest, opts ...grpc.CallOption) (*QueryEmissionStatsResponse, error) {
+	out := new(QueryEmissionStatsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/EmissionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the reward module's
@@ -399,6 +666,12 @@ type QueryServer interface {
 	// RewardPool defines a gRPC query method for fetching
 	// RewardPool data.
 	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// ReleaseHistory defines a gRPC query method for fetching the latest
+	// releases from the reward pool.
+	ReleaseHistory(context.Context, *QueryReleaseHistoryRequest) (*QueryReleaseHistoryResponse, error)
+	// EmissionStats defines a gRPC query method for fetching the released
+	// totals and the estimated annualized emission relative to bonded stake.
+	EmissionStats(context.Context, *QueryEmissionStatsRequest) (*QueryEmissionStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -414,6 +687,12 @@ func (*UnimplementedQueryServer) ReleaseSchedule(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
 }
+func (*UnimplementedQueryServer) ReleaseHistory(ctx context.Context, req *QueryReleaseHistoryRequest) (*QueryReleaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHistory not implemented")
+}
+func (*UnimplementedQueryServer) EmissionStats(ctx context.Context, req *QueryEmissionStatsRequest) (*QueryEmissionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -473,6 +752,42 @@ func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseHistory(ctx, req.(*QueryReleaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/EmissionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionStats(ctx, req.(*QueryEmissionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kiichain.rewards.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -489,6 +804,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RewardPool",
 			Handler:    _Query_RewardPool_Handler,
 		},
+		{
+			MethodName: "ReleaseHistory",
+			Handler:    _Query_ReleaseHistory_Handler,
+		},
+		{
+			MethodName: "EmissionStats",
+			Handler:    _Query_EmissionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kiichain/rewards/v1beta1/query.proto",
@@ -662,75 +985,315 @@ func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryReleaseHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryReleaseHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReleaseHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReleaseScheduleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReleaseHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReleaseScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ReleaseSchedule.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryReleaseHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRewardPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryReleaseHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.RewardPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalBondedTokens.Size()
+		i -= size
+		if _, err := m.TotalBondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.AnnualEmission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RemainingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalReleased.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryReleaseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleaseSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmissionStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEmissionStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalReleased.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalBondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func sovQuery(x uint64) (n int) {
@@ -1138,6 +1701,479 @@ func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryReleaseHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ReleaseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReleased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalReleased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,41 @@
+package types
+
+import (
+	fmt "fmt"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is used to annualize the emission of the release schedule
+const SecondsPerYear = 365 * 24 * 60 * 60
+
+// NewReleaseRecord returns a new release record
+func NewReleaseRecord(height int64, blockTime time.Time, amount sdk.Coin) ReleaseRecord {
+	return ReleaseRecord{
+		Height: height,
+		Time:   blockTime,
+		Amount: amount,
+	}
+}
+
+// ValidateReleaseHistory validates the release history for a genesis state
+func ValidateReleaseHistory(history []ReleaseRecord, maxHistory uint64) error {
+	if uint64(len(history)) > maxHistory {
+		return fmt.Errorf("release history has %d records, more than the max of %d", len(history), maxHistory)
+	}
+
+	lastHeight := int64(0)
+	for _, record := range history {
+		if record.Height <= lastHeight {
+			return fmt.Errorf("release history heights must be positive and increasing, got %d after %d",
+				record.Height, lastHeight)
+		}
+		if err := record.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid release record amount: %w", err)
+		}
+		lastHeight = record.Height
+	}
+
+	return nil
+}
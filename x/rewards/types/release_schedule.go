@@ -15,19 +15,41 @@ func InitialReleaseSchedule() ReleaseSchedule {
 		EndTime:         time.Time{},
 		LastReleaseTime: time.Time{},
 		Active:          false,
+		PausedTime:      time.Time{},
 	}
 }
 
-// ValidateGenesis validates the release schedule for a genesis state
+// ValidateGenesis validates the release schedule for a genesis state.
+// It is stateless and never looks at the local clock, so the same genesis
+// always validates the same way when replayed
 func (rr ReleaseSchedule) ValidateGenesis() error {
-	// Validate EndTime (zero time is allowed for genesis)
-	if !rr.EndTime.IsZero() && rr.EndTime.Before(time.Now()) {
-		return fmt.Errorf("end time %s cannot be in the past", rr.EndTime.String())
+	// Validate heights
+	if rr.EndHeight < 0 || rr.LastReleaseHeight < 0 || rr.PausedHeight < 0 {
+		return fmt.Errorf("release heights cannot be negative")
 	}
 
-	// Validate LastReleaseTime
-	if rr.LastReleaseTime.After(time.Now()) {
-		return fmt.Errorf("last release time %s cannot be in the future", rr.EndTime.String())
+	// Height based schedules can't mix in the release times
+	if rr.IsHeightBased() {
+		if !rr.EndTime.IsZero() || !rr.LastReleaseTime.IsZero() {
+			return fmt.Errorf("height based release cannot have end time or last release time")
+		}
+		if rr.LastReleaseHeight > rr.EndHeight {
+			return fmt.Errorf("last release height %d cannot be after end height %d",
+				rr.LastReleaseHeight, rr.EndHeight)
+		}
+	} else {
+		if rr.LastReleaseHeight != 0 {
+			return fmt.Errorf("time based release cannot have a last release height")
+		}
+		if !rr.EndTime.IsZero() && rr.LastReleaseTime.After(rr.EndTime) {
+			return fmt.Errorf("last release time %s cannot be after end time %s",
+				rr.LastReleaseTime.String(), rr.EndTime.String())
+		}
+	}
+
+	// A paused schedule is never active
+	if rr.IsPaused() && rr.Active {
+		return fmt.Errorf("paused release schedule cannot be active")
 	}
 
 	// Some validations just make sense if active
@@ -39,8 +61,8 @@ func (rr ReleaseSchedule) ValidateGenesis() error {
 		if rr.TotalAmount.IsZero() {
 			return fmt.Errorf("active reward releaser cannot have zero total amount")
 		}
-		if rr.EndTime.IsZero() {
-			return fmt.Errorf("active reward releaser must have an end time")
+		if rr.EndTime.IsZero() && !rr.IsHeightBased() {
+			return fmt.Errorf("active reward releaser must have an end time or end height")
 		}
 		// Validate ReleasedAmount if not zero
 		if !rr.ReleasedAmount.IsZero() {
@@ -62,3 +84,110 @@ func (rr ReleaseSchedule) ValidateGenesis() error {
 	}
 	return nil
 }
+
+// IsHeightBased returns true if the release schedule is denominated in block heights
+func (rr ReleaseSchedule) IsHeightBased() bool {
+	return rr.EndHeight != 0
+}
+
+// IsPaused returns true if the release schedule is currently paused
+func (rr ReleaseSchedule) IsPaused() bool {
+	return !rr.PausedTime.IsZero() || rr.PausedHeight != 0
+}
+
+// HasReleased returns true if the first release iteration already happened
+func (rr ReleaseSchedule) HasReleased() bool {
+	if rr.IsHeightBased() {
+		return rr.LastReleaseHeight != 0
+	}
+	return !rr.LastReleaseTime.IsZero()
+}
+
+// MarkReleased sets the last release to the given block, using the height or
+// the time depending on the schedule type
+func (rr ReleaseSchedule) MarkReleased(blockTime time.Time, blockHeight int64) ReleaseSchedule {
+	if rr.IsHeightBased() {
+		rr.LastReleaseHeight = blockHeight
+	} else {
+		rr.LastReleaseTime = blockTime
+	}
+	return rr
+}
+
+// Pause stops the release at the given block, keeping track of when it was paused
+// so the schedule can be shifted on resume
+func (rr ReleaseSchedule) Pause(pauseTime time.Time, pauseHeight int64) (ReleaseSchedule, error) {
+	if rr.IsPaused() {
+		if rr.IsHeightBased() {
+			return rr, fmt.Errorf("release schedule is already paused since height %d", rr.PausedHeight)
+		}
+		return rr, fmt.Errorf("release schedule is already paused since %s", rr.PausedTime)
+	}
+	if !rr.Active {
+		return rr, fmt.Errorf("release schedule is not active")
+	}
+
+	rr.Active = false
+	rr.PausedTime = pauseTime
+	rr.PausedHeight = pauseHeight
+	return rr, nil
+}
+
+// Resume restarts a paused release, shifting the last release and end by the
+// paused duration (or paused blocks for height based schedules) so no catch-up
+// release happens for the time spent paused
+func (rr ReleaseSchedule) Resume(resumeTime time.Time, resumeHeight int64) (ReleaseSchedule, error) {
+	if !rr.IsPaused() {
+		return rr, fmt.Errorf("release schedule is not paused")
+	}
+	if resumeTime.Before(rr.PausedTime) {
+		return rr, fmt.Errorf("resume time %s cannot be before paused time %s", resumeTime, rr.PausedTime)
+	}
+	if resumeHeight < rr.PausedHeight {
+		return rr, fmt.Errorf("resume height %d cannot be before paused height %d", resumeHeight, rr.PausedHeight)
+	}
+
+	if rr.IsHeightBased() {
+		pausedBlocks := resumeHeight - rr.PausedHeight
+
+		// A zero last release height means the first iteration never happened, keep it as is
+		if rr.LastReleaseHeight != 0 {
+			rr.LastReleaseHeight += pausedBlocks
+		}
+		rr.EndHeight += pausedBlocks
+	} else {
+		pausedDuration := resumeTime.Sub(rr.PausedTime)
+
+		// A zero last release time means the first iteration never happened, keep it as is
+		if !rr.LastReleaseTime.IsZero() {
+			rr.LastReleaseTime = rr.LastReleaseTime.Add(pausedDuration)
+		}
+		rr.EndTime = rr.EndTime.Add(pausedDuration)
+	}
+
+	rr.PausedTime = time.Time{}
+	rr.PausedHeight = 0
+	rr.Active = true
+	return rr, nil
+}
+
+// Terminate permanently ends the release at the given block. The total amount is
+// capped to what was already released, so the remaining funds stay in the pool
+func (rr ReleaseSchedule) Terminate(terminateTime time.Time, terminateHeight int64) (ReleaseSchedule, error) {
+	if !rr.Active && !rr.IsPaused() {
+		return rr, fmt.Errorf("release schedule is not active")
+	}
+
+	rr.TotalAmount = rr.ReleasedAmount
+	if rr.IsHeightBased() {
+		rr.EndHeight = terminateHeight
+		rr.LastReleaseHeight = terminateHeight
+	} else {
+		rr.EndTime = terminateTime
+		rr.LastReleaseTime = terminateTime
+	}
+	rr.PausedTime = time.Time{}
+	rr.PausedHeight = 0
+	rr.Active = false
+	return rr, nil
+}
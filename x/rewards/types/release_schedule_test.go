@@ -89,28 +89,85 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 			errMsg:  "cannot be greater than total amount",
 		},
 		{
-			name: "end time in past",
+			name: "end time in past - valid, genesis never looks at the local clock",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     validCoin,
+				ReleasedAmount:  sdk.NewCoin("akii", math.ZeroInt()),
+				EndTime:         time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				LastReleaseTime: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				Active:          true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "last release after end time",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
 				ReleasedAmount:  sdk.Coin{},
-				EndTime:         now.Add(-time.Hour * 24),
-				LastReleaseTime: time.Time{},
+				EndTime:         now,
+				LastReleaseTime: now.Add(time.Hour * 24),
 				Active:          false,
 			},
 			wantErr: true,
-			errMsg:  "cannot be in the past",
+			errMsg:  "cannot be after end time",
 		},
 		{
-			name: "last release in future",
+			name: "valid height based release",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       validCoin,
+				ReleasedAmount:    sdk.NewCoin("akii", math.NewInt(500)),
+				EndHeight:         1000,
+				LastReleaseHeight: 100,
+				Active:            true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "height based release with times",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
 				ReleasedAmount:  sdk.Coin{},
-				EndTime:         time.Time{},
-				LastReleaseTime: now.Add(time.Hour * 24),
-				Active:          false,
+				EndHeight:       1000,
+				LastReleaseTime: now,
+				Active:          true,
+			},
+			wantErr: true,
+			errMsg:  "cannot have end time or last release time",
+		},
+		{
+			name: "last release height after end height",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       validCoin,
+				ReleasedAmount:    sdk.Coin{},
+				EndHeight:         1000,
+				LastReleaseHeight: 1001,
+				Active:            true,
+			},
+			wantErr: true,
+			errMsg:  "cannot be after end height",
+		},
+		{
+			name: "time based release with last release height",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       validCoin,
+				ReleasedAmount:    sdk.Coin{},
+				EndTime:           now,
+				LastReleaseHeight: 10,
+				Active:            true,
 			},
 			wantErr: true,
-			errMsg:  "cannot be in the future",
+			errMsg:  "cannot have a last release height",
+		},
+		{
+			name: "negative end height",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.Coin{},
+				EndHeight:      -1,
+				Active:         true,
+			},
+			wantErr: true,
+			errMsg:  "cannot be negative",
 		},
 		{
 			name: "active with zero total",
@@ -134,7 +191,20 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 				Active:          true,
 			},
 			wantErr: true,
-			errMsg:  "must have an end time",
+			errMsg:  "must have an end time or end height",
+		},
+		{
+			name: "paused and active",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     validCoin,
+				ReleasedAmount:  sdk.Coin{},
+				EndTime:         now.Add(time.Hour * 24),
+				LastReleaseTime: time.Time{},
+				Active:          true,
+				PausedTime:      now,
+			},
+			wantErr: true,
+			errMsg:  "paused release schedule cannot be active",
 		},
 	}
 
@@ -152,3 +222,146 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseSchedulePauseResume(t *testing.T) {
+	now := time.Now().UTC()
+	schedule := types.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(100)),
+		EndTime:         now.Add(time.Hour),
+		LastReleaseTime: now,
+		Active:          true,
+	}
+
+	// Resume is not possible before pausing
+	_, err := schedule.Resume(now, 1)
+	require.ErrorContains(t, err, "not paused")
+
+	// Pause ten minutes after the last release
+	pauseTime := now.Add(10 * time.Minute)
+	paused, err := schedule.Pause(pauseTime, 10)
+	require.NoError(t, err)
+	require.False(t, paused.Active)
+	require.True(t, paused.IsPaused())
+	require.Equal(t, pauseTime, paused.PausedTime)
+
+	// Pausing twice fails
+	_, err = paused.Pause(pauseTime, 10)
+	require.ErrorContains(t, err, "already paused since "+pauseTime.String())
+
+	// Resume can't go back in time
+	_, err = paused.Resume(now, 10)
+	require.ErrorContains(t, err, "cannot be before paused time")
+
+	// Resume after two hours paused, everything is shifted by the paused duration
+	resumeTime := pauseTime.Add(2 * time.Hour)
+	resumed, err := paused.Resume(resumeTime, 20)
+	require.NoError(t, err)
+	require.True(t, resumed.Active)
+	require.False(t, resumed.IsPaused())
+	require.Equal(t, schedule.LastReleaseTime.Add(2*time.Hour), resumed.LastReleaseTime)
+	require.Equal(t, schedule.EndTime.Add(2*time.Hour), resumed.EndTime)
+
+	// The reward right after resuming only covers the time elapsed before the pause
+	before, err := types.CalculateReward(pauseTime, schedule)
+	require.NoError(t, err)
+	after, err := types.CalculateReward(resumeTime, resumed)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	// A schedule that never released keeps a zero last release time
+	schedule.LastReleaseTime = time.Time{}
+	paused, err = schedule.Pause(pauseTime, 10)
+	require.NoError(t, err)
+	resumed, err = paused.Resume(resumeTime, 20)
+	require.NoError(t, err)
+	require.True(t, resumed.LastReleaseTime.IsZero())
+	require.Equal(t, schedule.EndTime.Add(2*time.Hour), resumed.EndTime)
+
+	// Inactive schedules can't be paused
+	schedule.Active = false
+	_, err = schedule.Pause(pauseTime, 10)
+	require.ErrorContains(t, err, "not active")
+}
+
+func TestReleaseScheduleTerminate(t *testing.T) {
+	now := time.Now().UTC()
+	schedule := types.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(100)),
+		EndTime:         now.Add(time.Hour),
+		LastReleaseTime: now,
+		Active:          true,
+	}
+
+	// Terminate an active schedule
+	terminated, err := schedule.Terminate(now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.False(t, terminated.Active)
+	require.Equal(t, schedule.ReleasedAmount, terminated.TotalAmount)
+	require.Equal(t, now.Add(time.Minute), terminated.EndTime)
+	require.Equal(t, now.Add(time.Minute), terminated.LastReleaseTime)
+
+	// Terminating twice fails
+	_, err = terminated.Terminate(now.Add(time.Minute), 10)
+	require.ErrorContains(t, err, "not active")
+
+	// A paused schedule can also be terminated
+	paused, err := schedule.Pause(now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	terminated, err = paused.Terminate(now.Add(time.Hour*2), 20)
+	require.NoError(t, err)
+	require.False(t, terminated.IsPaused())
+	require.False(t, terminated.Active)
+}
+
+func TestReleaseScheduleHeightBased(t *testing.T) {
+	now := time.Now().UTC()
+	schedule := types.ReleaseSchedule{
+		TotalAmount:       sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount:    sdk.NewCoin("akii", math.NewInt(100)),
+		EndHeight:         200,
+		LastReleaseHeight: 100,
+		Active:            true,
+	}
+	require.True(t, schedule.IsHeightBased())
+	require.True(t, schedule.HasReleased())
+
+	// Marking a release only moves the height
+	marked := schedule.MarkReleased(now, 150)
+	require.Equal(t, int64(150), marked.LastReleaseHeight)
+	require.True(t, marked.LastReleaseTime.IsZero())
+
+	// Pause at height 110 and resume at height 160, heights are shifted by 50 blocks
+	paused, err := schedule.Pause(now, 110)
+	require.NoError(t, err)
+	require.True(t, paused.IsPaused())
+
+	_, err = paused.Pause(now, 120)
+	require.ErrorContains(t, err, "already paused since height 110")
+
+	_, err = paused.Resume(now, 100)
+	require.ErrorContains(t, err, "cannot be before paused height")
+
+	resumed, err := paused.Resume(now.Add(time.Hour), 160)
+	require.NoError(t, err)
+	require.False(t, resumed.IsPaused())
+	require.Equal(t, int64(150), resumed.LastReleaseHeight)
+	require.Equal(t, int64(250), resumed.EndHeight)
+	require.True(t, resumed.EndTime.IsZero())
+
+	// The release right after resuming only covers the blocks before the pause
+	before, err := types.CalculateRewardAtHeight(110, schedule)
+	require.NoError(t, err)
+	after, err := types.CalculateRewardAtHeight(160, resumed)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	// Terminate moves the heights and keeps the times empty
+	terminated, err := resumed.Terminate(now, 170)
+	require.NoError(t, err)
+	require.Equal(t, int64(170), terminated.EndHeight)
+	require.Equal(t, int64(170), terminated.LastReleaseHeight)
+	require.True(t, terminated.EndTime.IsZero())
+	require.NoError(t, terminated.ValidateGenesis())
+}
@@ -9,6 +9,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// CalculateScheduleReward figures what amt to be released in the current block, using
+// the block height for height based schedules and the block time otherwise
+func CalculateScheduleReward(blockTime time.Time, blockHeight int64, schedule ReleaseSchedule) (sdk.Coin, error) {
+	if schedule.IsHeightBased() {
+		return CalculateRewardAtHeight(blockHeight, schedule)
+	}
+	return CalculateReward(blockTime, schedule)
+}
+
 // CalculateReward figures what amt to be released in the current block
 // Assumes invalid values are cleared before calling, does not handle invalid blockTime/no last release
 func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, error) {
@@ -27,18 +36,36 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 	timeElapsedStamp := blockTime.Sub(schedule.LastReleaseTime)          // Time since last release
 	totalDurationStamp := schedule.EndTime.Sub(schedule.LastReleaseTime) // Remaining release period
 
-	// Convert to big int, using truncated seconds
-	timeElapsed, err := math.NewDecFromInt64(int64(timeElapsedStamp.Seconds())).BigInt()
-	if err != nil {
-		return sdk.Coin{}, err
+	// Use truncated seconds
+	return linearRelease(remaining, int64(timeElapsedStamp.Seconds()), int64(totalDurationStamp.Seconds())), nil
+}
+
+// CalculateRewardAtHeight figures what amt to be released in the current block for
+// a height based schedule
+// Assumes invalid values are cleared before calling, does not handle no last release
+func CalculateRewardAtHeight(blockHeight int64, schedule ReleaseSchedule) (sdk.Coin, error) {
+	// Calculate remaining amount
+	remaining := schedule.TotalAmount.Sub(schedule.ReleasedAmount)
+	if remaining.IsZero() {
+		return remaining, nil
 	}
-	totalDuration, err := math.NewDecFromInt64(int64(totalDurationStamp.Seconds())).BigInt()
-	if err != nil {
-		return sdk.Coin{}, err
+
+	// If total duration would be 0, there would be a div by 0
+	if schedule.EndHeight == schedule.LastReleaseHeight {
+		return sdk.Coin{}, fmt.Errorf("end height is equal to last release and would do a division by 0. EndHeight: %d", schedule.EndHeight)
 	}
 
+	// Blocks since last release and remaining release period
+	blocksElapsed := blockHeight - schedule.LastReleaseHeight
+	totalBlocks := schedule.EndHeight - schedule.LastReleaseHeight
+
+	return linearRelease(remaining, blocksElapsed, totalBlocks), nil
+}
+
+// linearRelease releases the elapsed proportion of the remaining amount
+func linearRelease(remaining sdk.Coin, elapsed, total int64) sdk.Coin {
 	// Calculate linear release proportion between 0 and 1
-	releaseProportion := math.LegacyNewDecFromBigInt(timeElapsed).Quo(math.LegacyNewDecFromBigInt(totalDuration))
+	releaseProportion := math.LegacyNewDec(elapsed).Quo(math.LegacyNewDec(total))
 	// Truncate to int, it will be a coin amt after all
 	amountToRelease := math.LegacyNewDecFromInt(remaining.Amount).Mul(releaseProportion).TruncateInt()
 
@@ -47,5 +74,49 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 	// Cap at remaining amount
 	amountToRelease = math.MinInt(amountToRelease, remaining.Amount)
 
-	return sdk.NewCoin(schedule.TotalAmount.Denom, amountToRelease), nil
+	return sdk.NewCoin(remaining.Denom, amountToRelease)
+}
+
+// CalculateAnnualEmission estimates the amount the schedule would release over a year
+// at its current linear rate. Height based schedules need the average block time to
+// be converted, a zero average block time makes them emit nothing.
+// Inactive or finished schedules emit nothing
+func CalculateAnnualEmission(blockTime time.Time, blockHeight int64, avgBlockTime time.Duration, schedule ReleaseSchedule) math.Int {
+	if !schedule.Active || schedule.TotalAmount.IsNil() || schedule.ReleasedAmount.IsNil() {
+		return math.ZeroInt()
+	}
+
+	remaining := schedule.TotalAmount.Amount.Sub(schedule.ReleasedAmount.Amount)
+	if !remaining.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	// Height based schedules are converted to time using the average block time
+	if schedule.IsHeightBased() {
+		// The release starts counting from the last release, or now if it never happened
+		start := schedule.LastReleaseHeight
+		if start == 0 {
+			start = blockHeight
+		}
+		blocks := schedule.EndHeight - start
+		if blocks <= 0 || avgBlockTime <= 0 {
+			return math.ZeroInt()
+		}
+
+		yearNanos := math.NewInt(SecondsPerYear).Mul(math.NewInt(int64(time.Second)))
+		durationNanos := math.NewInt(blocks).Mul(math.NewInt(avgBlockTime.Nanoseconds()))
+		return remaining.Mul(yearNanos).Quo(durationNanos)
+	}
+
+	// The release starts counting from the last release, or now if it never happened
+	start := schedule.LastReleaseTime
+	if start.IsZero() {
+		start = blockTime
+	}
+	duration := int64(schedule.EndTime.Sub(start).Seconds())
+	if duration <= 0 {
+		return math.ZeroInt()
+	}
+
+	return remaining.MulRaw(SecondsPerYear).QuoRaw(duration)
 }
@@ -136,3 +136,125 @@ func TestCalculateReward(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateRewardAtHeight(t *testing.T) {
+	denom := "akii"
+
+	tests := []struct {
+		name          string
+		blockHeight   int64
+		schedule      types.ReleaseSchedule
+		expectedCoin  sdk.Coin
+		expectedError bool
+	}{
+		{
+			name:        "nothing left to release",
+			blockHeight: 150,
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:    sdk.NewCoin(denom, math.NewInt(1000)),
+				LastReleaseHeight: 100,
+				EndHeight:         200,
+				Active:            true,
+			},
+			expectedCoin: sdk.NewCoin(denom, math.ZeroInt()),
+		},
+		{
+			name:        "linear release - halfway",
+			blockHeight: 150,
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:    sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseHeight: 100,
+				EndHeight:         200,
+				Active:            true,
+			},
+			expectedCoin: sdk.NewCoin(denom, math.NewInt(500)),
+		},
+		{
+			name:        "single block with small fraction - at least one coin",
+			blockHeight: 101,
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       sdk.NewCoin(denom, math.NewInt(10)),
+				ReleasedAmount:    sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseHeight: 100,
+				EndHeight:         10000,
+				Active:            true,
+			},
+			expectedCoin: sdk.NewCoin(denom, math.NewInt(1)),
+		},
+		{
+			name:        "last release (past end height)",
+			blockHeight: 300,
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:    sdk.NewCoin(denom, math.NewInt(800)),
+				LastReleaseHeight: 100,
+				EndHeight:         200,
+				Active:            true,
+			},
+			expectedCoin: sdk.NewCoin(denom, math.NewInt(200)), // Cap at remaining 200
+		},
+		{
+			name:        "invalid - end height equal last release",
+			blockHeight: 300,
+			schedule: types.ReleaseSchedule{
+				TotalAmount:       sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:    sdk.NewCoin(denom, math.NewInt(800)),
+				LastReleaseHeight: 200,
+				EndHeight:         200,
+				Active:            true,
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The block time must never matter for height based schedules
+			for _, blockTime := range []time.Time{time.Unix(0, 0), time.Now()} {
+				result, err := types.CalculateScheduleReward(blockTime, tt.blockHeight, tt.schedule)
+				if tt.expectedError {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, tt.expectedCoin.Denom, result.Denom)
+					require.True(t, tt.expectedCoin.Amount.Equal(result.Amount))
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateAnnualEmission(t *testing.T) {
+	now := time.Now().UTC()
+	denom := "akii"
+	halfYear := time.Duration(types.SecondsPerYear/2) * time.Second
+
+	// Time based, 1000 left over half a year
+	timeSchedule := types.ReleaseSchedule{
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(1500)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(500)),
+		LastReleaseTime: now,
+		EndTime:         now.Add(halfYear),
+		Active:          true,
+	}
+	require.Equal(t, math.NewInt(2000), types.CalculateAnnualEmission(now, 1, 0, timeSchedule))
+
+	// Height based, 1000 left over 1000 blocks of half a year in total
+	heightSchedule := types.ReleaseSchedule{
+		TotalAmount:       sdk.NewCoin(denom, math.NewInt(1500)),
+		ReleasedAmount:    sdk.NewCoin(denom, math.NewInt(500)),
+		LastReleaseHeight: 1000,
+		EndHeight:         2000,
+		Active:            true,
+	}
+	require.Equal(t, math.NewInt(2000), types.CalculateAnnualEmission(now, 1000, halfYear/1000, heightSchedule))
+
+	// Without an average block time height based schedules can't be estimated
+	require.True(t, types.CalculateAnnualEmission(now, 1000, 0, heightSchedule).IsZero())
+
+	// Inactive schedules emit nothing
+	timeSchedule.Active = false
+	require.True(t, types.CalculateAnnualEmission(now, 1, 0, timeSchedule).IsZero())
+}
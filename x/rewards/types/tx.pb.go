@@ -315,6 +315,258 @@ func (m *MsgChangeScheduleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeScheduleResponse proto.InternalMessageInfo
 
+// MsgPauseSchedule is the Msg/PauseSchedule request type.
+type MsgPauseSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgPauseSchedule) Reset()         { *m = MsgPauseSchedule{} }
+func (m *MsgPauseSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseSchedule) ProtoMessage()    {}
+func (*MsgPauseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{6}
+}
+func (m *MsgPauseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseSchedule.Merge(m, src)
+}
+func (m *MsgPauseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseSchedule proto.InternalMessageInfo
+
+func (m *MsgPauseSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgPauseScheduleResponse defines the response structure for executing a
+// MsgPauseSchedule message.
+type MsgPauseScheduleResponse struct {
+}
+
+func (m *MsgPauseScheduleResponse) Reset()         { *m = MsgPauseScheduleResponse{} }
+func (m *MsgPauseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseScheduleResponse) ProtoMessage()    {}
+func (*MsgPauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{7}
+}
+func (m *MsgPauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseScheduleResponse.Merge(m, src)
+}
+func (m *MsgPauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseScheduleResponse proto.InternalMessageInfo
+
+// MsgResumeSchedule is the Msg/ResumeSchedule request type.
+type MsgResumeSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgResumeSchedule) Reset()         { *m = MsgResumeSchedule{} }
+func (m *MsgResumeSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeSchedule) ProtoMessage()    {}
+func (*MsgResumeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{8}
+}
+func (m *MsgResumeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeSchedule.Merge(m, src)
+}
+func (m *MsgResumeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeSchedule proto.InternalMessageInfo
+
+func (m *MsgResumeSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResumeScheduleResponse defines the response structure for executing a
+// MsgResumeSchedule message.
+type MsgResumeScheduleResponse struct {
+}
+
+func (m *MsgResumeScheduleResponse) Reset()         { *m = MsgResumeScheduleResponse{} }
+func (m *MsgResumeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeScheduleResponse) ProtoMessage()    {}
+func (*MsgResumeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{9}
+}
+func (m *MsgResumeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeScheduleResponse.Merge(m, src)
+}
+func (m *MsgResumeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeScheduleResponse proto.InternalMessageInfo
+
+// MsgTerminateSchedule is the Msg/TerminateSchedule request type.
+type MsgTerminateSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgTerminateSchedule) Reset()         { *m = MsgTerminateSchedule{} }
+func (m *MsgTerminateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateSchedule) ProtoMessage()    {}
+func (*MsgTerminateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{10}
+}
+func (m *MsgTerminateSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTerminateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateSchedule.Merge(m, src)
+}
+func (m *MsgTerminateSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTerminateSchedule proto.InternalMessageInfo
+
+func (m *MsgTerminateSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgTerminateScheduleResponse defines the response structure for executing a
+// MsgTerminateSchedule message.
+type MsgTerminateScheduleResponse struct {
+}
+
+func (m *MsgTerminateScheduleResponse) Reset()         { *m = MsgTerminateScheduleResponse{} }
+func (m *MsgTerminateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTerminateScheduleResponse) ProtoMessage()    {}
+func (*MsgTerminateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{11}
+}
+func (m *MsgTerminateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTerminateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTerminateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTerminateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTerminateScheduleResponse.Merge(m, src)
+}
+func (m *MsgTerminateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTerminateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTerminateScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTerminateScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgFundPool)(nil), "kiichain.rewards.v1beta1.MsgFundPool")
 	proto.RegisterType((*MsgFundPoolResponse)(nil), "kiichain.rewards.v1beta1.MsgFundPoolResponse")
@@ -322,51 +574,64 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.rewards.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgChangeSchedule)(nil), "kiichain.rewards.v1beta1.MsgChangeSchedule")
 	proto.RegisterType((*MsgChangeScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgChangeScheduleResponse")
+	proto.RegisterType((*MsgPauseSchedule)(nil), "kiichain.rewards.v1beta1.MsgPauseSchedule")
+	proto.RegisterType((*MsgPauseScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgPauseScheduleResponse")
+	proto.RegisterType((*MsgResumeSchedule)(nil), "kiichain.rewards.v1beta1.MsgResumeSchedule")
+	proto.RegisterType((*MsgResumeScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgResumeScheduleResponse")
+	proto.RegisterType((*MsgTerminateSchedule)(nil), "kiichain.rewards.v1beta1.MsgTerminateSchedule")
+	proto.RegisterType((*MsgTerminateScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgTerminateScheduleResponse")
 }
 
 func init() { proto.RegisterFile("kiichain/rewards/v1beta1/tx.proto", fileDescriptor_8e1e54764dba96cb) }
 
 var fileDescriptor_8e1e54764dba96cb = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0x02, 0x51, 0x7b, 0xe1, 0x8f, 0x62, 0x5a, 0x25, 0x31, 0x92, 0x93, 0x5a, 0x44,
-	0x4a, 0x02, 0xb1, 0x95, 0x56, 0x62, 0xc8, 0x80, 0x44, 0x2a, 0x75, 0x41, 0x91, 0x2a, 0x57, 0x48,
-	0x88, 0xa5, 0x5c, 0xec, 0xeb, 0xc5, 0xc2, 0xbe, 0xb3, 0x7c, 0xe7, 0xd2, 0x6c, 0x88, 0x91, 0x89,
-	0x99, 0x4f, 0x11, 0x09, 0xf8, 0x06, 0x0c, 0x1d, 0x23, 0x26, 0xa6, 0x0a, 0x25, 0x43, 0x76, 0x3e,
-	0x01, 0xb2, 0x7d, 0x76, 0xd2, 0x56, 0xa9, 0x0a, 0x4b, 0x62, 0xfb, 0x7e, 0xf7, 0x3e, 0xcf, 0xf3,
-	0xde, 0xe9, 0x05, 0xdb, 0xef, 0x1c, 0xc7, 0x1a, 0x42, 0x87, 0x18, 0x01, 0x7a, 0x0f, 0x03, 0x9b,
-	0x19, 0x27, 0x9d, 0x01, 0xe2, 0xb0, 0x63, 0xf0, 0x53, 0xdd, 0x0f, 0x28, 0xa7, 0x72, 0x39, 0x45,
-	0x74, 0x81, 0xe8, 0x02, 0x51, 0x36, 0x31, 0xc5, 0x34, 0x86, 0x8c, 0xe8, 0x29, 0xe1, 0x15, 0xd5,
-	0xa2, 0xcc, 0xa3, 0xcc, 0x18, 0x40, 0x86, 0xb2, 0x6a, 0x16, 0x75, 0x88, 0x58, 0xaf, 0xaf, 0x94,
-	0xf4, 0x61, 0x00, 0x3d, 0x26, 0xb0, 0xc7, 0xab, 0x9d, 0x8d, 0x7c, 0x94, 0x52, 0x55, 0x4c, 0x29,
-	0x76, 0x91, 0x11, 0xbf, 0x0d, 0xc2, 0x63, 0x83, 0x3b, 0x1e, 0x62, 0x1c, 0x7a, 0xbe, 0x00, 0x4a,
-	0xc2, 0x8d, 0xc7, 0xb0, 0x71, 0xd2, 0x89, 0xfe, 0xc4, 0x42, 0x25, 0x59, 0x38, 0x4a, 0xfc, 0x27,
-	0x2f, 0x62, 0xa9, 0x08, 0x3d, 0x87, 0x50, 0x23, 0xfe, 0x4d, 0x3e, 0x69, 0xdf, 0x25, 0x50, 0xe8,
-	0x33, 0xbc, 0x1f, 0x12, 0xfb, 0x80, 0x52, 0x57, 0x6e, 0x82, 0x3c, 0x43, 0xc4, 0x46, 0x41, 0x59,
-	0xaa, 0x49, 0x8d, 0x8d, 0x5e, 0xf1, 0xcf, 0x79, 0xf5, 0xde, 0x08, 0x7a, 0x6e, 0x57, 0x4b, 0xbe,
-	0x6b, 0xa6, 0x00, 0xe4, 0xd7, 0x20, 0x0f, 0x3d, 0x1a, 0x12, 0x5e, 0x5e, 0xab, 0x49, 0x8d, 0xc2,
-	0x4e, 0x45, 0x17, 0x62, 0x51, 0x83, 0xd2, 0x5e, 0xea, 0x7b, 0xd4, 0x21, 0xbd, 0xfa, 0xd9, 0x79,
-	0x35, 0xb7, 0xa8, 0x94, 0x6c, 0xd3, 0xbe, 0xcc, 0xc7, 0xad, 0x82, 0x8b, 0x30, 0xb4, 0x46, 0x47,
-	0x51, 0x1f, 0x4d, 0x51, 0xaf, 0xbb, 0xfd, 0x71, 0x3e, 0x6e, 0x09, 0x99, 0x4f, 0xf3, 0x71, 0xab,
-	0x98, 0x76, 0xea, 0x38, 0x24, 0x76, 0xdb, 0xa7, 0xd4, 0xd5, 0xb6, 0xc0, 0xc3, 0x25, 0xdb, 0x26,
-	0x62, 0x3e, 0x25, 0x0c, 0x69, 0x5f, 0x25, 0xf0, 0xa0, 0xcf, 0xf0, 0x2b, 0xdf, 0x86, 0x1c, 0x1d,
-	0xc4, 0x6d, 0x97, 0x9f, 0x81, 0x0d, 0x18, 0xf2, 0x21, 0x0d, 0x1c, 0x3e, 0x12, 0xa9, 0xca, 0x3f,
-	0xbf, 0xb5, 0x37, 0x85, 0xdb, 0x17, 0xb6, 0x1d, 0x20, 0xc6, 0x0e, 0x79, 0xe0, 0x10, 0x6c, 0x2e,
-	0x50, 0xf9, 0x39, 0xc8, 0x27, 0x07, 0x27, 0xf2, 0xd5, 0xf4, 0x55, 0x17, 0x46, 0x4f, 0x94, 0x7a,
-	0xb7, 0xa3, 0x98, 0xa6, 0xd8, 0xd5, 0x6d, 0x44, 0x29, 0x16, 0xf5, 0xa2, 0x20, 0x5b, 0x69, 0x90,
-	0x30, 0x36, 0xd8, 0x4e, 0x48, 0xad, 0x02, 0x4a, 0x97, 0x4c, 0x67, 0x81, 0x7e, 0x48, 0xa0, 0xd8,
-	0x67, 0x78, 0x6f, 0x08, 0x09, 0x46, 0x87, 0xd6, 0x10, 0xd9, 0xa1, 0x8b, 0xfe, 0x3b, 0xd2, 0x4b,
-	0xb0, 0xce, 0x44, 0x0d, 0x11, 0xaa, 0xb9, 0x3a, 0x94, 0x89, 0x5c, 0x04, 0x59, 0x26, 0x2a, 0xd2,
-	0x65, 0x05, 0xba, 0xad, 0xab, 0xf9, 0x4a, 0x69, 0x3e, 0x2b, 0xf6, 0xdb, 0x4e, 0x59, 0xed, 0x11,
-	0xa8, 0x5c, 0x49, 0x91, 0x66, 0xdc, 0x99, 0xac, 0x81, 0x5b, 0x7d, 0x86, 0xe5, 0xb7, 0x60, 0x3d,
-	0xbb, 0x87, 0xf5, 0xd5, 0xbe, 0x96, 0xce, 0x5d, 0x69, 0xdf, 0x08, 0x4b, 0x95, 0x64, 0x17, 0xdc,
-	0xbd, 0x70, 0x35, 0x9a, 0xd7, 0x6e, 0x5f, 0x46, 0x95, 0xce, 0x8d, 0xd1, 0x4c, 0x2d, 0x00, 0xf7,
-	0x2f, 0x9d, 0xdb, 0x93, 0x6b, 0x8b, 0x5c, 0x84, 0x95, 0xdd, 0x7f, 0x80, 0x53, 0x4d, 0xe5, 0xce,
-	0x87, 0xf9, 0xb8, 0x25, 0xf5, 0xf6, 0xcf, 0xa6, 0xaa, 0x34, 0x99, 0xaa, 0xd2, 0xef, 0xa9, 0x2a,
-	0x7d, 0x9e, 0xa9, 0xb9, 0xc9, 0x4c, 0xcd, 0xfd, 0x9a, 0xa9, 0xb9, 0x37, 0x4f, 0xb1, 0xc3, 0x87,
-	0xe1, 0x40, 0xb7, 0xa8, 0x67, 0x64, 0x93, 0x28, 0x7b, 0x38, 0xcd, 0x86, 0x52, 0x3c, 0x8c, 0x06,
-	0xf9, 0x78, 0x4a, 0xec, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x64, 0xcc, 0x15, 0x84, 0x4f, 0x05,
-	0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4d, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x60, 0xd5, 0xe6, 0x31, 0xa0, 0x65, 0x63, 0x6d, 0x40, 0xd9, 0x16, 0x31, 0x69,
+	0x2d, 0x34, 0x51, 0x37, 0x69, 0x87, 0x1e, 0x90, 0xe8, 0xa4, 0x5d, 0x50, 0xa5, 0x29, 0x03, 0x09,
+	0x71, 0x19, 0x6e, 0xe2, 0xb9, 0x11, 0x49, 0x1c, 0xc5, 0xc9, 0xb6, 0x0a, 0x21, 0x21, 0x8e, 0x9c,
+	0x38, 0xf3, 0x29, 0x2a, 0x5e, 0xbe, 0x01, 0x87, 0x1d, 0x27, 0x4e, 0x9c, 0x26, 0xb4, 0x1d, 0x7a,
+	0xe7, 0x13, 0xa0, 0x24, 0xb6, 0xfb, 0x46, 0xab, 0x8d, 0x5d, 0xda, 0xc4, 0xfe, 0xf9, 0xff, 0x3c,
+	0xcf, 0xbf, 0x76, 0x0d, 0x56, 0xde, 0xd8, 0xb6, 0xd9, 0x82, 0xb6, 0xa7, 0x07, 0xe8, 0x10, 0x06,
+	0x16, 0xd5, 0x0f, 0xaa, 0x4d, 0x14, 0xc2, 0xaa, 0x1e, 0x1e, 0x69, 0x7e, 0x40, 0x42, 0x92, 0x2f,
+	0x70, 0x44, 0x63, 0x88, 0xc6, 0x10, 0x79, 0x1e, 0x13, 0x4c, 0x12, 0x48, 0x8f, 0x9f, 0x52, 0x5e,
+	0x56, 0x4c, 0x42, 0x5d, 0x42, 0xf5, 0x26, 0xa4, 0x48, 0x54, 0x33, 0x89, 0xed, 0xb1, 0xf9, 0xd5,
+	0xb1, 0x92, 0x3e, 0x0c, 0xa0, 0x4b, 0x19, 0xf6, 0x70, 0xbc, 0xb3, 0xb6, 0x8f, 0x38, 0xb5, 0x84,
+	0x09, 0xc1, 0x0e, 0xd2, 0x93, 0xb7, 0x66, 0xb4, 0xaf, 0x87, 0xb6, 0x8b, 0x68, 0x08, 0x5d, 0x9f,
+	0x01, 0x8b, 0xcc, 0x8d, 0x4b, 0xb1, 0x7e, 0x50, 0x8d, 0xbf, 0xd8, 0x44, 0x31, 0x9d, 0xd8, 0x4b,
+	0xfd, 0xa7, 0x2f, 0x6c, 0x2a, 0x07, 0x5d, 0xdb, 0x23, 0x7a, 0xf2, 0x99, 0x0e, 0xa9, 0xdf, 0x25,
+	0x30, 0xdb, 0xa0, 0x78, 0x3b, 0xf2, 0xac, 0x1d, 0x42, 0x9c, 0x7c, 0x09, 0x64, 0x29, 0xf2, 0x2c,
+	0x14, 0x14, 0xa4, 0x65, 0x69, 0x6d, 0xa6, 0x9e, 0xfb, 0x73, 0xba, 0x34, 0xd7, 0x86, 0xae, 0x53,
+	0x53, 0xd3, 0x71, 0xd5, 0x60, 0x40, 0xfe, 0x25, 0xc8, 0x42, 0x97, 0x44, 0x5e, 0x58, 0xb8, 0xb6,
+	0x2c, 0xad, 0xcd, 0xae, 0x17, 0x35, 0x26, 0x16, 0x37, 0x88, 0xf7, 0x52, 0xdb, 0x22, 0xb6, 0x57,
+	0x5f, 0x3d, 0x3e, 0x5d, 0xca, 0xf4, 0x2a, 0xa5, 0xcb, 0xd4, 0xcf, 0xdd, 0x4e, 0x79, 0xd6, 0x41,
+	0x18, 0x9a, 0xed, 0xbd, 0xb8, 0x8f, 0x06, 0xab, 0x57, 0x5b, 0xf9, 0xd0, 0xed, 0x94, 0x99, 0xcc,
+	0xc7, 0x6e, 0xa7, 0x9c, 0xe3, 0x9d, 0xda, 0x8f, 0x3c, 0xab, 0xe2, 0x13, 0xe2, 0xa8, 0x0b, 0xe0,
+	0x6e, 0x9f, 0x6d, 0x03, 0x51, 0x9f, 0x78, 0x14, 0xa9, 0x5f, 0x25, 0x70, 0xbb, 0x41, 0xf1, 0x0b,
+	0xdf, 0x82, 0x21, 0xda, 0x49, 0xda, 0x9e, 0xdf, 0x04, 0x33, 0x30, 0x0a, 0x5b, 0x24, 0xb0, 0xc3,
+	0x36, 0x4b, 0x55, 0xf8, 0xf9, 0xad, 0x32, 0xcf, 0xdc, 0x3e, 0xb5, 0xac, 0x00, 0x51, 0xba, 0x1b,
+	0x06, 0xb6, 0x87, 0x8d, 0x1e, 0x9a, 0x7f, 0x02, 0xb2, 0xe9, 0x0f, 0xc7, 0xf2, 0x2d, 0x6b, 0xe3,
+	0x36, 0x8c, 0x96, 0x2a, 0xd5, 0x6f, 0xc4, 0x31, 0x0d, 0xb6, 0xaa, 0xb6, 0x16, 0xa7, 0xe8, 0xd5,
+	0x8b, 0x83, 0x2c, 0xf0, 0x20, 0x51, 0x62, 0xb0, 0x92, 0x92, 0x6a, 0x11, 0x2c, 0x0e, 0x99, 0x16,
+	0x81, 0x7e, 0x48, 0x20, 0xd7, 0xa0, 0x78, 0xab, 0x05, 0x3d, 0x8c, 0x76, 0xcd, 0x16, 0xb2, 0x22,
+	0x07, 0xfd, 0x77, 0xa4, 0x67, 0x60, 0x9a, 0xb2, 0x1a, 0x2c, 0x54, 0x69, 0x7c, 0x28, 0x03, 0x39,
+	0x08, 0x52, 0x21, 0xca, 0xd2, 0x89, 0x02, 0xb5, 0xf2, 0x68, 0xbe, 0x45, 0x9e, 0xcf, 0x4c, 0xfc,
+	0x56, 0x38, 0xab, 0xde, 0x07, 0xc5, 0x91, 0x14, 0x22, 0x63, 0x04, 0xee, 0x34, 0x28, 0xde, 0x81,
+	0x11, 0xbd, 0x72, 0xc2, 0x5a, 0x69, 0xd4, 0xd4, 0x3d, 0x6e, 0xca, 0x8f, 0x15, 0x7a, 0x9e, 0x64,
+	0x50, 0x18, 0x96, 0x15, 0x96, 0x0e, 0x93, 0xae, 0x1b, 0x88, 0x46, 0xee, 0xd5, 0x3d, 0x4d, 0x6a,
+	0x54, 0x90, 0x48, 0x0c, 0x37, 0x6a, 0x50, 0x58, 0xb8, 0x7a, 0x07, 0xe6, 0x1b, 0x14, 0x3f, 0x47,
+	0x81, 0x6b, 0x7b, 0x30, 0xbc, 0xba, 0xb1, 0xca, 0xa8, 0x31, 0x99, 0x1b, 0x0b, 0xb9, 0x4a, 0xcf,
+	0x9b, 0x02, 0x1e, 0xfc, 0x4b, 0x9e, 0xdb, 0x5b, 0xff, 0x32, 0x05, 0xae, 0x37, 0x28, 0xce, 0xbf,
+	0x06, 0xd3, 0xe2, 0xff, 0x64, 0x75, 0xfc, 0xfe, 0xea, 0x3b, 0xbf, 0x72, 0xe5, 0x42, 0x18, 0x57,
+	0xca, 0x3b, 0xe0, 0xe6, 0xc0, 0x11, 0x2f, 0x4d, 0x5c, 0xde, 0x8f, 0xca, 0xd5, 0x0b, 0xa3, 0x42,
+	0x2d, 0x00, 0xb7, 0x86, 0xce, 0xdf, 0xa3, 0x89, 0x45, 0x06, 0x61, 0x79, 0xe3, 0x12, 0xb0, 0xd0,
+	0x24, 0x60, 0x6e, 0xf0, 0x40, 0x94, 0x27, 0x56, 0x19, 0x60, 0xe5, 0xf5, 0x8b, 0xb3, 0xfd, 0x21,
+	0x87, 0xb6, 0xfb, 0xe4, 0x90, 0x83, 0xb0, 0xbc, 0x71, 0x09, 0x58, 0x68, 0xbe, 0x05, 0xb9, 0xd1,
+	0xcd, 0xac, 0x4d, 0xac, 0x34, 0xc2, 0xcb, 0x9b, 0x97, 0xe3, 0xb9, 0xb8, 0x3c, 0xf5, 0xbe, 0xdb,
+	0x29, 0x4b, 0xf5, 0xed, 0xe3, 0x33, 0x45, 0x3a, 0x39, 0x53, 0xa4, 0xdf, 0x67, 0x8a, 0xf4, 0xe9,
+	0x5c, 0xc9, 0x9c, 0x9c, 0x2b, 0x99, 0x5f, 0xe7, 0x4a, 0xe6, 0xd5, 0x63, 0x6c, 0x87, 0xad, 0xa8,
+	0xa9, 0x99, 0xc4, 0xd5, 0xc5, 0x9d, 0x2d, 0x1e, 0x8e, 0xc4, 0xf5, 0x9d, 0x5c, 0xdb, 0xcd, 0x6c,
+	0x72, 0x9f, 0x6e, 0xfc, 0x1d, 0x00, 0xba, 0x54, 0xf6, 0x86, 0x79, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -391,6 +656,15 @@ type MsgClient interface {
 	// ChangeSchedule defines a governance operation for changing the reward and
 	// its schedule
 	ChangeSchedule(ctx context.Context, in *MsgChangeSchedule, opts ...grpc.CallOption) (*MsgChangeScheduleResponse, error)
+	// PauseSchedule defines a governance operation for pausing the current
+	// release schedule
+	PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error)
+	// ResumeSchedule defines a governance operation for resuming a paused
+	// release schedule
+	ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error)
+	// TerminateSchedule defines a governance operation for permanently ending
+	// the current release schedule
+	TerminateSchedule(ctx context.Context, in *MsgTerminateSchedule, opts ...grpc.CallOption) (*MsgTerminateScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -428,6 +702,33 @@ func (c *msgClient) ChangeSchedule(ctx context.Context, in *MsgChangeSchedule, o
 	return out, nil
 }
 
+func (c *msgClient) PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error) {
+	out := new(MsgPauseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/PauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error) {
+	out := new(MsgResumeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/ResumeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TerminateSchedule(ctx context.Context, in *MsgTerminateSchedule, opts ...grpc.CallOption) (*MsgTerminateScheduleResponse, error) {
+	out := new(MsgTerminateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/TerminateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FundPool adds funds to the community pool that can be used on a extension
@@ -440,6 +741,15 @@ type MsgServer interface {
 	// ChangeSchedule defines a governance operation for changing the reward and
 	// its schedule
 	ChangeSchedule(context.Context, *MsgChangeSchedule) (*MsgChangeScheduleResponse, error)
+	// PauseSchedule defines a governance operation for pausing the current
+	// release schedule
+	PauseSchedule(context.Context, *MsgPauseSchedule) (*MsgPauseScheduleResponse, error)
+	// ResumeSchedule defines a governance operation for resuming a paused
+	// release schedule
+	ResumeSchedule(context.Context, *MsgResumeSchedule) (*MsgResumeScheduleResponse, error)
+	// TerminateSchedule defines a governance operation for permanently ending
+	// the current release schedule
+	TerminateSchedule(context.Context, *MsgTerminateSchedule) (*MsgTerminateScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -455,6 +765,15 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) ChangeSchedule(ctx context.Context, req *MsgChangeSchedule) (*MsgChangeScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeSchedule not implemented")
 }
+func (*UnimplementedMsgServer) PauseSchedule(ctx context.Context, req *MsgPauseSchedule) (*MsgPauseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ResumeSchedule(ctx context.Context, req *MsgResumeSchedule) (*MsgResumeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
+func (*UnimplementedMsgServer) TerminateSchedule(ctx context.Context, req *MsgTerminateSchedule) (*MsgTerminateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -514,6 +833,60 @@ func _Msg_ChangeSchedule_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/PauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseSchedule(ctx, req.(*MsgPauseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/ResumeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeSchedule(ctx, req.(*MsgResumeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TerminateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTerminateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TerminateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/TerminateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TerminateSchedule(ctx, req.(*MsgTerminateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kiichain.rewards.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -530,6 +903,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeSchedule",
 			Handler:    _Msg_ChangeSchedule_Handler,
 		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _Msg_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _Msg_ResumeSchedule_Handler,
+		},
+		{
+			MethodName: "TerminateSchedule",
+			Handler:    _Msg_TerminateSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kiichain/rewards/v1beta1/tx.proto",
@@ -724,33 +1109,192 @@ func (m *MsgChangeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPauseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgPauseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFundPoolResponse) Size() (n int) {
+func (m *MsgPauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTerminateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTerminateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTerminateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundPoolResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -778,42 +1322,438 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgChangeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgChangeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTerminateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTerminateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgChangeSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Schedule.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgChangeScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +1776,15 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,11 +1812,11 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -903,7 +1843,7 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -928,7 +1868,7 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -951,10 +1891,10 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -978,7 +1918,7 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,10 +1941,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1039,39 +1979,6 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1093,7 +2000,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1116,10 +2023,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1143,7 +2050,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1166,10 +2073,10 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1204,11 +2111,111 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTerminateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTerminateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTerminateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1218,24 +2225,23 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1258,7 +2264,7 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTerminateScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1281,10 +2287,10 @@ func (m *MsgChangeScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTerminateScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTerminateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -42,6 +42,15 @@ type ReleaseSchedule struct {
 	LastReleaseTime time.Time `protobuf:"bytes,5,opt,name=last_release_time,json=lastReleaseTime,proto3,stdtime" json:"last_release_time" yaml:"last_release_time"`
 	// If reward pool is active
 	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
+	// Timestamp at which the release was paused, zero if not paused
+	PausedTime time.Time `protobuf:"bytes,7,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time" yaml:"paused_time"`
+	// Height of end of release, if set the schedule is height based and the
+	// end and last release times must be empty
+	EndHeight int64 `protobuf:"varint,8,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// Last height released, only used by height based schedules
+	LastReleaseHeight int64 `protobuf:"varint,9,opt,name=last_release_height,json=lastReleaseHeight,proto3" json:"last_release_height,omitempty" yaml:"last_release_height"`
+	// Height at which the release was paused, zero if not paused
+	PausedHeight int64 `protobuf:"varint,10,opt,name=paused_height,json=pausedHeight,proto3" json:"paused_height,omitempty" yaml:"paused_height"`
 }
 
 func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
@@ -112,6 +121,34 @@ func (m *ReleaseSchedule) GetActive() bool {
 	return false
 }
 
+func (m *ReleaseSchedule) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetLastReleaseHeight() int64 {
+	if m != nil {
+		return m.LastReleaseHeight
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetPausedHeight() int64 {
+	if m != nil {
+		return m.PausedHeight
+	}
+	return 0
+}
+
 // RewardPool is the global fee pool for distribution.
 type RewardPool struct {
 	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool"`
@@ -157,9 +194,74 @@ func (m *RewardPool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
+// ReleaseRecord keeps track of a single release from the reward pool
+type ReleaseRecord struct {
+	// Height of the release
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// Block time of the release
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// Amount released
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount" yaml:"amount"`
+}
+
+func (m *ReleaseRecord) Reset()         { *m = ReleaseRecord{} }
+func (m *ReleaseRecord) String() string { return proto.CompactTextString(m) }
+func (*ReleaseRecord) ProtoMessage()    {}
+func (*ReleaseRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{2}
+}
+func (m *ReleaseRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseRecord.Merge(m, src)
+}
+func (m *ReleaseRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseRecord proto.InternalMessageInfo
+
+func (m *ReleaseRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReleaseRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*ReleaseSchedule)(nil), "kiichain.rewards.v1beta1.ReleaseSchedule")
 	proto.RegisterType((*RewardPool)(nil), "kiichain.rewards.v1beta1.RewardPool")
+	proto.RegisterType((*ReleaseRecord)(nil), "kiichain.rewards.v1beta1.ReleaseRecord")
 }
 
 func init() {
@@ -167,38 +269,48 @@ func init() {
 }
 
 var fileDescriptor_890c6773eb163743 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0x5f, 0xf5, 0x2b, 0x27, 0x97, 0xbb, 0xaa, 0x01, 0xa1, 0x50, 0x50, 0x52, 0x45,
-	0x37, 0x94, 0x7f, 0x8e, 0xee, 0x58, 0x10, 0x1b, 0x05, 0x31, 0xa3, 0xc0, 0x02, 0x4b, 0xe5, 0x24,
-	0x26, 0xb5, 0xce, 0xce, 0x53, 0xd5, 0xce, 0x41, 0x07, 0x5e, 0x01, 0xcb, 0xbd, 0x0c, 0xc4, 0xc4,
-	0x1b, 0x60, 0xbf, 0xf1, 0x46, 0xa6, 0x1e, 0x6a, 0x07, 0xf6, 0x7b, 0x05, 0x28, 0xb6, 0x13, 0x9d,
-	0x00, 0xe9, 0x96, 0xc4, 0x7e, 0xf4, 0x7c, 0x3f, 0xdf, 0x27, 0xdf, 0xd8, 0x78, 0xff, 0x88, 0xf3,
-	0x6c, 0x4e, 0x79, 0x19, 0x2f, 0xd9, 0x07, 0xba, 0xcc, 0x55, 0x7c, 0x7c, 0x90, 0x32, 0x4d, 0x0f,
-	0x62, 0xbd, 0x5a, 0x30, 0x45, 0x16, 0x4b, 0xd0, 0xe0, 0xf9, 0x4d, 0x17, 0x71, 0x5d, 0xc4, 0x75,
-	0x8d, 0x82, 0x0c, 0x94, 0x04, 0x15, 0xa7, 0x54, 0xb1, 0x56, 0x9a, 0x01, 0x2f, 0xad, 0x72, 0x74,
-	0xb3, 0x80, 0x02, 0xcc, 0x32, 0xae, 0x57, 0xae, 0x1a, 0x16, 0x00, 0x85, 0x60, 0xb1, 0xd9, 0xa5,
-	0xd5, 0xfb, 0x58, 0x73, 0xc9, 0x94, 0xa6, 0x72, 0xe1, 0x1a, 0x86, 0x54, 0xf2, 0x12, 0x62, 0xf3,
-	0xb4, 0xa5, 0xe8, 0x7b, 0x17, 0x0f, 0x12, 0x26, 0x18, 0x55, 0xec, 0x75, 0x36, 0x67, 0x79, 0x25,
-	0x98, 0xf7, 0x16, 0x5f, 0xd7, 0xa0, 0xa9, 0x98, 0x51, 0x09, 0x55, 0xa9, 0x7d, 0x34, 0x46, 0x93,
-	0xfe, 0xe1, 0x6d, 0x62, 0x87, 0x22, 0xf5, 0x50, 0xcd, 0xa4, 0xe4, 0x39, 0xf0, 0x72, 0x7a, 0xe7,
-	0x74, 0x1d, 0x76, 0x2e, 0xd6, 0xe1, 0x8d, 0x15, 0x95, 0xe2, 0x69, 0x74, 0x59, 0x1c, 0x25, 0x7d,
-	0xb3, 0x7d, 0x66, 0x76, 0x5e, 0x8a, 0x07, 0x4b, 0xeb, 0x96, 0x37, 0xf4, 0xff, 0xae, 0xa2, 0x07,
-	0x8e, 0x7e, 0xcb, 0xd2, 0xff, 0xd0, 0x47, 0xc9, 0x5e, 0x53, 0x71, 0x1e, 0x09, 0xde, 0x61, 0x65,
-	0x3e, 0xab, 0x3f, 0xde, 0xef, 0x1a, 0xf8, 0x88, 0xd8, 0x64, 0x48, 0x93, 0x0c, 0x79, 0xd3, 0x24,
-	0xd3, 0xce, 0x3e, 0xb0, 0xf4, 0x46, 0x19, 0x9d, 0x9c, 0x87, 0x28, 0xb9, 0xc6, 0xca, 0xbc, 0x6e,
-	0xf5, 0x04, 0x1e, 0x0a, 0xaa, 0xf4, 0xcc, 0x59, 0x59, 0xf8, 0xff, 0x57, 0xc2, 0xf7, 0x1d, 0xdc,
-	0xb7, 0xf0, 0xbf, 0x10, 0xd6, 0x65, 0x50, 0xd7, 0xdd, 0x4f, 0x30, 0x6e, 0xf7, 0x70, 0x8f, 0x66,
-	0x9a, 0x1f, 0x33, 0xbf, 0x37, 0x46, 0x93, 0x9d, 0xe9, 0xf0, 0x62, 0x1d, 0xee, 0x5a, 0x84, 0xad,
-	0x47, 0x89, 0x6b, 0x88, 0x3e, 0x23, 0x8c, 0x13, 0x73, 0x7a, 0x5e, 0x01, 0x08, 0xef, 0x13, 0xde,
-	0xcb, 0x40, 0xca, 0xaa, 0xe4, 0x7a, 0x35, 0x5b, 0x00, 0x08, 0x1f, 0x8d, 0xbb, 0x93, 0xfe, 0xe1,
-	0xdd, 0x7f, 0xc6, 0xfb, 0x82, 0x65, 0x26, 0xe1, 0x27, 0xf5, 0x98, 0x5f, 0xcf, 0xc3, 0x07, 0x05,
-	0xd7, 0xf3, 0x2a, 0x25, 0x19, 0xc8, 0xd8, 0x9d, 0x40, 0xfb, 0x7a, 0xa4, 0xf2, 0x23, 0x77, 0x74,
-	0x9d, 0x46, 0x7d, 0xf9, 0xf5, 0xed, 0x3e, 0x4a, 0x76, 0x5b, 0xb7, 0xda, 0x7e, 0xfa, 0xf2, 0x74,
-	0x13, 0xa0, 0xb3, 0x4d, 0x80, 0x7e, 0x6e, 0x02, 0x74, 0xb2, 0x0d, 0x3a, 0x67, 0xdb, 0xa0, 0xf3,
-	0x63, 0x1b, 0x74, 0xde, 0x3d, 0xbc, 0x84, 0x6e, 0x2f, 0x47, 0xbb, 0xf8, 0xd8, 0xde, 0x13, 0x63,
-	0x92, 0xf6, 0x4c, 0x96, 0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x75, 0x10, 0x1e, 0x59, 0x48,
-	0x03, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x0d, 0x4d, 0xdb, 0x4d, 0xd3, 0x28, 0x6e, 0x0b, 0x26, 0x20, 0x3b, 0xb2, 0x7a,
+	0x08, 0x5f, 0xb6, 0x5a, 0x38, 0x20, 0x24, 0x0e, 0x04, 0x04, 0x3d, 0x21, 0x64, 0xb8, 0x00, 0x87,
+	0x68, 0x6d, 0x2f, 0x8e, 0x55, 0xdb, 0x1b, 0xc5, 0xeb, 0x42, 0x0f, 0x3c, 0x01, 0x97, 0x3e, 0x06,
+	0xe2, 0xc4, 0x63, 0xf4, 0x82, 0xd4, 0x23, 0x27, 0x17, 0x35, 0x07, 0xee, 0x79, 0x02, 0xe4, 0xdd,
+	0x59, 0x93, 0x42, 0xa5, 0x5c, 0xda, 0xdd, 0xc9, 0xcc, 0x6f, 0x66, 0xfe, 0xb3, 0x63, 0xbc, 0x73,
+	0x10, 0x45, 0xfe, 0x88, 0x44, 0xa9, 0x33, 0xa1, 0x1f, 0xc9, 0x24, 0xc8, 0x9c, 0xc3, 0x5d, 0x8f,
+	0x72, 0xb2, 0xeb, 0xf0, 0xa3, 0x31, 0xcd, 0xec, 0xf1, 0x84, 0x71, 0xa6, 0xe9, 0xca, 0xcb, 0x06,
+	0x2f, 0x1b, 0xbc, 0xba, 0x86, 0xcf, 0xb2, 0x84, 0x65, 0x8e, 0x47, 0x32, 0x5a, 0x85, 0xfa, 0x2c,
+	0x4a, 0x65, 0x64, 0x77, 0x2b, 0x64, 0x21, 0x13, 0x47, 0xa7, 0x3c, 0x81, 0xd5, 0x0c, 0x19, 0x0b,
+	0x63, 0xea, 0x88, 0x9b, 0x97, 0x7f, 0x70, 0x78, 0x94, 0xd0, 0x8c, 0x93, 0x64, 0x0c, 0x0e, 0x1d,
+	0x92, 0x44, 0x29, 0x73, 0xc4, 0x5f, 0x69, 0xb2, 0xce, 0x96, 0x71, 0xdb, 0xa5, 0x31, 0x25, 0x19,
+	0x7d, 0xed, 0x8f, 0x68, 0x90, 0xc7, 0x54, 0x7b, 0x8b, 0xd7, 0x39, 0xe3, 0x24, 0x1e, 0x92, 0x84,
+	0xe5, 0x29, 0xd7, 0x51, 0x0f, 0xf5, 0x9b, 0x7b, 0xd7, 0x6d, 0x59, 0x94, 0x5d, 0x16, 0xa5, 0x2a,
+	0xb5, 0x9f, 0xb2, 0x28, 0x1d, 0xdc, 0x38, 0x29, 0xcc, 0xda, 0xac, 0x30, 0x37, 0x8f, 0x48, 0x12,
+	0x3f, 0xb2, 0xe6, 0x83, 0x2d, 0xb7, 0x29, 0xae, 0x4f, 0xc4, 0x4d, 0xf3, 0x70, 0x7b, 0x22, 0xb3,
+	0x05, 0x8a, 0xbe, 0xb4, 0x88, 0x6e, 0x00, 0xfd, 0xaa, 0xa4, 0xff, 0x13, 0x6f, 0xb9, 0x1b, 0xca,
+	0x02, 0x39, 0x5c, 0xbc, 0x4a, 0xd3, 0x60, 0x58, 0x36, 0xaf, 0xd7, 0x05, 0xbc, 0x6b, 0x4b, 0x65,
+	0x6c, 0xa5, 0x8c, 0xfd, 0x46, 0x29, 0x53, 0xd5, 0xde, 0x96, 0x74, 0x15, 0x69, 0x1d, 0x9f, 0x99,
+	0xc8, 0x5d, 0xa1, 0x69, 0x50, 0xba, 0x6a, 0x31, 0xee, 0xc4, 0x24, 0xe3, 0x43, 0x48, 0x25, 0xe1,
+	0xcb, 0x0b, 0xe1, 0x3b, 0x00, 0xd7, 0x25, 0xfc, 0x3f, 0x84, 0xcc, 0xd2, 0x2e, 0xed, 0x30, 0x04,
+	0x91, 0xed, 0x16, 0x6e, 0x10, 0x9f, 0x47, 0x87, 0x54, 0x6f, 0xf4, 0x50, 0x7f, 0x75, 0xd0, 0x99,
+	0x15, 0x66, 0x4b, 0x22, 0xa4, 0xdd, 0x72, 0xc1, 0x41, 0x7b, 0x8f, 0x9b, 0x63, 0x92, 0x97, 0x72,
+	0x88, 0x92, 0x56, 0x16, 0x96, 0xa4, 0xd4, 0xd4, 0x24, 0x6f, 0x2e, 0x58, 0x16, 0x83, 0xa5, 0x45,
+	0xd4, 0xf1, 0x00, 0xe3, 0x52, 0x8f, 0x11, 0x8d, 0xc2, 0x11, 0xd7, 0x57, 0x7b, 0xa8, 0x5f, 0x1f,
+	0x6c, 0xcf, 0x0a, 0xb3, 0xf3, 0x57, 0x2b, 0xf9, 0x9b, 0xe5, 0xae, 0xd1, 0x34, 0xd8, 0x17, 0x67,
+	0xed, 0x25, 0xde, 0xbc, 0xd0, 0x28, 0x84, 0xaf, 0x89, 0x70, 0x63, 0x56, 0x98, 0xdd, 0x4b, 0xd4,
+	0x50, 0x9c, 0xce, 0x9c, 0x16, 0xc0, 0x7b, 0x8c, 0x5b, 0x50, 0x25, 0x90, 0xb0, 0x20, 0xe9, 0xb3,
+	0xc2, 0xdc, 0xba, 0xd0, 0x84, 0x62, 0xac, 0xcb, 0xbb, 0x0c, 0xb7, 0xbe, 0x20, 0x8c, 0x5d, 0xb1,
+	0x5f, 0xaf, 0x18, 0x8b, 0xb5, 0xcf, 0x78, 0xc3, 0x67, 0x49, 0x92, 0xa7, 0x11, 0x3f, 0x1a, 0x8e,
+	0x19, 0x8b, 0x75, 0xd4, 0xab, 0xf7, 0x9b, 0x7b, 0x37, 0x2f, 0x7d, 0x80, 0xcf, 0xa8, 0x2f, 0xde,
+	0xe0, 0xc3, 0x52, 0xb5, 0x6f, 0x67, 0xe6, 0x9d, 0x30, 0xe2, 0xa3, 0xdc, 0xb3, 0x7d, 0x96, 0x38,
+	0xb0, 0xa3, 0xf2, 0xdf, 0xbd, 0x2c, 0x38, 0x80, 0xe5, 0x86, 0x98, 0xec, 0xeb, 0xef, 0xef, 0xb7,
+	0x91, 0xdb, 0xaa, 0xb2, 0x95, 0xe9, 0xad, 0x1f, 0x08, 0xb7, 0xa0, 0x3d, 0x97, 0xfa, 0x6c, 0x12,
+	0x94, 0xc3, 0x86, 0xbe, 0x90, 0xe8, 0x6b, 0x6e, 0xd8, 0xaa, 0x21, 0x70, 0xd0, 0x5e, 0xe0, 0x2b,
+	0x62, 0xca, 0x4b, 0x0b, 0xa7, 0x7c, 0x0d, 0xa6, 0xdc, 0x84, 0x8d, 0xac, 0xc6, 0x2b, 0x00, 0xda,
+	0x3e, 0x6e, 0xc0, 0xf6, 0xd5, 0x17, 0x6d, 0xdf, 0x36, 0x90, 0xd4, 0xfb, 0x83, 0xa5, 0x83, 0xf8,
+	0xc1, 0xf3, 0x93, 0x73, 0x03, 0x9d, 0x9e, 0x1b, 0xe8, 0xd7, 0xb9, 0x81, 0x8e, 0xa7, 0x46, 0xed,
+	0x74, 0x6a, 0xd4, 0x7e, 0x4e, 0x8d, 0xda, 0xbb, 0xbb, 0x73, 0x52, 0x55, 0x9f, 0xc3, 0xea, 0xf0,
+	0xa9, 0xfa, 0x32, 0x0a, 0xd1, 0xbc, 0x86, 0x68, 0xe2, 0xfe, 0x9f, 0x01, 0x00, 0x81, 0x3d, 0x18,
+	0x72, 0x3a, 0x05, 0x00, 0x00,
 }
 
 func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
@@ -221,6 +333,29 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PausedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PausedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LastReleaseHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastReleaseHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
 	if m.Active {
 		i--
 		if m.Active {
@@ -231,21 +366,21 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
@@ -307,6 +442,52 @@ func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReleaseRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -335,6 +516,17 @@ func (m *ReleaseSchedule) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EndHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EndHeight))
+	}
+	if m.LastReleaseHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastReleaseHeight))
+	}
+	if m.PausedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.PausedHeight))
+	}
 	return n
 }
 
@@ -353,6 +545,22 @@ func (m *RewardPool) Size() (n int) {
 	return n
 }
 
+func (m *ReleaseRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -540,6 +748,96 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReleaseHeight", wireType)
+			}
+			m.LastReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedHeight", wireType)
+			}
+			m.PausedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PausedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -645,6 +943,141 @@ func (m *RewardPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReleaseRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
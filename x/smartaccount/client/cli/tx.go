@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Smart account transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewUpdateParamsCmd(),
+		NewRegisterSmartAccountCmd(),
+		NewUnregisterSmartAccountCmd(),
+	)
+
+	return cmd
+}
+
+// NewUpdateParamsCmd implements the update-params tx command.
+func NewUpdateParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-json]",
+		Short: "Update module parameters (gov proposal)",
+		Long: `Update module parameters through a governance proposal. Example:
+$ %s tx gov submit-proposal update-smartaccount-params <path/to/params.json> --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &params); err != nil {
+				return fmt.Errorf("failed to parse params: %w", err)
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterSmartAccountCmd implements the register-smart-account tx command.
+func NewRegisterSmartAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-smart-account [cosmwasm|evm] [contract-address]",
+		Short: "Convert the sender account into a smart account authenticated by a contract",
+		Long: `Convert the sender account into a smart account authenticated by a contract.
+After the conversion the account key can't sign transactions anymore, the signatures are verified by the contract. Example:
+$ %s tx smartaccount register-smart-account evm 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --from mykey
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractType, err := parseContractType(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterSmartAccount(clientCtx.GetFromAddress().String(), contractType, args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnregisterSmartAccountCmd implements the unregister-smart-account tx command.
+func NewUnregisterSmartAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unregister-smart-account",
+		Short: "Convert the sender smart account back into an account authenticated by its key",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnregisterSmartAccount(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseContractType parses the contract type argument
+func parseContractType(arg string) (types.ContractType, error) {
+	switch strings.ToLower(arg) {
+	case "cosmwasm":
+		return types.CONTRACT_TYPE_COSMWASM, nil
+	case "evm":
+		return types.CONTRACT_TYPE_EVM, nil
+	default:
+		return types.CONTRACT_TYPE_UNSPECIFIED, fmt.Errorf("invalid contract type %q, expected cosmwasm or evm", arg)
+	}
+}
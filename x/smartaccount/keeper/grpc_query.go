@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(keeper Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries params of smartaccount module
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// SmartAccount queries the authenticator contract of a smart account
+func (k Querier) SmartAccount(ctx context.Context, req *types.QuerySmartAccountRequest) (*types.QuerySmartAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	smartAccount, found := k.GetSmartAccount(sdk.UnwrapSDKContext(ctx), addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s is not a smart account", req.Address)
+	}
+
+	return &types.QuerySmartAccountResponse{
+		ContractType:    smartAccount.ContractType,
+		ContractAddress: smartAccount.ContractAddress,
+	}, nil
+}
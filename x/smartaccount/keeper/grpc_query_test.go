@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// TestQueries tests the smartaccount queries
+func (suite *KeeperTestSuite) TestQueries() {
+	account := suite.TestAccs[0]
+
+	// Default params are set from genesis
+	paramsRes, err := suite.queryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+
+	// Base accounts are not found
+	_, err = suite.queryClient.SmartAccount(suite.Ctx, &types.QuerySmartAccountRequest{Address: account.String()})
+	suite.Require().ErrorContains(err, "is not a smart account")
+	_, err = suite.queryClient.SmartAccount(suite.Ctx, &types.QuerySmartAccountRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	// Smart accounts return their authenticator contract
+	contract := suite.setEVMContract(authenticatorCode).Hex()
+	suite.registerSmartAccount(account, types.CONTRACT_TYPE_EVM, contract)
+	smartAccountRes, err := suite.queryClient.SmartAccount(suite.Ctx, &types.QuerySmartAccountRequest{Address: account.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CONTRACT_TYPE_EVM, smartAccountRes.ContractType)
+	suite.Require().Equal(contract, smartAccountRes.ContractAddress)
+}
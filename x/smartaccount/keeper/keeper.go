@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+type (
+	Keeper struct {
+		cdc codec.BinaryCodec
+
+		accountKeeper types.AccountKeeper
+		wasmKeeper    types.WasmKeeper
+		evmKeeper     types.EVMKeeper
+
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
+
+		Schema collections.Schema
+		Params collections.Item[types.Params]
+	}
+)
+
+// NewKeeper returns a new instance of the x/smartaccount keeper
+// The smart accounts are kept on the auth module, only the params live on the module store
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	wasmKeeper types.WasmKeeper,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc: cdc,
+
+		accountKeeper: accountKeeper,
+		wasmKeeper:    wasmKeeper,
+		evmKeeper:     evmKeeper,
+
+		authority: authority,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/smartaccount module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a logger for the x/smartaccount module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetSmartAccount returns the smart account of an address, if the address is one
+func (k Keeper) GetSmartAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.SmartAccount, bool) {
+	smartAccount, ok := k.accountKeeper.GetAccount(ctx, addr).(*types.SmartAccount)
+	return smartAccount, ok
+}
+
+// HasContract checks if the authenticator contract exists
+func (k Keeper) HasContract(ctx sdk.Context, contractType types.ContractType, contractAddress string) bool {
+	switch contractType {
+	case types.CONTRACT_TYPE_COSMWASM:
+		contract, err := sdk.AccAddressFromBech32(contractAddress)
+		return err == nil && k.wasmKeeper.HasContractInfo(ctx, contract)
+	case types.CONTRACT_TYPE_EVM:
+		return k.evmKeeper.IsContract(ctx, common.HexToAddress(contractAddress))
+	default:
+		return false
+	}
+}
+
+// validateAuthority checks if address authority is valid and same as expected
+func (k Keeper) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return nil
+}
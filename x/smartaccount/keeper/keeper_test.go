@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/smartaccount/keeper"
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+var (
+	// authenticatorCode is an ERC-1271 contract returning the magic value when the signature starts with 0x01
+	authenticatorCode = common.FromHex("60643560001a600114631626ba7e0260e01b60005260206000f3")
+	// gasBurnerCode is a contract looping until it runs out of gas
+	gasBurnerCode = common.FromHex("5b600056")
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+
+	// The EVM needs a block proposer for the coinbase
+	validators, err := suite.App.StakingKeeper.GetAllValidators(suite.Ctx)
+	suite.Require().NoError(err)
+	consAddr, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithProposer(consAddr)
+
+	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
+	suite.msgServer = keeper.NewMsgServerImpl(suite.App.SmartAccountKeeper)
+}
+
+// setEVMContract sets the code of a new EVM contract and returns its address
+func (suite *KeeperTestSuite) setEVMContract(code []byte) common.Address {
+	addr := common.BytesToAddress(apptesting.RandomAccountAddress())
+	acc := suite.App.AccountKeeper.NewAccountWithAddress(suite.Ctx, addr.Bytes())
+	suite.App.AccountKeeper.SetAccount(suite.Ctx, acc)
+
+	codeHash := crypto.Keccak256(code)
+	suite.App.EVMKeeper.SetCode(suite.Ctx, codeHash, code)
+	suite.App.EVMKeeper.SetCodeHash(suite.Ctx, addr.Bytes(), codeHash)
+	return addr
+}
+
+// registerSmartAccount converts an account into a smart account
+func (suite *KeeperTestSuite) registerSmartAccount(addr sdk.AccAddress, contractType types.ContractType, contract string) {
+	suite.App.AccountKeeper.SetAccount(suite.Ctx, suite.App.AccountKeeper.NewAccountWithAddress(suite.Ctx, addr))
+	_, err := suite.msgServer.RegisterSmartAccount(suite.Ctx, types.NewMsgRegisterSmartAccount(addr.String(), contractType, contract))
+	suite.Require().NoError(err)
+}
+
+// TestVerifySignature tests the verification of signatures by EVM authenticator contracts
+func (suite *KeeperTestSuite) TestVerifySignature() {
+	k := suite.App.SmartAccountKeeper
+	account := suite.TestAccs[0]
+	suite.registerSmartAccount(account, types.CONTRACT_TYPE_EVM, suite.setEVMContract(authenticatorCode).Hex())
+
+	smartAccount, found := k.GetSmartAccount(suite.Ctx, account)
+	suite.Require().True(found)
+
+	// The contract accepts the signature
+	ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	suite.Require().NoError(k.VerifySignature(ctx, smartAccount, []byte("sign bytes"), []byte{0x01, 0x02}))
+	suite.Require().NotZero(ctx.GasMeter().GasConsumed())
+
+	// The contract rejects the signature
+	err := k.VerifySignature(suite.Ctx, smartAccount, []byte("sign bytes"), []byte{0x02})
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+	err = k.VerifySignature(suite.Ctx, smartAccount, []byte("sign bytes"), nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// Contracts without the ERC-1271 interface reject every signature
+	smartAccount.ContractAddress = common.BytesToAddress(suite.TestAccs[1]).Hex()
+	err = k.VerifySignature(suite.Ctx, smartAccount, []byte("sign bytes"), []byte{0x01})
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+
+	// CosmWasm contracts that can't be queried reject every signature
+	smartAccount.ContractType = types.CONTRACT_TYPE_COSMWASM
+	smartAccount.ContractAddress = suite.TestAccs[1].String()
+	err = k.VerifySignature(suite.Ctx, smartAccount, []byte("sign bytes"), []byte{0x01})
+	suite.Require().ErrorIs(err, types.ErrInvalidSignature)
+}
+
+// TestVerifySignatureGasCap tests that the authenticator contracts run under the max verify gas
+func (suite *KeeperTestSuite) TestVerifySignatureGasCap() {
+	k := suite.App.SmartAccountKeeper
+	account := suite.TestAccs[0]
+	suite.registerSmartAccount(account, types.CONTRACT_TYPE_EVM, suite.setEVMContract(gasBurnerCode).Hex())
+
+	smartAccount, found := k.GetSmartAccount(suite.Ctx, account)
+	suite.Require().True(found)
+
+	// The verification stops at the cap, which is charged on the transaction along with the params read
+	ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	err := k.VerifySignature(ctx, smartAccount, []byte("sign bytes"), []byte{0x01})
+	suite.Require().ErrorIs(err, types.ErrVerificationOutOfGas)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), types.DefaultMaxVerifyGas)
+	suite.Require().Less(ctx.GasMeter().GasConsumed(), types.DefaultMaxVerifyGas+10_000)
+}
+
+// TestGetSmartAccount tests the lookup of smart accounts on the auth module
+func (suite *KeeperTestSuite) TestGetSmartAccount() {
+	k := suite.App.SmartAccountKeeper
+	contract := suite.setEVMContract(authenticatorCode)
+
+	// Unknown and base accounts are not smart accounts
+	_, found := k.GetSmartAccount(suite.Ctx, suite.TestAccs[0])
+	suite.Require().False(found)
+	suite.App.AccountKeeper.SetAccount(suite.Ctx, suite.App.AccountKeeper.NewAccountWithAddress(suite.Ctx, suite.TestAccs[0]))
+	_, found = k.GetSmartAccount(suite.Ctx, suite.TestAccs[0])
+	suite.Require().False(found)
+
+	suite.registerSmartAccount(suite.TestAccs[1], types.CONTRACT_TYPE_EVM, contract.Hex())
+	smartAccount, found := k.GetSmartAccount(suite.Ctx, suite.TestAccs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(suite.TestAccs[1].String(), smartAccount.Address)
+	suite.Require().Equal(contract.Hex(), smartAccount.ContractAddress)
+
+	// The contract must exist
+	suite.Require().True(k.HasContract(suite.Ctx, types.CONTRACT_TYPE_EVM, contract.Hex()))
+	suite.Require().False(k.HasContract(suite.Ctx, types.CONTRACT_TYPE_EVM, common.BytesToAddress(suite.TestAccs[2]).Hex()))
+	suite.Require().False(k.HasContract(suite.Ctx, types.CONTRACT_TYPE_COSMWASM, suite.TestAccs[2].String()))
+	suite.Require().False(k.HasContract(suite.Ctx, types.CONTRACT_TYPE_COSMWASM, authtypes.NewModuleAddress("invalid").String()))
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the smartaccount MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams validates a MsgUpdateParams and sets the new params
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterSmartAccount converts the sender base account into a smart account
+// From now on, the sender signatures are verified by the authenticator contract
+func (k msgServer) RegisterSmartAccount(ctx context.Context, msg *types.MsgRegisterSmartAccount) (*types.MsgRegisterSmartAccountResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := types.ValidateContract(msg.ContractType, msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.HasContract(sdkCtx, msg.ContractType, msg.ContractAddress) {
+		return nil, types.ErrContractNotFound.Wrap(msg.ContractAddress)
+	}
+
+	// Only base accounts can be converted, module and vesting accounts keep their own logic
+	account := k.accountKeeper.GetAccount(ctx, sender)
+	baseAccount, ok := account.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, types.ErrInvalidAccountType.Wrapf("only base accounts can be converted, got %T", account)
+	}
+
+	k.accountKeeper.SetAccount(ctx, types.NewSmartAccount(baseAccount, msg.ContractType, msg.ContractAddress))
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterSmartAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyContractType, msg.ContractType.String()),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, msg.ContractAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRegisterSmartAccountResponse{}, nil
+}
+
+// UnregisterSmartAccount converts the sender smart account back into a base account
+// The signatures are verified by the account public key again
+func (k msgServer) UnregisterSmartAccount(ctx context.Context, msg *types.MsgUnregisterSmartAccount) (*types.MsgUnregisterSmartAccountResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	smartAccount, found := k.GetSmartAccount(sdkCtx, sender)
+	if !found {
+		return nil, types.ErrNotSmartAccount.Wrap(msg.Sender)
+	}
+
+	k.accountKeeper.SetAccount(ctx, smartAccount.BaseAccount)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnregisterSmartAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgUnregisterSmartAccountResponse{}, nil
+}
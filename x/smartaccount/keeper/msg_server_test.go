@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// TestUpdateParams tests changes to the params of the module
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name         string
+		msg          *types.MsgUpdateParams
+		expectedPass bool
+	}{
+		{
+			name: "valid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.App.SmartAccountKeeper.GetAuthority(),
+				types.NewParams(500_000),
+			),
+			expectedPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.NewMsgUpdateParams(
+				suite.TestAccs[0].String(),
+				types.DefaultParams(),
+			),
+			expectedPass: false,
+		},
+		{
+			name: "zero max verify gas",
+			msg: types.NewMsgUpdateParams(
+				suite.App.SmartAccountKeeper.GetAuthority(),
+				types.NewParams(0),
+			),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateParams(suite.Ctx, tc.msg)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				// Verify params were updated
+				params, err := suite.App.SmartAccountKeeper.Params.Get(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.msg.Params, params)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRegisterAndUnregisterSmartAccount tests the conversion of base accounts into smart accounts and back
+func (suite *KeeperTestSuite) TestRegisterAndUnregisterSmartAccount() {
+	k := suite.App.SmartAccountKeeper
+	sender := suite.TestAccs[0]
+	contract := suite.setEVMContract(authenticatorCode).Hex()
+	suite.App.AccountKeeper.SetAccount(suite.Ctx, suite.App.AccountKeeper.NewAccountWithAddress(suite.Ctx, sender))
+	baseAccount := suite.App.AccountKeeper.GetAccount(suite.Ctx, sender)
+	moduleAccount := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name   string
+		msg    any
+		errMsg string
+	}{
+		{
+			name:   "register - invalid contract type",
+			msg:    types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_UNSPECIFIED, contract),
+			errMsg: "unsupported contract type",
+		},
+		{
+			name:   "register - invalid evm address",
+			msg:    types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_EVM, sender.String()),
+			errMsg: "invalid evm contract address",
+		},
+		{
+			name:   "register - contract not found",
+			msg:    types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_EVM, common.BytesToAddress(sender).Hex()),
+			errMsg: "authenticator contract not found",
+		},
+		{
+			name:   "register - wasm contract not found",
+			msg:    types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_COSMWASM, suite.TestAccs[1].String()),
+			errMsg: "authenticator contract not found",
+		},
+		{
+			name:   "register - module account",
+			msg:    types.NewMsgRegisterSmartAccount(moduleAccount.String(), types.CONTRACT_TYPE_EVM, contract),
+			errMsg: "only base accounts can be converted",
+		},
+		{
+			name:   "unregister - not a smart account",
+			msg:    types.NewMsgUnregisterSmartAccount(sender.String()),
+			errMsg: "not a smart account",
+		},
+		{
+			name: "register - valid",
+			msg:  types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_EVM, contract),
+		},
+		{
+			name:   "register - already a smart account",
+			msg:    types.NewMsgRegisterSmartAccount(sender.String(), types.CONTRACT_TYPE_EVM, contract),
+			errMsg: "only base accounts can be converted",
+		},
+		{
+			name: "unregister - valid",
+			msg:  types.NewMsgUnregisterSmartAccount(sender.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			var err error
+			switch msg := tc.msg.(type) {
+			case *types.MsgRegisterSmartAccount:
+				_, err = suite.msgServer.RegisterSmartAccount(suite.Ctx, msg)
+				if tc.errMsg == "" {
+					suite.Require().NoError(err)
+					smartAccount, found := k.GetSmartAccount(suite.Ctx, sender)
+					suite.Require().True(found)
+					suite.Require().Equal(types.CONTRACT_TYPE_EVM, smartAccount.ContractType)
+					suite.Require().Equal(contract, smartAccount.ContractAddress)
+					suite.Require().Equal(baseAccount.GetAccountNumber(), smartAccount.GetAccountNumber())
+				}
+			case *types.MsgUnregisterSmartAccount:
+				_, err = suite.msgServer.UnregisterSmartAccount(suite.Ctx, msg)
+				if tc.errMsg == "" {
+					suite.Require().NoError(err)
+					suite.Require().Equal(baseAccount, suite.App.AccountKeeper.GetAccount(suite.Ctx, sender))
+				}
+			}
+			if tc.errMsg != "" {
+				suite.Require().ErrorContains(err, tc.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// VerifySignature calls the authenticator contract of a smart account to verify a signature over the sign bytes.
+// The contract runs under the max verify gas cap and the gas it used is charged on the context gas meter
+func (k Keeper) VerifySignature(ctx sdk.Context, smartAccount *types.SmartAccount, signBytes, signature []byte) (err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	verifyCtx := ctx.WithGasMeter(storetypes.NewGasMeter(params.MaxVerifyGas))
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = types.ErrVerificationOutOfGas.Wrapf("gas cap %d reached on %s", params.MaxVerifyGas, outOfGas.Descriptor)
+		}
+		ctx.GasMeter().ConsumeGas(verifyCtx.GasMeter().GasConsumedToLimit(), "smart account signature verification")
+	}()
+
+	switch smartAccount.ContractType {
+	case types.CONTRACT_TYPE_COSMWASM:
+		return k.verifyWasmSignature(verifyCtx, smartAccount, signBytes, signature)
+	case types.CONTRACT_TYPE_EVM:
+		return k.verifyEVMSignature(verifyCtx, smartAccount, params.MaxVerifyGas, signBytes, signature)
+	default:
+		return types.ErrInvalidContractType.Wrapf("unsupported contract type %s", smartAccount.ContractType)
+	}
+}
+
+// verifyWasmSignature queries the verify_signature entrypoint of a CosmWasm contract
+func (k Keeper) verifyWasmSignature(ctx sdk.Context, smartAccount *types.SmartAccount, signBytes, signature []byte) error {
+	contract, err := sdk.AccAddressFromBech32(smartAccount.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	req, err := json.Marshal(types.VerifySignatureQuery{
+		VerifySignature: &types.VerifySignature{
+			Account:   smartAccount.Address,
+			SignBytes: signBytes,
+			Signature: signature,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	resBz, err := k.wasmKeeper.QuerySmart(ctx, contract, req)
+	if err != nil {
+		return types.ErrInvalidSignature.Wrapf("contract query failed: %s", err)
+	}
+
+	var res types.VerifySignatureResponse
+	if err := json.Unmarshal(resBz, &res); err != nil {
+		return types.ErrInvalidSignature.Wrapf("invalid contract response: %s", err)
+	}
+	if !res.Valid {
+		return types.ErrInvalidSignature.Wrap("rejected by the authenticator contract")
+	}
+
+	return nil
+}
+
+// verifyEVMSignature calls the ERC-1271 isValidSignature method of an EVM contract
+// with the keccak256 hash of the sign bytes
+func (k Keeper) verifyEVMSignature(ctx sdk.Context, smartAccount *types.SmartAccount, gasLimit uint64, signBytes, signature []byte) error {
+	data, err := types.ERC1271ABI.Pack(types.IsValidSignatureMethod, crypto.Keccak256Hash(signBytes), signature)
+	if err != nil {
+		return err
+	}
+
+	from := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	contract := common.HexToAddress(smartAccount.ContractAddress)
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		0,             // nonce
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err != nil {
+		return types.ErrInvalidSignature.Wrapf("contract call failed: %s", err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm authenticator contract")
+
+	if res.Failed() {
+		return types.ErrInvalidSignature.Wrapf("contract call failed: %s", res.VmError)
+	}
+
+	unpacked, err := types.ERC1271ABI.Unpack(types.IsValidSignatureMethod, res.Ret)
+	if err != nil {
+		return types.ErrInvalidSignature.Wrapf("invalid contract response: %s", err)
+	}
+	magicValue, ok := unpacked[0].([4]byte)
+	if !ok || magicValue != types.ERC1271MagicValue {
+		return types.ErrInvalidSignature.Wrap("rejected by the authenticator contract")
+	}
+
+	return nil
+}
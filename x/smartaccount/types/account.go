@@ -0,0 +1,58 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.AccountI             = (*SmartAccount)(nil)
+	_ authtypes.GenesisAccount = (*SmartAccount)(nil)
+)
+
+// NewSmartAccount returns a smart account authenticated by the given contract
+func NewSmartAccount(baseAccount *authtypes.BaseAccount, contractType ContractType, contractAddress string) *SmartAccount {
+	return &SmartAccount{
+		BaseAccount:     baseAccount,
+		ContractType:    contractType,
+		ContractAddress: contractAddress,
+	}
+}
+
+// Validate checks the base account and the authenticator contract
+func (sa SmartAccount) Validate() error {
+	if sa.BaseAccount == nil {
+		return errors.New("base account cannot be nil")
+	}
+
+	if err := sa.BaseAccount.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateContract(sa.ContractType, sa.ContractAddress)
+}
+
+// ValidateContract checks that the contract address matches the contract type,
+// CosmWasm contracts use bech32 addresses and EVM contracts use hex addresses
+func ValidateContract(contractType ContractType, contractAddress string) error {
+	switch contractType {
+	case CONTRACT_TYPE_COSMWASM:
+		if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+			return fmt.Errorf("invalid cosmwasm contract address %q: %w", contractAddress, err)
+		}
+	case CONTRACT_TYPE_EVM:
+		if !common.IsHexAddress(contractAddress) {
+			return fmt.Errorf("invalid evm contract address %q", contractAddress)
+		}
+	default:
+		return ErrInvalidContractType.Wrapf("unsupported contract type %s", contractType)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// VerifySignatureQuery is the query answered by CosmWasm authenticator contracts
+type VerifySignatureQuery struct {
+	VerifySignature *VerifySignature `json:"verify_signature"`
+}
+
+// VerifySignature holds the signed bytes and the signature of a smart account,
+// the byte fields are base64 encoded
+type VerifySignature struct {
+	Account   string `json:"account"`
+	SignBytes []byte `json:"sign_bytes"`
+	Signature []byte `json:"signature"`
+}
+
+// VerifySignatureResponse is the answer of CosmWasm authenticator contracts
+type VerifySignatureResponse struct {
+	Valid bool `json:"valid"`
+}
+
+const (
+	// IsValidSignatureMethod is the ERC-1271 method called on EVM authenticator contracts
+	IsValidSignatureMethod = "isValidSignature"
+
+	erc1271ABIJSON = `[{"inputs":[{"internalType":"bytes32","name":"hash","type":"bytes32"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"isValidSignature","outputs":[{"internalType":"bytes4","name":"magicValue","type":"bytes4"}],"stateMutability":"view","type":"function"}]`
+)
+
+var (
+	// ERC1271MagicValue is returned by isValidSignature when the signature is valid
+	ERC1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+	// ERC1271ABI is the ABI of the ERC-1271 isValidSignature method
+	ERC1271ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ERC1271ABI, err = abi.JSON(strings.NewReader(erc1271ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
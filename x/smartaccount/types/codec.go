@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterInterfaces register interfaces into the app
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	// Register messages
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgRegisterSmartAccount{},
+		&MsgUnregisterSmartAccount{},
+	)
+
+	// Register the smart account, so it can be kept on the auth module
+	registry.RegisterImplementations(
+		(*sdk.AccountI)(nil),
+		&SmartAccount{},
+	)
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&SmartAccount{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/smartaccount interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&SmartAccount{}, "kiichain/SmartAccount", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "smartaccount/update-params", nil)
+	cdc.RegisterConcrete(&MsgRegisterSmartAccount{}, "smartaccount/register-smart-account", nil)
+	cdc.RegisterConcrete(&MsgUnregisterSmartAccount{}, "smartaccount/unregister-smart-account", nil)
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodecTestSuite struct {
+	suite.Suite
+}
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(CodecTestSuite))
+}
+
+func (suite *CodecTestSuite) TestRegisterInterfaces() {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface(sdk.MsgInterfaceProtoName, (*sdk.Msg)(nil))
+	registry.RegisterInterface("cosmos.auth.v1beta1.AccountI", (*sdk.AccountI)(nil))
+	RegisterInterfaces(registry)
+
+	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	suite.Require().Equal(3, len(impls))
+	suite.Require().ElementsMatch([]string{
+		"/kiichain.smartaccount.v1beta1.MsgUpdateParams",
+		"/kiichain.smartaccount.v1beta1.MsgRegisterSmartAccount",
+		"/kiichain.smartaccount.v1beta1.MsgUnregisterSmartAccount",
+	}, impls)
+
+	// The smart account is a regular account of the auth module
+	accountImpls := registry.ListImplementations("cosmos.auth.v1beta1.AccountI")
+	suite.Require().Contains(accountImpls, "/kiichain.smartaccount.v1beta1.SmartAccount")
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/smartaccount module sentinel errors
+var (
+	ErrInvalidContractType  = errorsmod.Register(ModuleName, 2, "invalid contract type")
+	ErrContractNotFound     = errorsmod.Register(ModuleName, 3, "authenticator contract not found")
+	ErrInvalidAccountType   = errorsmod.Register(ModuleName, 4, "invalid account type")
+	ErrNotSmartAccount      = errorsmod.Register(ModuleName, 5, "account is not a smart account")
+	ErrInvalidSignature     = errorsmod.Register(ModuleName, 6, "smart account signature verification failed")
+	ErrVerificationOutOfGas = errorsmod.Register(ModuleName, 7, "smart account signature verification out of gas")
+)
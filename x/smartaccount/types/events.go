@@ -0,0 +1,16 @@
+package types
+
+// Smartaccount module event types
+const (
+	EventTypeRegisterSmartAccount   = "register_smart_account"
+	EventTypeUnregisterSmartAccount = "unregister_smart_account"
+)
+
+// Smartaccount module attribute keys
+const (
+	AttributeKeyAccount         = "account"
+	AttributeKeyContractType    = "contract_type"
+	AttributeKeyContractAddress = "contract_address"
+
+	AttributeValueCategory = ModuleName
+)
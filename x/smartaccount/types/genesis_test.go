@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v3/app/apptesting"
+	"github.com/kiichain/kiichain/v3/x/smartaccount/types"
+)
+
+// TestValidateGenesis tests the validation of the smartaccount genesis
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.NoError(t, types.NewGenesisState(types.NewParams(1)).Validate())
+	require.ErrorContains(t, types.NewGenesisState(types.NewParams(0)).Validate(), "max verify gas must be positive")
+}
+
+// TestValidateSmartAccount tests the validation of smart accounts and their contracts
+func TestValidateSmartAccount(t *testing.T) {
+	addr := apptesting.RandomAccountAddress()
+	baseAccount := authtypes.NewBaseAccountWithAddress(addr)
+
+	testCases := []struct {
+		name         string
+		smartAccount *types.SmartAccount
+		errorMsg     string
+	}{
+		{
+			name:         "valid cosmwasm contract",
+			smartAccount: types.NewSmartAccount(baseAccount, types.CONTRACT_TYPE_COSMWASM, apptesting.RandomAccountAddress().String()),
+		},
+		{
+			name:         "valid evm contract",
+			smartAccount: types.NewSmartAccount(baseAccount, types.CONTRACT_TYPE_EVM, "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+		},
+		{
+			name:         "missing base account",
+			smartAccount: types.NewSmartAccount(nil, types.CONTRACT_TYPE_EVM, "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+			errorMsg:     "base account cannot be nil",
+		},
+		{
+			name:         "unspecified contract type",
+			smartAccount: types.NewSmartAccount(baseAccount, types.CONTRACT_TYPE_UNSPECIFIED, "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+			errorMsg:     "unsupported contract type",
+		},
+		{
+			name:         "hex address for cosmwasm contract",
+			smartAccount: types.NewSmartAccount(baseAccount, types.CONTRACT_TYPE_COSMWASM, "0x5FbDB2315678afecb367f032d93F642f64180aa3"),
+			errorMsg:     "invalid cosmwasm contract address",
+		},
+		{
+			name:         "bech32 address for evm contract",
+			smartAccount: types.NewSmartAccount(baseAccount, types.CONTRACT_TYPE_EVM, addr.String()),
+			errorMsg:     "invalid evm contract address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.smartAccount.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
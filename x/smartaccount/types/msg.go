@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Verify interface at compile time
+var (
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgRegisterSmartAccount)(nil)
+	_ sdk.Msg = (*MsgUnregisterSmartAccount)(nil)
+)
+
+// NewMsgUpdateParams returns a new MsgUpdateParams with the authority
+// and the new params.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// NewMsgRegisterSmartAccount returns a new MsgRegisterSmartAccount
+func NewMsgRegisterSmartAccount(sender string, contractType ContractType, contractAddress string) *MsgRegisterSmartAccount {
+	return &MsgRegisterSmartAccount{
+		Sender:          sender,
+		ContractType:    contractType,
+		ContractAddress: contractAddress,
+	}
+}
+
+// NewMsgUnregisterSmartAccount returns a new MsgUnregisterSmartAccount
+func NewMsgUnregisterSmartAccount(sender string) *MsgUnregisterSmartAccount {
+	return &MsgUnregisterSmartAccount{
+		Sender: sender,
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import "fmt"
+
+// DefaultMaxVerifyGas is the default gas cap of a signature verification by an authenticator contract
+const DefaultMaxVerifyGas uint64 = 300_000
+
+// NewParams returns new smartaccount parameters
+func NewParams(maxVerifyGas uint64) Params {
+	return Params{
+		MaxVerifyGas: maxVerifyGas,
+	}
+}
+
+// DefaultParams returns default smartaccount parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMaxVerifyGas)
+}
+
+// Validate performs basic validation on the smartaccount parameters
+func (p Params) Validate() error {
+	if p.MaxVerifyGas == 0 {
+		return fmt.Errorf("max verify gas must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/smartaccount/v1beta1/smartaccount.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractType defines the virtual machine of an authenticator contract
+type ContractType int32
+
+const (
+	// CONTRACT_TYPE_UNSPECIFIED defines an invalid contract type
+	CONTRACT_TYPE_UNSPECIFIED ContractType = 0
+	// CONTRACT_TYPE_COSMWASM defines a CosmWasm contract answering the
+	// verify_signature query
+	CONTRACT_TYPE_COSMWASM ContractType = 1
+	// CONTRACT_TYPE_EVM defines an EVM contract implementing ERC-1271
+	// isValidSignature
+	CONTRACT_TYPE_EVM ContractType = 2
+)
+
+var ContractType_name = map[int32]string{
+	0: "CONTRACT_TYPE_UNSPECIFIED",
+	1: "CONTRACT_TYPE_COSMWASM",
+	2: "CONTRACT_TYPE_EVM",
+}
+
+var ContractType_value = map[string]int32{
+	"CONTRACT_TYPE_UNSPECIFIED": 0,
+	"CONTRACT_TYPE_COSMWASM":    1,
+	"CONTRACT_TYPE_EVM":         2,
+}
+
+func (x ContractType) String() string {
+	return proto.EnumName(ContractType_name, int32(x))
+}
+
+func (ContractType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_817964730ff7eb3d, []int{0}
+}
+
+// SmartAccount is an account whose signatures are verified by an authenticator
+// contract instead of a public key
+type SmartAccount struct {
+	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
+	// contract_type is the virtual machine of the authenticator contract
+	ContractType ContractType `protobuf:"varint,2,opt,name=contract_type,json=contractType,proto3,enum=kiichain.smartaccount.v1beta1.ContractType" json:"contract_type,omitempty"`
+	// contract_address is the authenticator contract, a bech32 address for
+	// CosmWasm and a hex address for EVM contracts
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *SmartAccount) Reset()         { *m = SmartAccount{} }
+func (m *SmartAccount) String() string { return proto.CompactTextString(m) }
+func (*SmartAccount) ProtoMessage()    {}
+func (*SmartAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_817964730ff7eb3d, []int{0}
+}
+func (m *SmartAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SmartAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SmartAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartAccount.Merge(m, src)
+}
+func (m *SmartAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartAccount proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("kiichain.smartaccount.v1beta1.ContractType", ContractType_name, ContractType_value)
+	proto.RegisterType((*SmartAccount)(nil), "kiichain.smartaccount.v1beta1.SmartAccount")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/smartaccount/v1beta1/smartaccount.proto", fileDescriptor_817964730ff7eb3d)
+}
+
+var fileDescriptor_817964730ff7eb3d = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
+	0x14, 0xcd, 0xd4, 0x87, 0xe0, 0xbc, 0xa8, 0x79, 0xc1, 0x27, 0x7d, 0xc1, 0x37, 0x2f, 0xb8, 0xaa,
+	0x15, 0x13, 0x5b, 0x77, 0x2e, 0x84, 0x34, 0x46, 0x08, 0xd2, 0x0f, 0x92, 0xa8, 0xe8, 0x26, 0x4c,
+	0xa6, 0xb1, 0x0d, 0x92, 0x4c, 0xc9, 0x4c, 0xc5, 0xfe, 0x83, 0xe2, 0xca, 0x95, 0x6b, 0xc1, 0x3f,
+	0xe0, 0xc2, 0x1f, 0x21, 0xae, 0xba, 0x74, 0x25, 0xd2, 0x2e, 0xfc, 0x1b, 0x92, 0x64, 0x1a, 0x1b,
+	0x10, 0x37, 0xe1, 0x9e, 0x73, 0x38, 0xf7, 0x9e, 0xdc, 0x3b, 0xf0, 0xfe, 0x9b, 0x24, 0x21, 0x73,
+	0x9c, 0x64, 0x26, 0x4b, 0x71, 0xce, 0x31, 0x21, 0x74, 0x99, 0x71, 0xf3, 0x6d, 0x2f, 0x8a, 0x39,
+	0xee, 0x35, 0x48, 0x63, 0x91, 0x53, 0x4e, 0xd5, 0xf3, 0xbd, 0xc3, 0x68, 0x88, 0xc2, 0xa1, 0x9d,
+	0xe0, 0x34, 0xc9, 0xa8, 0x59, 0x7e, 0x2b, 0x87, 0x76, 0x63, 0x46, 0x67, 0xb4, 0x2c, 0xcd, 0xa2,
+	0x12, 0xec, 0x19, 0xa1, 0x2c, 0xa5, 0x2c, 0xac, 0x84, 0x0a, 0x08, 0x09, 0x55, 0xc8, 0xc4, 0x4b,
+	0x3e, 0xaf, 0xa3, 0x14, 0xa0, 0xd2, 0x6f, 0x7f, 0x6c, 0x41, 0xd9, 0x2f, 0x86, 0x5b, 0xd5, 0x70,
+	0xd5, 0x85, 0x72, 0x84, 0x59, 0x1c, 0x8a, 0x30, 0x6d, 0xa0, 0x83, 0xce, 0x71, 0x5f, 0x37, 0x44,
+	0xd7, 0xd2, 0x2a, 0xfa, 0x18, 0x03, 0xcc, 0x62, 0xe1, 0x1b, 0x1c, 0x6d, 0x7e, 0x5e, 0x00, 0xef,
+	0x38, 0xfa, 0x4b, 0xa9, 0x13, 0x78, 0x95, 0xd0, 0x8c, 0xe7, 0x98, 0xf0, 0x90, 0xaf, 0x16, 0x71,
+	0xbb, 0xa5, 0x83, 0xce, 0xb5, 0xfe, 0x5d, 0xe3, 0xbf, 0xbf, 0x6d, 0xd8, 0xc2, 0x13, 0xac, 0x16,
+	0xb1, 0x27, 0x93, 0x03, 0xa4, 0xde, 0x81, 0x4a, 0xdd, 0x11, 0x4f, 0xa7, 0x79, 0xcc, 0x58, 0xfb,
+	0x92, 0x0e, 0x3a, 0x57, 0xbc, 0xeb, 0x7b, 0xde, 0xaa, 0xe8, 0x87, 0x8f, 0xd6, 0x9f, 0x2e, 0xa4,
+	0xef, 0x5f, 0xef, 0xdd, 0xfa, 0x57, 0x70, 0x91, 0xd0, 0x7d, 0xff, 0xfb, 0x4b, 0xf7, 0xb4, 0x3e,
+	0xdb, 0xe1, 0x1e, 0xba, 0xaf, 0xa1, 0x7c, 0x18, 0x44, 0x3d, 0x87, 0x67, 0xf6, 0x78, 0x14, 0x78,
+	0x96, 0x1d, 0x84, 0xc1, 0xcb, 0x89, 0x13, 0x3e, 0x1b, 0xf9, 0x13, 0xc7, 0x76, 0x9f, 0xb8, 0xce,
+	0x63, 0x45, 0x52, 0x35, 0x78, 0xb3, 0x29, 0xdb, 0x63, 0x7f, 0xf8, 0xc2, 0xf2, 0x87, 0x0a, 0x50,
+	0x4f, 0xe1, 0x49, 0x53, 0x73, 0x9e, 0x0f, 0x95, 0x96, 0x76, 0xb4, 0xfe, 0x8c, 0xa4, 0xc1, 0xd3,
+	0x6f, 0x5b, 0x04, 0x36, 0x5b, 0x04, 0x7e, 0x6d, 0x11, 0xf8, 0xb0, 0x43, 0xd2, 0x66, 0x87, 0xa4,
+	0x1f, 0x3b, 0x24, 0xbd, 0xea, 0xcd, 0x12, 0x3e, 0x5f, 0x46, 0x06, 0xa1, 0xa9, 0x59, 0x67, 0xac,
+	0x8b, 0x77, 0xcd, 0x57, 0x56, 0xec, 0x97, 0x45, 0x97, 0xcb, 0xa3, 0x3e, 0xf8, 0x33, 0x00, 0x15,
+	0xed, 0x53, 0xb7, 0x8b, 0x02, 0x00, 0x00,
+}
+
+func (m *SmartAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintSmartaccount(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintSmartaccount(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSmartaccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSmartaccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SmartAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovSmartaccount(uint64(m.ContractType))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	return n
+}
+
+func sovSmartaccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSmartaccount(x uint64) (n int) {
+	return sovSmartaccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SmartAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSmartaccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSmartaccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSmartaccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSmartaccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSmartaccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSmartaccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSmartaccount = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1367 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kiichain/smartaccount/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/smartaccount parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{0}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{1}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRegisterSmartAccount is the Msg/RegisterSmartAccount request type.
+type MsgRegisterSmartAccount struct {
+	// sender is the account converted into a smart account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_type is the virtual machine of the authenticator contract
+	ContractType ContractType `protobuf:"varint,2,opt,name=contract_type,json=contractType,proto3,enum=kiichain.smartaccount.v1beta1.ContractType" json:"contract_type,omitempty"`
+	// contract_address is the authenticator contract
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgRegisterSmartAccount) Reset()         { *m = MsgRegisterSmartAccount{} }
+func (m *MsgRegisterSmartAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSmartAccount) ProtoMessage()    {}
+func (*MsgRegisterSmartAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{2}
+}
+func (m *MsgRegisterSmartAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSmartAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSmartAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSmartAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSmartAccount.Merge(m, src)
+}
+func (m *MsgRegisterSmartAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSmartAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSmartAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSmartAccount proto.InternalMessageInfo
+
+func (m *MsgRegisterSmartAccount) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterSmartAccount) GetContractType() ContractType {
+	if m != nil {
+		return m.ContractType
+	}
+	return CONTRACT_TYPE_UNSPECIFIED
+}
+
+func (m *MsgRegisterSmartAccount) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgRegisterSmartAccountResponse defines the response structure for executing
+// a MsgRegisterSmartAccount message.
+type MsgRegisterSmartAccountResponse struct {
+}
+
+func (m *MsgRegisterSmartAccountResponse) Reset()         { *m = MsgRegisterSmartAccountResponse{} }
+func (m *MsgRegisterSmartAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSmartAccountResponse) ProtoMessage()    {}
+func (*MsgRegisterSmartAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{3}
+}
+func (m *MsgRegisterSmartAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSmartAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSmartAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSmartAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSmartAccountResponse.Merge(m, src)
+}
+func (m *MsgRegisterSmartAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSmartAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSmartAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSmartAccountResponse proto.InternalMessageInfo
+
+// MsgUnregisterSmartAccount is the Msg/UnregisterSmartAccount request type.
+type MsgUnregisterSmartAccount struct {
+	// sender is the smart account converted back into a public key account
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgUnregisterSmartAccount) Reset()         { *m = MsgUnregisterSmartAccount{} }
+func (m *MsgUnregisterSmartAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterSmartAccount) ProtoMessage()    {}
+func (*MsgUnregisterSmartAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{4}
+}
+func (m *MsgUnregisterSmartAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterSmartAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterSmartAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterSmartAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterSmartAccount.Merge(m, src)
+}
+func (m *MsgUnregisterSmartAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterSmartAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterSmartAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterSmartAccount proto.InternalMessageInfo
+
+func (m *MsgUnregisterSmartAccount) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgUnregisterSmartAccountResponse defines the response structure for
+// executing a MsgUnregisterSmartAccount message.
+type MsgUnregisterSmartAccountResponse struct {
+}
+
+func (m *MsgUnregisterSmartAccountResponse) Reset()         { *m = MsgUnregisterSmartAccountResponse{} }
+func (m *MsgUnregisterSmartAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterSmartAccountResponse) ProtoMessage()    {}
+func (*MsgUnregisterSmartAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fcfb014bf0e2a8a3, []int{5}
+}
+func (m *MsgUnregisterSmartAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterSmartAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterSmartAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnregisterSmartAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterSmartAccountResponse.Merge(m, src)
+}
+func (m *MsgUnregisterSmartAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterSmartAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterSmartAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnregisterSmartAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.smartaccount.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.smartaccount.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterSmartAccount)(nil), "kiichain.smartaccount.v1beta1.MsgRegisterSmartAccount")
+	proto.RegisterType((*MsgRegisterSmartAccountResponse)(nil), "kiichain.smartaccount.v1beta1.MsgRegisterSmartAccountResponse")
+	proto.RegisterType((*MsgUnregisterSmartAccount)(nil), "kiichain.smartaccount.v1beta1.MsgUnregisterSmartAccount")
+	proto.RegisterType((*MsgUnregisterSmartAccountResponse)(nil), "kiichain.smartaccount.v1beta1.MsgUnregisterSmartAccountResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/smartaccount/v1beta1/tx.proto", fileDescriptor_fcfb014bf0e2a8a3)
+}
+
+var fileDescriptor_fcfb014bf0e2a8a3 = []byte{
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x11, 0x88, 0xd4, 0xa3, 0x50, 0xb0, 0x22, 0x9a, 0x58, 0xc2, 0x6d, 0x53, 0x15, 0x95,
+	0x20, 0xdb, 0x4d, 0x2a, 0x2a, 0x94, 0x01, 0xd1, 0x74, 0x44, 0x95, 0x2a, 0x17, 0x16, 0x96, 0xea,
+	0xe2, 0x9c, 0x1c, 0x0b, 0xf9, 0xce, 0xba, 0xbb, 0x44, 0xcd, 0x86, 0x10, 0x13, 0x03, 0x62, 0xe0,
+	0xcf, 0x60, 0xc8, 0xc0, 0xca, 0xde, 0xb1, 0x62, 0x62, 0x42, 0x28, 0x19, 0xf2, 0x57, 0x20, 0x21,
+	0xfb, 0xce, 0x6e, 0x1d, 0xe5, 0x47, 0xa9, 0x58, 0x92, 0xbb, 0xf7, 0xbe, 0xef, 0xbd, 0xef, 0x7d,
+	0x77, 0x3e, 0xf8, 0xe8, 0xad, 0xef, 0xbb, 0x1d, 0xe4, 0x13, 0x9b, 0x07, 0x88, 0x09, 0xe4, 0xba,
+	0xb4, 0x4b, 0x84, 0xdd, 0xab, 0xb5, 0xb0, 0x40, 0x35, 0x5b, 0x9c, 0x5a, 0x21, 0xa3, 0x82, 0x6a,
+	0x0f, 0x13, 0x9c, 0x75, 0x19, 0x67, 0x29, 0x9c, 0x5e, 0xf4, 0xa8, 0x47, 0x63, 0xa4, 0x1d, 0xad,
+	0x24, 0x49, 0xaf, 0xce, 0x2f, 0x1e, 0x22, 0x86, 0x02, 0xae, 0xb0, 0x3b, 0xf3, 0xb1, 0x99, 0xae,
+	0x92, 0xb1, 0xea, 0x52, 0x1e, 0x50, 0x6e, 0x07, 0xdc, 0xb3, 0x7b, 0xb5, 0xe8, 0x4f, 0x25, 0xca,
+	0x32, 0x71, 0x22, 0xf5, 0xc8, 0x8d, 0x4a, 0xdd, 0x47, 0x81, 0x4f, 0xa8, 0x1d, 0xff, 0xca, 0x50,
+	0xe5, 0x3b, 0x80, 0x2b, 0x87, 0xdc, 0x7b, 0x1d, 0xb6, 0x91, 0xc0, 0x47, 0xb1, 0x24, 0x6d, 0x0f,
+	0x2e, 0xa1, 0xae, 0xe8, 0x50, 0xe6, 0x8b, 0x7e, 0x09, 0xac, 0x83, 0xed, 0xa5, 0x66, 0xe9, 0xc7,
+	0x37, 0xb3, 0xa8, 0x6a, 0xed, 0xb7, 0xdb, 0x0c, 0x73, 0x7e, 0x2c, 0x98, 0x4f, 0x3c, 0xe7, 0x02,
+	0xaa, 0x1d, 0xc0, 0x82, 0x1c, 0xaa, 0x74, 0x63, 0x1d, 0x6c, 0xdf, 0xae, 0x6f, 0x59, 0x73, 0x6d,
+	0xb3, 0x64, 0xbb, 0xe6, 0xcd, 0xb3, 0x5f, 0x6b, 0x39, 0x47, 0x51, 0x1b, 0xe6, 0xfb, 0xf1, 0xa0,
+	0x7a, 0x51, 0xf4, 0xe3, 0x78, 0x50, 0xd5, 0x33, 0x9e, 0x74, 0x63, 0xa9, 0xa6, 0x84, 0x57, 0xca,
+	0x70, 0x75, 0x42, 0xbe, 0x83, 0x79, 0x48, 0x09, 0xc7, 0x95, 0x3f, 0x20, 0xce, 0x39, 0xd8, 0xf3,
+	0xb9, 0xc0, 0xec, 0x38, 0x2a, 0xb2, 0x2f, 0x8b, 0x68, 0x3b, 0xb0, 0xc0, 0x31, 0x69, 0x63, 0xb6,
+	0x70, 0x3e, 0x85, 0xd3, 0x8e, 0xe0, 0x1d, 0x97, 0x12, 0xc1, 0x90, 0x2b, 0x4e, 0x44, 0x3f, 0xc4,
+	0xf1, 0x8c, 0x77, 0xeb, 0x4f, 0x16, 0xcc, 0x78, 0xa0, 0x38, 0xaf, 0xfa, 0x21, 0x76, 0x96, 0xdd,
+	0x4b, 0x3b, 0xed, 0x31, 0xbc, 0x97, 0x56, 0x44, 0xb2, 0x67, 0x29, 0x1f, 0xa9, 0x71, 0x56, 0x92,
+	0xb8, 0x92, 0xd2, 0xd8, 0x8d, 0x4c, 0x51, 0x4a, 0x22, 0x47, 0x36, 0x33, 0x8e, 0x30, 0x35, 0xa1,
+	0x19, 0x47, 0x4d, 0x15, 0xae, 0x6c, 0xc0, 0xb5, 0x19, 0xe3, 0xa7, 0x16, 0x7d, 0x00, 0xb0, 0x1c,
+	0xd9, 0x47, 0xd8, 0x7f, 0x31, 0xa9, 0xf1, 0x74, 0x42, 0xe7, 0x56, 0xf6, 0xe4, 0xc8, 0x0c, 0xa5,
+	0x9b, 0x70, 0x63, 0xa6, 0x8a, 0x44, 0x6b, 0xfd, 0x6b, 0x1e, 0xe6, 0x0f, 0xb9, 0xa7, 0xf5, 0xe0,
+	0x72, 0xe6, 0xb6, 0x5a, 0x0b, 0x4e, 0x60, 0xe2, 0x7a, 0xe8, 0x7b, 0xff, 0x86, 0x4f, 0xfa, 0x6b,
+	0x9f, 0x00, 0x2c, 0x4e, 0xbd, 0x4b, 0x57, 0x28, 0x38, 0x8d, 0xa7, 0x3f, 0xbf, 0x1e, 0x2f, 0x15,
+	0xf4, 0x05, 0xc0, 0x07, 0x33, 0x4e, 0xee, 0xd9, 0x15, 0x66, 0x9c, 0xca, 0xd4, 0x5f, 0x5c, 0x97,
+	0x99, 0xc8, 0xd2, 0x6f, 0xbd, 0x1b, 0x0f, 0xaa, 0xa0, 0xf9, 0xf2, 0x6c, 0x68, 0x80, 0xf3, 0xa1,
+	0x01, 0x7e, 0x0f, 0x0d, 0xf0, 0x79, 0x64, 0xe4, 0xce, 0x47, 0x46, 0xee, 0xe7, 0xc8, 0xc8, 0xbd,
+	0xa9, 0x79, 0xbe, 0xe8, 0x74, 0x5b, 0x96, 0x4b, 0x03, 0x3b, 0x7d, 0xf6, 0xd2, 0xc5, 0x69, 0xf6,
+	0x05, 0x8c, 0x3e, 0x35, 0xde, 0x2a, 0xc4, 0x8f, 0xd5, 0xee, 0xdf, 0x01, 0x00, 0xef, 0x8b, 0xb2,
+	0x8a, 0xb0, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/smartaccount module parameters
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterSmartAccount converts the sender account into a smart account
+	// authenticated by a contract
+	RegisterSmartAccount(ctx context.Context, in *MsgRegisterSmartAccount, opts ...grpc.CallOption) (*MsgRegisterSmartAccountResponse, error)
+	// UnregisterSmartAccount converts the sender smart account back into a
+	// public key account
+	UnregisterSmartAccount(ctx context.Context, in *MsgUnregisterSmartAccount, opts ...grpc.CallOption) (*MsgUnregisterSmartAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.smartaccount.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSmartAccount(ctx context.Context, in *MsgRegisterSmartAccount, opts ...grpc.CallOption) (*MsgRegisterSmartAccountResponse, error) {
+	out := new(MsgRegisterSmartAccountResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.smartaccount.v1beta1.Msg/RegisterSmartAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterSmartAccount(ctx context.Context, in *MsgUnregisterSmartAccount, opts ...grpc.CallOption) (*MsgUnregisterSmartAccountResponse, error) {
+	out := new(MsgUnregisterSmartAccountResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.smartaccount.v1beta1.Msg/UnregisterSmartAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/smartaccount module parameters
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterSmartAccount converts the sender account into a smart account
+	// authenticated by a contract
+	RegisterSmartAccount(context.Context, *MsgRegisterSmartAccount) (*MsgRegisterSmartAccountResponse, error)
+	// UnregisterSmartAccount converts the sender smart account back into a
+	// public key account
+	UnregisterSmartAccount(context.Context, *MsgUnregisterSmartAccount) (*MsgUnregisterSmartAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSmartAccount(ctx context.Context, req *MsgRegisterSmartAccount) (*MsgRegisterSmartAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSmartAccount not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterSmartAccount(ctx context.Context, req *MsgUnregisterSmartAccount) (*MsgUnregisterSmartAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterSmartAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.smartaccount.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSmartAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSmartAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSmartAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.smartaccount.v1beta1.Msg/RegisterSmartAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSmartAccount(ctx, req.(*MsgRegisterSmartAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterSmartAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterSmartAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterSmartAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.smartaccount.v1beta1.Msg/UnregisterSmartAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterSmartAccount(ctx, req.(*MsgUnregisterSmartAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.smartaccount.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterSmartAccount",
+			Handler:    _Msg_RegisterSmartAccount_Handler,
+		},
+		{
+			MethodName: "UnregisterSmartAccount",
+			Handler:    _Msg_UnregisterSmartAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/smartaccount/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSmartAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSmartAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSmartAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ContractType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSmartAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSmartAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSmartAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterSmartAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterSmartAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterSmartAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterSmartAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterSmartAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterSmartAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSmartAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ContractType != 0 {
+		n += 1 + sovTx(uint64(m.ContractType))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterSmartAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterSmartAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterSmartAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSmartAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSmartAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSmartAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractType", wireType)
+			}
+			m.ContractType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractType |= ContractType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSmartAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSmartAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSmartAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterSmartAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterSmartAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterSmartAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterSmartAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterSmartAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterSmartAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
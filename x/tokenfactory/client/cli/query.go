@@ -27,6 +27,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
 		GetCmdDenomsFromAdmin(),
+		GetCmdBeforeSendHookAddress(),
+		GetCmdDenomSupplyCap(),
+		GetCmdMintAllowance(),
+		GetCmdMintAllowances(),
+		GetCmdDenomPaused(),
+		GetCmdIsAccountFrozen(),
+		GetCmdFrozenAccounts(),
+		GetCmdAllDenoms(),
 	)
 
 	return cmd
@@ -144,3 +152,242 @@ func GetCmdDenomsFromAdmin() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdBeforeSendHookAddress returns the before send hook contract for a denom
+func GetCmdBeforeSendHookAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook-address [denom] [flags]",
+		Short: "Get the before send hook contract address for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomSupplyCap returns the max supply and remaining mintable amount of a denom
+func GetCmdDenomSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-cap [denom] [flags]",
+		Short: "Get the max supply, current supply and remaining mintable amount of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomSupplyCap(cmd.Context(), &types.QueryDenomSupplyCapRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMintAllowance returns the remaining allowance of a delegated minter
+func GetCmdMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowance [denom] [minter] [flags]",
+		Short: "Get the remaining mint allowance of a delegated minter of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintAllowance(cmd.Context(), &types.QueryMintAllowanceRequest{
+				Denom:  args[0],
+				Minter: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMintAllowances returns all the delegated minters of a denom
+func GetCmdMintAllowances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-allowances [denom] [flags]",
+		Short: "Get all the delegated minters of a specific denom and their remaining allowances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintAllowances(cmd.Context(), &types.QueryMintAllowancesRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomPaused returns if all the transfers of a denom are paused
+func GetCmdDenomPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-paused [denom] [flags]",
+		Short: "Get if all the transfers of a specific denom are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomPaused(cmd.Context(), &types.QueryDenomPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdIsAccountFrozen returns if an account is frozen for a denom
+func GetCmdIsAccountFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-frozen [denom] [account] [flags]",
+		Short: "Get if an account is frozen for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IsAccountFrozen(cmd.Context(), &types.QueryIsAccountFrozenRequest{
+				Denom:   args[0],
+				Account: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAccounts returns all the frozen accounts of a denom
+func GetCmdFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts [denom] [flags]",
+		Short: "Get all the frozen accounts of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllDenoms returns all the factory denoms with their metadata, authority and supply
+func GetCmdAllDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-denoms [flags]",
+		Short: "Get all the factory denoms with their metadata, authority and supply",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllDenoms(cmd.Context(), &types.QueryAllDenomsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-denoms")
+	return cmd
+}
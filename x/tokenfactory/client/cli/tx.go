@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -35,11 +37,28 @@ func GetTxCmd() *cobra.Command {
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetMinterCmd(),
+		NewFreezeAccountCmd(),
+		NewUnfreezeAccountCmd(),
+		NewPauseDenomCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewRenounceAdminCmd(),
+		NewMultiMintCmd(),
 	)
 
 	return cmd
 }
 
+const (
+	// FlagRegisterERC20 registers the created denom as an ERC20 token pair
+	FlagRegisterERC20 = "register-erc20"
+	// FlagMaxSupply caps the supply of the created denom
+	FlagMaxSupply = "max-supply"
+)
+
 // NewCreateDenomCmd broadcast MsgCreateDenom
 func NewCreateDenomCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -64,10 +83,29 @@ func NewCreateDenomCmd() *cobra.Command {
 				args[0],
 			)
 
+			msg.RegisterErc20, err = cmd.Flags().GetBool(FlagRegisterERC20)
+			if err != nil {
+				return err
+			}
+
+			maxSupply, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupply != "" {
+				var ok bool
+				msg.MaxSupply, ok = sdkmath.NewIntFromString(maxSupply)
+				if !ok {
+					return fmt.Errorf("invalid max supply: %s", maxSupply)
+				}
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagRegisterERC20, false, "Register the new denom as a native ERC20 token pair")
+	cmd.Flags().String(FlagMaxSupply, "", "Immutable max supply of the new denom, uncapped if empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -365,3 +403,356 @@ func NewModifyDenomMetadataCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the cosmwasm contract called before each send of a factory-created denom. Use an empty address to remove it. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Sets the immutable max supply of a factory-created denom that has no cap yet. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMinterCmd broadcast MsgSetMinter
+func NewSetMinterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-minter [denom] [minter] [allowance] [flags]",
+		Short: "Sets the mint allowance of a delegated minter of a factory-created denom. A zero allowance removes the minter. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			allowance, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid allowance: %s", args[2])
+			}
+
+			msg := types.NewMsgSetMinter(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				allowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeAccountCmd broadcast MsgFreezeAccount
+func NewFreezeAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account [denom] [account] [flags]",
+		Short: "Freezes an account so it can't send or receive a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgFreezeAccount(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnfreezeAccountCmd broadcast MsgUnfreezeAccount
+func NewUnfreezeAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account [denom] [account] [flags]",
+		Short: "Unfreezes an account frozen for a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgUnfreezeAccount(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseDenomCmd broadcast MsgPauseDenom
+func NewPauseDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-denom [denom] [paused] [flags]",
+		Short: "Pauses (true) or unpauses (false) all the transfers of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				paused,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: "Grants a role (minter, burner, metadata or force_transfer) of a factory-created denom to an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revokes a role (minter, burner, metadata or force_transfer) of a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceAdminCmd broadcast MsgRenounceAdmin
+func NewRenounceAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-admin [denom] [flags]",
+		Short: "Permanently renounces the admin of a factory-created denom, making it immutable. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRenounceAdmin(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMultiMintCmd broadcast MsgMultiMint
+func NewMultiMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-mint [denom] [address:amount,...] [flags]",
+		Short:   "Mint a denom to many recipients at once. Must have the minter role or a mint allowance.",
+		Example: "multi-mint factory/kii1.../token kii1abc...:100,kii1def...:250",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			recipients := []types.MintRecipient{}
+			for _, entry := range strings.Split(args[1], ",") {
+				address, amountStr, found := strings.Cut(entry, ":")
+				if !found {
+					return fmt.Errorf("invalid recipient %s, expected address:amount", entry)
+				}
+
+				amount, ok := sdkmath.NewIntFromString(amountStr)
+				if !ok {
+					return fmt.Errorf("invalid amount %s", amountStr)
+				}
+
+				recipients = append(recipients, types.MintRecipient{Address: address, Amount: amount})
+			}
+
+			msg := types.NewMsgMultiMint(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				recipients,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
@@ -40,7 +42,9 @@ func (k Keeper) setAuthorityMetadata(ctx context.Context, denom string, metadata
 	return nil
 }
 
+// setAdmin changes the admin of a denom, the roles held by the previous admin move to the new one
 func (k Keeper) setAdmin(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, admin string) error {
+	metadata.TransferRoles(metadata.Admin, admin)
 	metadata.Admin = admin
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
@@ -64,3 +68,40 @@ func (k Keeper) GetDenomsFromAdmin(ctx context.Context, admin string) ([]string,
 	}
 	return denoms, nil
 }
+
+// grantRole grants a role of a denom to an address
+func (k Keeper) grantRole(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, role string, address string) error {
+	if err := metadata.GrantRole(role, address); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// revokeRole revokes a role of a denom from an address
+func (k Keeper) revokeRole(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, role string, address string) error {
+	if err := metadata.RevokeRole(role, address); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// renounceAdmin permanently removes the admin, the roles, the mint allowances and the transfer restrictions of a denom
+func (k Keeper) renounceAdmin(ctx sdk.Context, metadata types.DenomAuthorityMetadata, denom string) error {
+	metadata.Renounce()
+	if err := k.setAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	for _, minter := range k.GetMintAllowances(ctx, denom) {
+		if err := k.setMintAllowance(ctx, denom, minter.Minter, sdkmath.ZeroInt()); err != nil {
+			return err
+		}
+	}
+
+	// Nobody could lift the transfer restrictions anymore, so they are removed with the admin
+	k.setDenomPaused(ctx, denom, false)
+	k.clearFrozenAccounts(ctx, denom)
+	return k.setBeforeSendHook(ctx, denom, "")
+}
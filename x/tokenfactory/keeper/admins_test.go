@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -584,3 +586,72 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRenounceAdmin() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+
+	// Give the denom an operator and a delegated minter
+	_, err := suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleBurner, minter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetMinter(suite.Ctx, types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+
+	// Restrict the transfers of the denom
+	_, err = suite.msgServer.FreezeAccount(suite.Ctx, types.NewMsgFreezeAccount(admin, suite.defaultDenom, minter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.PauseDenom(suite.Ctx, types.NewMsgPauseDenom(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(admin, suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+
+	// Only the admin can renounce
+	_, err = suite.msgServer.RenounceAdmin(suite.Ctx, types.NewMsgRenounceAdmin(minter, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.RenounceAdmin(ctx, types.NewMsgRenounceAdmin(admin, suite.defaultDenom))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgRenounceAdmin, 1)
+
+	// The denom is immutable, without admin, roles or allowances
+	queryRes, err := suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{Immutable: true}, queryRes.AuthorityMetadata)
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetMintAllowances(suite.Ctx, suite.defaultDenom))
+
+	// The transfer restrictions are lifted, nobody could remove them anymore
+	suite.Require().False(suite.App.TokenFactoryKeeper.IsDenomPaused(suite.Ctx, suite.defaultDenom))
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetFrozenAccounts(suite.Ctx, suite.defaultDenom))
+	suite.Require().Empty(suite.App.TokenFactoryKeeper.GetBeforeSendHook(suite.Ctx, suite.defaultDenom))
+
+	// Minting and metadata are locked
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(admin, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom, Exponent: 0}},
+		Base:       suite.defaultDenom,
+		Display:    suite.defaultDenom,
+		Name:       suite.defaultDenom,
+		Symbol:     suite.defaultDenom,
+	}))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+
+	// Admin operations are rejected
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, admin))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, admin))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+	_, err = suite.msgServer.RenounceAdmin(suite.Ctx, types.NewMsgRenounceAdmin(admin, suite.defaultDenom))
+	suite.Require().ErrorIs(err, types.ErrDenomImmutable)
+
+	// Previous role holders lost their roles
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
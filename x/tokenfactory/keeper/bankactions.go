@@ -7,7 +7,10 @@ import (
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
@@ -38,6 +41,40 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		sdk.NewCoins(amount))
 }
 
+// multiMintTo mints the total amount once to the module and distributes it with a single multi-send
+func (k Keeper) multiMintTo(ctx sdk.Context, denom string, recipients []types.MintRecipient) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	total := sdk.NewCoin(denom, sdkmath.ZeroInt())
+	outputs := make([]banktypes.Output, 0, len(recipients))
+	for _, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return fmt.Errorf("failed to mint to blocked address: %s", addr)
+		}
+
+		amount := sdk.NewCoin(denom, recipient.Amount)
+		total = total.Add(amount)
+		outputs = append(outputs, banktypes.NewOutput(addr, sdk.NewCoins(amount)))
+	}
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(total))
+	if err != nil {
+		return err
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+	return k.bankKeeper.InputOutputCoins(ctx, banktypes.NewInput(moduleAddr, sdk.NewCoins(total)), outputs)
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// setBeforeSendHook stores the contract called before each send of a denom.
+// An empty address removes the hook
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	// delete the hook if the address is empty
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return nil
+	}
+
+	_, err = sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+	return nil
+}
+
+// GetBeforeSendHook returns the contract registered as the before send hook of a denom
+func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz := store.Get([]byte(types.BeforeSendHookAddressKey))
+	if bz == nil {
+		return ""
+	}
+
+	return string(bz)
+}
+
+// BlockBeforeSend is a bank send restriction that calls the before send hook of
+// every factory denom being transferred, the transfer is rejected if any hook errors
+func (k Keeper) BlockBeforeSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, coin := range amount {
+		if err := k.callBeforeSendListener(sdkCtx, fromAddr, toAddr, coin); err != nil {
+			return toAddr, err
+		}
+	}
+
+	return toAddr, nil
+}
+
+// callBeforeSendListener calls the before send hook contract of the coin denom, if any,
+// under a capped gas meter
+func (k Keeper) callBeforeSendListener(ctx sdk.Context, from, to sdk.AccAddress, coin sdk.Coin) (err error) {
+	// Only factory denoms can have hooks
+	if _, _, err := types.DeconstructDenom(coin.Denom); err != nil {
+		return nil
+	}
+
+	cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
+	if cosmwasmAddress == "" || k.contractKeeper == nil {
+		return nil
+	}
+
+	cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := json.Marshal(types.BlockBeforeSendSudoMsg{
+		BlockBeforeSend: types.BlockBeforeSendMsg{
+			From:   from.String(),
+			To:     to.String(),
+			Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Call the contract with a capped gas meter, the events are dropped
+	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.BeforeSendHookGasLimit)).
+		WithEventManager(sdk.NewEventManager())
+
+	// Any panic, like running out of gas, is turned into an error so the send is rejected
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(types.ErrBeforeSendHookOutOfGas, "denom: %s", coin.Denom)
+			} else {
+				err = errorsmod.Wrapf(types.ErrBeforeSendHookPanic, "denom: %s: %v", coin.Denom, r)
+			}
+		}
+
+		// Consume the gas used by the contract on the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "track before send gas")
+	}()
+
+	_, err = k.contractKeeper.Sudo(childCtx, cwAddr, msgBz)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// mockContractKeeper records the sudo calls and runs a custom handler
+type mockContractKeeper struct {
+	calls   [][]byte
+	handler func(ctx sdk.Context) error
+}
+
+// Sudo implements types.ContractKeeper
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls = append(m.calls, msg)
+	if m.handler != nil {
+		return nil, m.handler(ctx)
+	}
+	return nil, nil
+}
+
+// TestSetBeforeSendHookMsg tests setting and removing the before send hook
+func (suite *KeeperTestSuite) TestSetBeforeSendHookMsg() {
+	for _, tc := range []struct {
+		desc            string
+		sender          func() string
+		cosmwasmAddress func() string
+		expectPass      bool
+	}{
+		{
+			desc:            "admin sets the hook",
+			sender:          func() string { return suite.TestAccs[0].String() },
+			cosmwasmAddress: func() string { return suite.TestAccs[2].String() },
+			expectPass:      true,
+		},
+		{
+			desc:            "admin removes the hook",
+			sender:          func() string { return suite.TestAccs[0].String() },
+			cosmwasmAddress: func() string { return "" },
+			expectPass:      true,
+		},
+		{
+			desc:            "non-admin can't set the hook",
+			sender:          func() string { return suite.TestAccs[1].String() },
+			cosmwasmAddress: func() string { return suite.TestAccs[2].String() },
+			expectPass:      false,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			_, err := suite.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(tc.sender(), suite.defaultDenom, tc.cosmwasmAddress()))
+			if !tc.expectPass {
+				suite.Require().ErrorIs(err, types.ErrUnauthorized)
+				suite.AssertEventEmitted(ctx, types.TypeMsgSetBeforeSendHook, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeMsgSetBeforeSendHook, 1)
+
+			// The query returns the stored hook
+			res, err := suite.queryClient.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{Denom: suite.defaultDenom})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.cosmwasmAddress(), res.CosmwasmAddress)
+		})
+	}
+}
+
+// TestBlockBeforeSend tests the send restriction calling the before send hook
+func (suite *KeeperTestSuite) TestBlockBeforeSend() {
+	for _, tc := range []struct {
+		desc          string
+		setHook       bool
+		denom         func() string
+		handler       func(ctx sdk.Context) error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			desc:          "no hook set is a no-op",
+			denom:         func() string { return suite.defaultDenom },
+			expectedCalls: 0,
+		},
+		{
+			desc:          "non factory denoms are skipped",
+			setHook:       true,
+			denom:         func() string { return "utwo" },
+			expectedCalls: 0,
+		},
+		{
+			desc:          "hook allows the send",
+			setHook:       true,
+			denom:         func() string { return suite.defaultDenom },
+			expectedCalls: 1,
+		},
+		{
+			desc:    "hook rejects the send",
+			setHook: true,
+			denom:   func() string { return suite.defaultDenom },
+			handler: func(sdk.Context) error {
+				return errors.New("blocked")
+			},
+			expectedCalls: 1,
+			expectedErr:   errors.New("blocked"),
+		},
+		{
+			desc:    "hook running out of gas rejects the send",
+			setHook: true,
+			denom:   func() string { return suite.defaultDenom },
+			handler: func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(types.BeforeSendHookGasLimit+1, "test")
+				return nil
+			},
+			expectedCalls: 1,
+			expectedErr:   types.ErrBeforeSendHookOutOfGas,
+		},
+		{
+			desc:    "hook panicking rejects the send",
+			setHook: true,
+			denom:   func() string { return suite.defaultDenom },
+			handler: func(sdk.Context) error {
+				panic("boom")
+			},
+			expectedCalls: 1,
+			expectedErr:   types.ErrBeforeSendHookPanic,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+
+			// Use a copy of the keeper with a mocked contract keeper
+			contractKeeper := &mockContractKeeper{handler: tc.handler}
+			tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+			tokenFactoryKeeper.SetContractKeeper(contractKeeper)
+
+			if tc.setHook {
+				_, err := suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+				suite.Require().NoError(err)
+			}
+
+			ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			amount := sdk.NewCoins(sdk.NewInt64Coin(tc.denom(), 10))
+			_, err := tokenFactoryKeeper.BlockBeforeSend(ctx, suite.TestAccs[0], suite.TestAccs[1], amount)
+			suite.Require().Len(contractKeeper.calls, tc.expectedCalls)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				if errors.Is(tc.expectedErr, types.ErrBeforeSendHookOutOfGas) {
+					// The gas used by the hook is charged on the parent ctx
+					suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(types.BeforeSendHookGasLimit))
+				}
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Check the sudo message sent to the contract
+			if tc.expectedCalls > 0 {
+				var sudoMsg types.BlockBeforeSendSudoMsg
+				suite.Require().NoError(json.Unmarshal(contractKeeper.calls[0], &sudoMsg))
+				suite.Require().Equal(suite.TestAccs[0].String(), sudoMsg.BlockBeforeSend.From)
+				suite.Require().Equal(suite.TestAccs[1].String(), sudoMsg.BlockBeforeSend.To)
+				suite.Require().Equal("10", sudoMsg.BlockBeforeSend.Amount.Amount)
+			}
+		})
+	}
+}
@@ -40,9 +40,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 
 	k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
 
-	authorityMetadata := types.DenomAuthorityMetadata{
-		Admin: creatorAddr,
-	}
+	authorityMetadata := types.NewDenomAuthorityMetadata(creatorAddr)
 	err = k.setAuthorityMetadata(ctx, denom, authorityMetadata)
 	if err != nil {
 		return err
@@ -75,26 +73,27 @@ func (k Keeper) validateCreateDenom(ctx sdk.Context, creatorAddr string, subdeno
 
 func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, _ string) (err error) {
 	params := k.GetParams(ctx)
+	denomCreationFee := k.GetDenomCreationFee(ctx)
 
 	// if DenomCreationFee is non-zero, transfer the tokens from the creator
 	// account to community pool
-	if params.DenomCreationFee != nil {
+	if denomCreationFee != nil {
 		accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
 		if err != nil {
 			return err
 		}
 
 		if types.IsCapabilityEnabled(k.enabledCapabilities, types.EnableCommunityPoolFeeFunding) {
-			if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr); err != nil {
+			if err := k.communityPoolKeeper.FundCommunityPool(ctx, denomCreationFee, accAddr); err != nil {
 				return err
 			}
 		} else {
-			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, params.DenomCreationFee)
+			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, denomCreationFee)
 			if err != nil {
 				return err
 			}
 
-			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee)
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, denomCreationFee)
 			if err != nil {
 				return err
 			}
@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/kiichain/kiichain/v3/app/params"
@@ -58,6 +59,57 @@ func (suite *KeeperTestSuite) TestMsgCreateDenom() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestAllDenoms() {
+	suite.SetupTest()
+
+	// Create denoms from two creators
+	denoms := []string{}
+	for _, creator := range []sdk.AccAddress{suite.TestAccs[0], suite.TestAccs[1]} {
+		for _, subdenom := range []string{"bitcoin", "litecoin"} {
+			res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(creator.String(), subdenom))
+			suite.Require().NoError(err)
+			denoms = append(denoms, res.GetNewTokenDenom())
+		}
+	}
+
+	// Mint some supply of the first denom
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denoms[0], 10)))
+	suite.Require().NoError(err)
+
+	// Page through all the denoms
+	found := []types.DenomInfo{}
+	var nextKey []byte
+	for {
+		res, err := suite.queryClient.AllDenoms(suite.Ctx.Context(), &types.QueryAllDenomsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 3},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Denoms), 3)
+
+		found = append(found, res.Denoms...)
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Require().Len(found, len(denoms))
+
+	for _, denomInfo := range found {
+		suite.Require().Contains(denoms, denomInfo.Denom)
+		suite.Require().Equal(denomInfo.Denom, denomInfo.Metadata.Base)
+
+		creator, _, err := types.DeconstructDenom(denomInfo.Denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(creator, denomInfo.AuthorityMetadata.Admin)
+
+		expectedSupply := sdk.NewInt64Coin(denomInfo.Denom, 0)
+		if denomInfo.Denom == denoms[0] {
+			expectedSupply = sdk.NewInt64Coin(denomInfo.Denom, 10)
+		}
+		suite.Require().Equal(expectedSupply.String(), denomInfo.Supply.String())
+	}
+}
+
 func (suite *KeeperTestSuite) TestCreateDenom() {
 	var (
 		primaryDenom            = types.DefaultParams().DenomCreationFee[0].Denom
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// GetDenomCreationFee returns the fee charged to create a denom. When the quote fee is set it's
+// converted with the oracle TWAP, falling back to the fixed fee if the oracle price is stale
+func (k Keeper) GetDenomCreationFee(ctx sdk.Context) sdk.Coins {
+	params := k.GetParams(ctx)
+	quoteFee := params.DenomCreationQuoteFee
+	if !quoteFee.IsEnabled() || k.oracleKeeper == nil {
+		return params.DenomCreationFee
+	}
+
+	fee, ok := k.convertQuoteFee(ctx, quoteFee)
+	if !ok {
+		return params.DenomCreationFee
+	}
+	return fee
+}
+
+// convertQuoteFee converts the quote fee to the fee denom, it returns false if the oracle price is stale
+func (k Keeper) convertQuoteFee(ctx sdk.Context, quoteFee types.DenomCreationQuoteFee) (sdk.Coins, bool) {
+	// The TWAP is the price of a unit of the fee denom in the quote asset
+	prices, err := k.oracleKeeper.GetRecentTwaps(
+		ctx,
+		[]string{quoteFee.OracleDenom},
+		quoteFee.MaxPriceAgeSeconds,
+		quoteFee.TwapLookbackSeconds,
+	)
+	if err != nil {
+		return nil, false
+	}
+
+	// Round up so the fee is never worth less than the quote amount
+	amount := quoteFee.QuoteAmount.
+		MulInt(sdkmath.NewIntWithDecimal(1, int(quoteFee.FeeDenomExponent))).
+		Quo(prices[quoteFee.OracleDenom]).
+		Ceil().
+		TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(quoteFee.FeeDenom, amount)), true
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/kiichain/kiichain/v3/x/oracle/types"
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// setOraclePrice stores a fresh exchange rate and a snapshot one minute old for the oracle denom
+func (suite *KeeperTestSuite) setOraclePrice(denom string, price sdkmath.LegacyDec) {
+	oracleKeeper := suite.App.OracleKeeper
+
+	err := oracleKeeper.VoteTarget.Set(suite.Ctx, denom, oracletypes.Denom{Name: denom})
+	suite.Require().NoError(err)
+	err = oracleKeeper.SetBaseExchangeRateWithDefault(suite.Ctx, denom, price)
+	suite.Require().NoError(err)
+	err = oracleKeeper.AddPriceSnapshot(suite.Ctx, oracletypes.PriceSnapshot{
+		SnapshotTimestamp: suite.Ctx.BlockTime().Unix() - 60,
+		PriceSnapshotItems: oracletypes.PriceSnapshotItems{
+			{
+				Denom: denom,
+				OracleExchangeRate: oracletypes.OracleExchangeRate{
+					ExchangeRate:        price,
+					LastUpdate:          sdkmath.NewInt(suite.Ctx.BlockHeight()),
+					LastUpdateTimestamp: suite.Ctx.BlockTime().UnixMilli(),
+				},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestDenomCreationQuoteFee() {
+	suite.SetupTest()
+	feeDenom := types.DefaultParams().DenomCreationFee[0].Denom
+	fixedFee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1_000_000))
+
+	params := types.DefaultParams()
+	params.DenomCreationFee = fixedFee
+	params.DenomCreationQuoteFee = types.DenomCreationQuoteFee{
+		QuoteAmount:         sdkmath.LegacyNewDec(10),
+		FeeDenom:            feeDenom,
+		FeeDenomExponent:    6,
+		OracleDenom:         "kii",
+		TwapLookbackSeconds: 600,
+		MaxPriceAgeSeconds:  300,
+	}
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+	// Without oracle price the fixed fee is charged
+	suite.Require().Equal(fixedFee, suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx))
+
+	// With a price of 0.5 the fee is 10 / 0.5 units of the fee denom
+	suite.setOraclePrice("kii", sdkmath.LegacyMustNewDecFromStr("0.5"))
+	quoteFee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 20_000_000))
+	suite.Require().Equal(quoteFee, suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx))
+
+	// The effective fee is returned by the params query
+	res, err := suite.queryClient.Params(suite.Ctx.Context(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(quoteFee, res.EffectiveDenomCreationFee)
+	suite.Require().Equal(fixedFee, res.Params.DenomCreationFee)
+
+	// Creating a denom charges the converted fee
+	preCreateBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], feeDenom)
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(suite.TestAccs[0].String(), "bitcoin"))
+	suite.Require().NoError(err)
+	postCreateBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], feeDenom)
+	suite.Require().Equal(quoteFee[0].String(), preCreateBalance.Sub(postCreateBalance).String())
+
+	// Once the price is stale the fixed fee is charged again
+	staleCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(301 * time.Second))
+	suite.Require().Equal(fixedFee, suite.App.TokenFactoryKeeper.GetDenomCreationFee(staleCtx))
+
+	// A disabled quote fee charges the fixed fee
+	params.DenomCreationQuoteFee.QuoteAmount = sdkmath.LegacyZeroDec()
+	suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+	suite.Require().Equal(fixedFee, suite.App.TokenFactoryKeeper.GetDenomCreationFee(suite.Ctx))
+}
@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/store"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
 
 func (k Keeper) addDenomFromCreator(ctx context.Context, creator, denom string) {
@@ -29,3 +31,19 @@ func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) []stri
 func (k Keeper) GetAllDenomsIterator(ctx context.Context) store.Iterator {
 	return k.GetCreatorsPrefixStore(sdk.UnwrapSDKContext(ctx)).Iterator(nil, nil)
 }
+
+// GetDenomInfo returns the bank metadata, the authority metadata and the supply of a denom
+func (k Keeper) GetDenomInfo(ctx context.Context, denom string) (types.DenomInfo, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.DenomInfo{}, err
+	}
+
+	metadata, _ := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	return types.DenomInfo{
+		Denom:             denom,
+		Metadata:          metadata,
+		AuthorityMetadata: authorityMetadata,
+		Supply:            k.bankKeeper.GetSupply(ctx, denom),
+	}, nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// GetERC20TokenAddress derives the ERC20 address of a factory denom token pair
+// The address is the last 20 bytes of the keccak256 hash of the denom
+func GetERC20TokenAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(denom)))
+}
+
+// registerERC20 registers a factory denom as a native ERC20 token pair on x/erc20
+// The ERC20 is a dynamic precompile that reads the denom balances and metadata from bank
+func (k Keeper) registerERC20(ctx sdk.Context, denom string) (common.Address, error) {
+	if k.erc20Keeper.IsDenomRegistered(ctx, denom) {
+		return common.Address{}, errorsmod.Wrapf(erc20types.ErrTokenPairAlreadyExists, "denom: %s", denom)
+	}
+
+	// The created denom metadata must be readable by the ERC20
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return common.Address{}, types.ErrDenomDoesNotExist.Wrapf("denom: %s", denom)
+	}
+	if err := types.ValidateERC20Metadata(metadata); err != nil {
+		return common.Address{}, err
+	}
+
+	address := GetERC20TokenAddress(denom)
+	if k.erc20Keeper.IsERC20Registered(ctx, address) {
+		return common.Address{}, errorsmod.Wrapf(erc20types.ErrTokenPairAlreadyExists, "erc20: %s", address.Hex())
+	}
+
+	// Store the pair and enable the ERC20 precompile
+	pair := erc20types.NewTokenPair(address, denom, erc20types.OWNER_MODULE)
+	k.erc20Keeper.SetToken(ctx, pair)
+	if err := k.erc20Keeper.EnableDynamicPrecompiles(ctx, address); err != nil {
+		return common.Address{}, err
+	}
+
+	return address, nil
+}
+
+// GetERC20Address returns the ERC20 address of the token pair of a denom, if any
+func (k Keeper) GetERC20Address(ctx sdk.Context, denom string) (common.Address, bool) {
+	id := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	if len(id) == 0 {
+		return common.Address{}, false
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, id)
+	if !found {
+		return common.Address{}, false
+	}
+
+	return pair.GetERC20Contract(), true
+}
+
+// emitERC20MetadataUpdated emits the new name, symbol and decimals of an ERC20 token pair
+func (k Keeper) emitERC20MetadataUpdated(ctx sdk.Context, erc20Address common.Address, metadata banktypes.Metadata) {
+	decimals, _ := types.GetERC20Decimals(metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeERC20MetadataUpdated,
+			sdk.NewAttribute(types.AttributeDenom, metadata.Base),
+			sdk.NewAttribute(types.AttributeERC20Address, erc20Address.Hex()),
+			sdk.NewAttribute(types.AttributeName, metadata.Name),
+			sdk.NewAttribute(types.AttributeSymbol, metadata.Symbol),
+			sdk.NewAttribute(types.AttributeDecimals, strconv.FormatUint(uint64(decimals), 10)),
+		),
+	)
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TestCreateDenomERC20Registration tests the ERC20 token pair registration on denom creation
+func (suite *KeeperTestSuite) TestCreateDenomERC20Registration() {
+	for _, tc := range []struct {
+		desc           string
+		enableParam    bool
+		registerERC20  bool
+		expectedErr    error
+		expectERC20    bool
+		expectedEvents int
+	}{
+		{
+			desc:           "denom without the flag is not registered",
+			enableParam:    true,
+			registerERC20:  false,
+			expectERC20:    false,
+			expectedEvents: 1,
+		},
+		{
+			desc:          "flag is rejected when the param is disabled",
+			enableParam:   false,
+			registerERC20: true,
+			expectedErr:   types.ErrERC20NotEnabled,
+		},
+		{
+			desc:           "denom is registered as an erc20",
+			enableParam:    true,
+			registerERC20:  true,
+			expectERC20:    true,
+			expectedEvents: 1,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			params := suite.App.TokenFactoryKeeper.GetParams(ctx)
+			params.EnableErc20AutoRegistration = tc.enableParam
+			suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(ctx, params))
+
+			msg := types.NewMsgCreateDenom(suite.TestAccs[0].String(), "bitcoin")
+			msg.RegisterErc20 = tc.registerERC20
+			res, err := suite.msgServer.CreateDenom(ctx, msg)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeMsgCreateDenom, tc.expectedEvents)
+
+			denom := res.GetNewTokenDenom()
+			suite.Require().Equal(tc.expectERC20, suite.App.Erc20Keeper.IsDenomRegistered(ctx, denom))
+
+			erc20Address, found := suite.App.TokenFactoryKeeper.GetERC20Address(ctx, denom)
+			suite.Require().Equal(tc.expectERC20, found)
+			if !tc.expectERC20 {
+				return
+			}
+
+			// The pair uses the derived address and the ERC20 precompile is enabled
+			suite.Require().Equal(keeper.GetERC20TokenAddress(denom), erc20Address)
+			erc20Params := suite.App.Erc20Keeper.GetParams(ctx)
+			suite.Require().True(erc20Params.IsDynamicPrecompile(erc20Address))
+
+			// The create denom event has the erc20 address
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.TypeMsgCreateDenom {
+					continue
+				}
+				attr, found := event.GetAttribute(types.AttributeERC20Address)
+				suite.Require().True(found)
+				suite.Require().Equal(erc20Address.Hex(), attr.Value)
+			}
+		})
+	}
+}
+
+// TestSetDenomMetadataERC20Propagation tests the metadata updates of denoms with an ERC20 token pair
+func (suite *KeeperTestSuite) TestSetDenomMetadataERC20Propagation() {
+	for _, tc := range []struct {
+		desc           string
+		registerERC20  bool
+		exponent       uint32
+		expectedErr    error
+		expectedEvents int
+	}{
+		{
+			desc:           "metadata is propagated to the erc20",
+			registerERC20:  true,
+			exponent:       6,
+			expectedEvents: 1,
+		},
+		{
+			desc:          "decimals overflowing the erc20 are rejected",
+			registerERC20: true,
+			exponent:      256,
+			expectedErr:   types.ErrInvalidERC20Metadata,
+		},
+		{
+			desc:           "denoms without erc20 are not limited",
+			registerERC20:  false,
+			exponent:       256,
+			expectedEvents: 0,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+
+			params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+			params.EnableErc20AutoRegistration = true
+			suite.Require().NoError(suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params))
+
+			msg := types.NewMsgCreateDenom(suite.TestAccs[0].String(), "bitcoin")
+			msg.RegisterErc20 = tc.registerERC20
+			res, err := suite.msgServer.CreateDenom(suite.Ctx, msg)
+			suite.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			metadata := banktypes.Metadata{
+				Description: "Bitcoin",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: "BTC", Exponent: tc.exponent},
+				},
+				Base:    denom,
+				Display: "BTC",
+				Name:    "Bitcoin",
+				Symbol:  "BTC",
+			}
+			_, err = suite.msgServer.SetDenomMetadata(ctx, types.NewMsgSetDenomMetadata(suite.TestAccs[0].String(), metadata))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.EventTypeERC20MetadataUpdated, tc.expectedEvents)
+		})
+	}
+}
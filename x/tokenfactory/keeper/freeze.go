@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// setAccountFrozen freezes or unfreezes an account for a denom
+func (k Keeper) setAccountFrozen(ctx sdk.Context, denom string, account string, frozen bool) error {
+	accountAddr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return err
+	}
+
+	store := k.getFrozenAccountsStore(ctx, denom)
+
+	if !frozen {
+		store.Delete(accountAddr)
+		return nil
+	}
+
+	store.Set(accountAddr, []byte{1})
+	return nil
+}
+
+// IsFrozen returns if an account is frozen for a denom
+func (k Keeper) IsFrozen(ctx sdk.Context, denom string, account sdk.AccAddress) bool {
+	return k.getFrozenAccountsStore(ctx, denom).Has(account)
+}
+
+// GetFrozenAccounts returns all the frozen accounts of a denom
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, denom string) []string {
+	store := k.getFrozenAccountsStore(ctx, denom)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	accounts := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		accounts = append(accounts, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return accounts
+}
+
+// clearFrozenAccounts unfreezes all the frozen accounts of a denom
+func (k Keeper) clearFrozenAccounts(ctx sdk.Context, denom string) {
+	store := k.getFrozenAccountsStore(ctx, denom)
+
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// setDenomPaused pauses or unpauses all the transfers of a denom
+func (k Keeper) setDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	if !paused {
+		store.Delete([]byte(types.PausedKey))
+		return
+	}
+
+	store.Set([]byte(types.PausedKey), []byte{1})
+}
+
+// IsDenomPaused returns if all the transfers of a denom are paused
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.PausedKey))
+}
+
+// BlockFrozenSend is a bank send restriction that rejects transfers of paused
+// denoms and transfers from or to accounts frozen for the denom
+func (k Keeper) BlockFrozenSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, coin := range amount {
+		// Only factory denoms can be frozen
+		if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if k.IsDenomPaused(sdkCtx, coin.Denom) {
+			return toAddr, types.ErrDenomPaused.Wrapf("denom: %s", coin.Denom)
+		}
+
+		if k.IsFrozen(sdkCtx, coin.Denom, fromAddr) {
+			return toAddr, types.ErrAccountFrozen.Wrapf("denom: %s, account: %s", coin.Denom, fromAddr)
+		}
+
+		if k.IsFrozen(sdkCtx, coin.Denom, toAddr) {
+			return toAddr, types.ErrAccountFrozen.Wrapf("denom: %s, account: %s", coin.Denom, toAddr)
+		}
+	}
+
+	return toAddr, nil
+}
+
+// getFrozenAccountsStore returns the substore with the frozen accounts of a denom
+func (k Keeper) getFrozenAccountsStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAccountsPrefix())
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TestFreezeAccount tests freezing and unfreezing accounts for a denom
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 500)))
+	suite.Require().NoError(err)
+
+	// Only the admin can freeze accounts
+	_, err = suite.msgServer.FreezeAccount(suite.Ctx, types.NewMsgFreezeAccount(holder.String(), suite.defaultDenom, holder.String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.FreezeAccount(ctx, types.NewMsgFreezeAccount(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgFreezeAccount, 1)
+
+	frozenRes, err := suite.queryClient.IsAccountFrozen(suite.Ctx.Context(), &types.QueryIsAccountFrozenRequest{Denom: suite.defaultDenom, Account: holder.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(frozenRes.Frozen)
+
+	accountsRes, err := suite.queryClient.FrozenAccounts(suite.Ctx.Context(), &types.QueryFrozenAccountsRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder.String()}, accountsRes.Accounts)
+
+	// The frozen account can't send nor receive the denom
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, holder, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+	// Other denoms are not affected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin("utwo", 1)))
+	suite.Require().NoError(err)
+
+	// Other accounts are not affected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, suite.TestAccs[2], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().NoError(err)
+
+	// The account can send again once unfrozen
+	ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.UnfreezeAccount(ctx, types.NewMsgUnfreezeAccount(admin.String(), suite.defaultDenom, holder.String()))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgUnfreezeAccount, 1)
+
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, holder, admin, sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().NoError(err)
+}
+
+// TestPauseDenom tests pausing all the transfers of a denom
+func (suite *KeeperTestSuite) TestPauseDenom() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	// Only the admin can pause the denom
+	_, err = suite.msgServer.PauseDenom(suite.Ctx, types.NewMsgPauseDenom(suite.TestAccs[1].String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.PauseDenom(ctx, types.NewMsgPauseDenom(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgPauseDenom, 1)
+
+	pausedRes, err := suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().True(pausedRes.Paused)
+
+	// Transfers and mints are rejected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// Transfers are accepted once unpaused
+	_, err = suite.msgServer.PauseDenom(suite.Ctx, types.NewMsgPauseDenom(admin.String(), suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, admin, suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().NoError(err)
+}
+
+// TestFreezeCapabilityNotEnabled tests that freezing requires the capability
+func (suite *KeeperTestSuite) TestFreezeCapabilityNotEnabled() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	_, err := suite.msgServer.FreezeAccount(suite.Ctx, types.NewMsgFreezeAccount(admin, suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
+	_, err = suite.msgServer.UnfreezeAccount(suite.Ctx, types.NewMsgUnfreezeAccount(admin, suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
+	_, err = suite.msgServer.PauseDenom(suite.Ctx, types.NewMsgPauseDenom(admin, suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
+}
@@ -29,6 +29,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
+		if err != nil {
+			panic(err)
+		}
+		if !genDenom.MaxSupply.IsNil() && genDenom.MaxSupply.IsPositive() {
+			err = k.setMaxSupply(ctx, genDenom.GetDenom(), genDenom.MaxSupply)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, minter := range genDenom.GetMinters() {
+			err = k.setMintAllowance(ctx, genDenom.GetDenom(), minter.GetMinter(), minter.Allowance)
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.setDenomPaused(ctx, genDenom.GetDenom(), genDenom.GetPaused())
+		for _, account := range genDenom.GetFrozenAccounts() {
+			err = k.setAccountFrozen(ctx, genDenom.GetDenom(), account, true)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -45,9 +68,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
+		maxSupply, _ := k.GetMaxSupply(ctx, denom)
+
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			BeforeSendHookAddress: k.GetBeforeSendHook(ctx, denom),
+			MaxSupply:             maxSupply,
+			Minters:               k.GetMintAllowances(ctx, denom),
+			Paused:                k.IsDenomPaused(ctx, denom),
+			FrozenAccounts:        k.GetFrozenAccounts(ctx, denom),
 		})
 	}
 
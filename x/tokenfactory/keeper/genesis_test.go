@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -9,24 +11,40 @@ import (
 
 func (suite *KeeperTestSuite) TestGenesis() {
 	genesisState := types.GenesisState{
+		Params: types.Params{
+			DenomCreationQuoteFee: types.DenomCreationQuoteFee{QuoteAmount: sdkmath.LegacyZeroDec()},
+		},
 		FactoryDenoms: []types.GenesisDenom{
 			{
 				Denom: "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/bitcoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c",
 				},
+				MaxSupply:      sdkmath.ZeroInt(),
+				Minters:        []types.MintAllowance{},
+				FrozenAccounts: []string{},
 			},
 			{
 				Denom: "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7",
 				},
+				MaxSupply:      sdkmath.ZeroInt(),
+				Minters:        []types.MintAllowance{},
+				Paused:         true,
+				FrozenAccounts: []string{},
 			},
 			{
 				Denom: "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c",
 				},
+				BeforeSendHookAddress: "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7",
+				MaxSupply:             sdkmath.NewInt(21_000_000),
+				Minters: []types.MintAllowance{
+					{Minter: "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7", Allowance: sdkmath.NewInt(1000)},
+				},
+				FrozenAccounts: []string{"kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c"},
 			},
 		},
 	}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
 )
@@ -13,8 +16,9 @@ var _ types.QueryServer = Keeper{}
 func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(sdkCtx)
+	effectiveFee := k.GetDenomCreationFee(sdkCtx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, EffectiveDenomCreationFee: effectiveFee}, nil
 }
 
 func (k Keeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
@@ -34,6 +38,12 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
 
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
 func (k Keeper) DenomsFromAdmin(ctx context.Context, req *types.QueryDenomsFromAdminRequest) (*types.QueryDenomsFromAdminResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	denoms, err := k.GetDenomsFromAdmin(sdkCtx, req.GetAdmin())
@@ -42,3 +52,86 @@ func (k Keeper) DenomsFromAdmin(ctx context.Context, req *types.QueryDenomsFromA
 	}
 	return &types.QueryDenomsFromAdminResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) DenomSupplyCap(ctx context.Context, req *types.QueryDenomSupplyCapRequest) (*types.QueryDenomSupplyCapResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	supply := k.bankKeeper.GetSupply(sdkCtx, req.GetDenom())
+	maxSupply, found := k.GetMaxSupply(sdkCtx, req.GetDenom())
+	if !found {
+		return &types.QueryDenomSupplyCapResponse{
+			MaxSupply: sdkmath.ZeroInt(),
+			Supply:    supply.Amount,
+			Remaining: sdkmath.ZeroInt(),
+		}, nil
+	}
+
+	return &types.QueryDenomSupplyCapResponse{
+		HasMaxSupply: true,
+		MaxSupply:    maxSupply,
+		Supply:       supply.Amount,
+		Remaining:    sdkmath.MaxInt(maxSupply.Sub(supply.Amount), sdkmath.ZeroInt()),
+	}, nil
+}
+
+func (k Keeper) MintAllowance(ctx context.Context, req *types.QueryMintAllowanceRequest) (*types.QueryMintAllowanceResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	minter, err := sdk.AccAddressFromBech32(req.GetMinter())
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, _ := k.GetMintAllowance(sdkCtx, req.GetDenom(), minter)
+	return &types.QueryMintAllowanceResponse{Allowance: allowance}, nil
+}
+
+func (k Keeper) MintAllowances(ctx context.Context, req *types.QueryMintAllowancesRequest) (*types.QueryMintAllowancesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	minters := k.GetMintAllowances(sdkCtx, req.GetDenom())
+	return &types.QueryMintAllowancesResponse{Minters: minters}, nil
+}
+
+func (k Keeper) DenomPaused(ctx context.Context, req *types.QueryDenomPausedRequest) (*types.QueryDenomPausedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	paused := k.IsDenomPaused(sdkCtx, req.GetDenom())
+	return &types.QueryDenomPausedResponse{Paused: paused}, nil
+}
+
+func (k Keeper) IsAccountFrozen(ctx context.Context, req *types.QueryIsAccountFrozenRequest) (*types.QueryIsAccountFrozenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	account, err := sdk.AccAddressFromBech32(req.GetAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	frozen := k.IsFrozen(sdkCtx, req.GetDenom(), account)
+	return &types.QueryIsAccountFrozenResponse{Frozen: frozen}, nil
+}
+
+func (k Keeper) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	accounts := k.GetFrozenAccounts(sdkCtx, req.GetDenom())
+	return &types.QueryFrozenAccountsResponse{Accounts: accounts}, nil
+}
+
+func (k Keeper) AllDenoms(ctx context.Context, req *types.QueryAllDenomsRequest) (*types.QueryAllDenomsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denoms := []types.DenomInfo{}
+	pageRes, err := query.Paginate(k.GetCreatorsPrefixStore(sdkCtx), req.GetPagination(), func(_, value []byte) error {
+		denomInfo, err := k.GetDenomInfo(sdkCtx, string(value))
+		if err != nil {
+			return err
+		}
+
+		denoms = append(denoms, denomInfo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
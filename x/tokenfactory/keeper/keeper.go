@@ -23,6 +23,9 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		erc20Keeper         types.ERC20Keeper
+		oracleKeeper        types.OracleKeeper
+		contractKeeper      types.ContractKeeper
 
 		enabledCapabilities []string
 
@@ -40,6 +43,8 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	erc20Keeper types.ERC20Keeper,
+	oracleKeeper types.OracleKeeper,
 	enabledCapabilities []string,
 	authority string,
 ) Keeper {
@@ -56,6 +61,8 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		erc20Keeper:         erc20Keeper,
+		oracleKeeper:        oracleKeeper,
 
 		authority: authority,
 
@@ -72,6 +79,12 @@ func (k Keeper) GetEnabledCapabilities() []string {
 	return k.enabledCapabilities
 }
 
+// SetContractKeeper sets the contract keeper used to call the before send hooks.
+// The wasm keeper is built after the tokenfactory keeper, so it's set later on
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
 func (k *Keeper) SetEnabledCapabilities(_ sdk.Context, newCapabilities []string) {
 	k.enabledCapabilities = newCapabilities
 }
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The admin of every existing denom
+// is granted all the denom roles
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	iterator := m.keeper.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+
+		metadata, err := m.keeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		// Denoms without admin keep no roles
+		if metadata.Admin == "" {
+			continue
+		}
+
+		for _, role := range types.GrantableRoles {
+			if metadata.HasRole(role, metadata.Admin) {
+				continue
+			}
+			if err := metadata.GrantRole(role, metadata.Admin); err != nil {
+				return err
+			}
+		}
+
+		if err := m.keeper.setAuthorityMetadata(ctx, denom, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 
@@ -30,17 +31,40 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The ERC20 registration must be enabled by governance
+	if msg.RegisterErc20 && !server.Keeper.GetParams(ctx).EnableErc20AutoRegistration {
+		return nil, types.ErrERC20NotEnabled
+	}
+
 	denom, err := server.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
 	if err != nil {
 		return nil, err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeCreator, msg.Sender),
+		sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+	}
+
+	// Cap the supply of the new denom
+	if !msg.MaxSupply.IsNil() && msg.MaxSupply.IsPositive() {
+		if err := server.Keeper.setMaxSupply(ctx, denom, msg.MaxSupply); err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()))
+	}
+
+	// Register the new denom as a native ERC20 token pair
+	if msg.RegisterErc20 {
+		erc20Address, err := server.Keeper.registerERC20(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeERC20Address, erc20Address.Hex()))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.TypeMsgCreateDenom,
-			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
-			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
-		),
+		sdk.NewEvent(types.TypeMsgCreateDenom, attributes...),
 	})
 
 	return &types.MsgCreateDenomResponse{
@@ -63,8 +87,19 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	// Delegated minters spend their allowance, minter role holders mint unboundedly
+	if !authorityMetadata.HasRole(types.RoleMinter, msg.Sender) {
+		if err := server.Keeper.spendMintAllowance(ctx, msg.Sender, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := server.Keeper.checkMaxSupply(ctx, msg.Amount); err != nil {
+		return nil, err
 	}
 
 	if msg.MintToAddress == "" {
@@ -95,7 +130,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleBurner, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -133,7 +168,7 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleForceTransfer, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -162,7 +197,11 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -199,10 +238,22 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleMetadata, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
+	// Denoms with an ERC20 token pair must keep metadata the ERC20 can read
+	erc20Address, hasERC20 := server.Keeper.GetERC20Address(ctx, msg.Metadata.Base)
+	if hasERC20 {
+		if err := types.ValidateERC20Metadata(msg.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
 	server.Keeper.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -213,9 +264,360 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		),
 	})
 
+	// Propagate the new metadata to the ERC20 token pair
+	if hasERC20 {
+		server.Keeper.emitERC20MetadataUpdated(ctx, erc20Address, msg.Metadata)
+	}
+
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
 
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.GetCosmwasmAddress()),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) SetMinter(goCtx context.Context, msg *types.MsgSetMinter) (*types.MsgSetMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMintAllowance(ctx, msg.Denom, msg.Minter, msg.Allowance)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMinter,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMinter, msg.GetMinter()),
+			sdk.NewAttribute(types.AttributeAllowance, msg.Allowance.String()),
+		),
+	})
+
+	return &types.MsgSetMinterResponse{}, nil
+}
+
+func (server msgServer) FreezeAccount(goCtx context.Context, msg *types.MsgFreezeAccount) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableFreeze) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setAccountFrozen(ctx, msg.Denom, msg.Account, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgFreezeAccount,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAccount, msg.GetAccount()),
+		),
+	})
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+func (server msgServer) UnfreezeAccount(goCtx context.Context, msg *types.MsgUnfreezeAccount) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableFreeze) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setAccountFrozen(ctx, msg.Denom, msg.Account, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAccount, msg.GetAccount()),
+		),
+	})
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+func (server msgServer) PauseDenom(goCtx context.Context, msg *types.MsgPauseDenom) (*types.MsgPauseDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableFreeze) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.Keeper.setDenomPaused(ctx, msg.Denom, msg.Paused)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgPauseDenom,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgPauseDenomResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.grantRole(ctx, authorityMetadata, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.GetRole()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeRole(ctx, authorityMetadata, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.GetRole()),
+			sdk.NewAttribute(types.AttributeAddress, msg.GetAddress()),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) RenounceAdmin(goCtx context.Context, msg *types.MsgRenounceAdmin) (*types.MsgRenounceAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	if !authorityMetadata.HasRole(types.RoleAdmin, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.renounceAdmin(ctx, authorityMetadata, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		),
+	})
+
+	return &types.MsgRenounceAdminResponse{}, nil
+}
+
+func (server msgServer) MultiMint(goCtx context.Context, msg *types.MsgMultiMint) (*types.MsgMultiMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Standard user verification
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.Immutable {
+		return nil, types.ErrDenomImmutable
+	}
+
+	// The checks run once over the total amount
+	total := msg.TotalAmount()
+	if !authorityMetadata.HasRole(types.RoleMinter, msg.Sender) {
+		if err := server.Keeper.spendMintAllowance(ctx, msg.Sender, total); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := server.Keeper.checkMaxSupply(ctx, total); err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.multiMintTo(ctx, msg.Denom, msg.Recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgMultiMint,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAmount, total.String()),
+			sdk.NewAttribute(types.AttributeRecipients, strconv.Itoa(len(msg.Recipients))),
+		),
+	})
+
+	return &types.MsgMultiMintResponse{}, nil
+}
+
 func (server msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if server.authority != req.Authority {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.authority, req.Authority)
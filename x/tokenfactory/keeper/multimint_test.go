@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMultiMint() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	recipients := []types.MintRecipient{
+		{Address: suite.TestAccs[1].String(), Amount: sdkmath.NewInt(100)},
+		{Address: suite.TestAccs[2].String(), Amount: sdkmath.NewInt(250)},
+	}
+
+	// Only minters can multi mint
+	_, err := suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(suite.TestAccs[1].String(), suite.defaultDenom, recipients))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.MultiMint(ctx, types.NewMsgMultiMint(admin, suite.defaultDenom, recipients))
+	suite.Require().NoError(err)
+
+	// A single aggregated event is emitted
+	suite.AssertEventEmitted(ctx, types.TypeMsgMultiMint, 1)
+	suite.AssertEventEmitted(ctx, types.TypeMsgMint, 0)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.TypeMsgMultiMint {
+			continue
+		}
+		amount, _ := event.GetAttribute(types.AttributeAmount)
+		suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 350).String(), amount.Value)
+		count, _ := event.GetAttribute(types.AttributeRecipients)
+		suite.Require().Equal("2", count.Value)
+	}
+
+	// The recipients got their amounts and nothing is left in the module
+	suite.Require().Equal(int64(100), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+	suite.Require().Equal(int64(250), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[2], suite.defaultDenom).Amount.Int64())
+	moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddr, suite.defaultDenom).IsZero())
+	suite.Require().Equal(int64(350), suite.App.BankKeeper.GetSupply(suite.Ctx, suite.defaultDenom).Amount.Int64())
+
+	// Blocked addresses can't receive the mint
+	blocked := suite.App.AccountKeeper.GetModuleAddress(banktypes.ModuleName)
+	_, err = suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(admin, suite.defaultDenom, []types.MintRecipient{
+		{Address: blocked.String(), Amount: sdkmath.NewInt(1)},
+	}))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMultiMintLimits() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+	recipients := []types.MintRecipient{
+		{Address: suite.TestAccs[1].String(), Amount: sdkmath.NewInt(60)},
+		{Address: suite.TestAccs[2].String(), Amount: sdkmath.NewInt(60)},
+	}
+
+	// Delegated minters spend their allowance on the total amount
+	_, err := suite.msgServer.SetMinter(suite.Ctx, types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(minter, suite.defaultDenom, recipients))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	_, err = suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(minter, suite.defaultDenom, recipients[:1]))
+	suite.Require().NoError(err)
+	allowance, found := suite.App.TokenFactoryKeeper.GetMintAllowance(suite.Ctx, suite.defaultDenom, suite.TestAccs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(40).String(), allowance.String())
+
+	// The max supply caps the total amount
+	_, err = suite.msgServer.SetMaxSupply(suite.Ctx, types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdkmath.NewInt(150)))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.MultiMint(suite.Ctx, types.NewMsgMultiMint(admin, suite.defaultDenom, recipients))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/keeper"
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TestDenomRoles tests that every handler is restricted to its role
+func (suite *KeeperTestSuite) TestDenomRoles() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	operator := suite.TestAccs[1].String()
+
+	// The creator holds all the roles
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	for _, role := range append(types.GrantableRoles, types.RoleAdmin) {
+		suite.Require().True(authorityMetadata.HasRole(role, admin), role)
+	}
+
+	// Force transfers require the capability
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{types.EnableSetMetadata, types.EnableForceTransfer})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	// Without roles the operator can't do anything
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(operator, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Only the admin can grant roles
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(operator, suite.defaultDenom, types.RoleMinter, operator))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	for _, role := range types.GrantableRoles {
+		ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+		_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, role, operator))
+		suite.Require().NoError(err)
+		suite.AssertEventEmitted(ctx, types.TypeMsgGrantRole, 1)
+	}
+
+	// Roles can't be granted twice
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, operator))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	// The operator uses its roles
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(operator, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(operator, sdk.NewInt64Coin(suite.defaultDenom, 5)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(operator, sdk.NewInt64Coin(suite.defaultDenom, 5), operator, admin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(operator, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: suite.defaultDenom, Exponent: 0}},
+		Base:       suite.defaultDenom,
+		Display:    suite.defaultDenom,
+		Name:       suite.defaultDenom,
+		Symbol:     suite.defaultDenom,
+	}))
+	suite.Require().NoError(err)
+
+	// But it can't do admin operations
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(operator, suite.defaultDenom, operator))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(operator, suite.defaultDenom, types.RoleMinter, suite.TestAccs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Once revoked the operator can't mint anymore
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, operator))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgRevokeRole, 1)
+
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(operator, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Roles can't be revoked twice
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, operator))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	// The admin can revoke its own minter role
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, admin))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+// TestChangeAdminTransfersRoles tests that the roles of the previous admin move to the new admin
+func (suite *KeeperTestSuite) TestChangeAdminTransfersRoles() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	operator := suite.TestAccs[1].String()
+	newAdmin := suite.TestAccs[2].String()
+
+	_, err := suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, operator))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, newAdmin))
+	suite.Require().NoError(err)
+
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	for _, role := range append(types.GrantableRoles, types.RoleAdmin) {
+		suite.Require().True(authorityMetadata.HasRole(role, newAdmin), role)
+		suite.Require().False(authorityMetadata.HasRole(role, admin), role)
+	}
+
+	// Roles granted to other addresses are kept
+	suite.Require().True(authorityMetadata.HasRole(types.RoleMinter, operator))
+}
+
+// TestMigrate1to2 tests that the admin of existing denoms is granted all the roles
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTestForInitGenesis()
+	admin := "kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c"
+
+	// Denoms from before the roles only have an admin
+	suite.App.TokenFactoryKeeper.InitGenesis(suite.Ctx, types.GenesisState{
+		Params: types.DefaultParams(),
+		FactoryDenoms: []types.GenesisDenom{
+			{
+				Denom:             "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/bitcoin",
+				AuthorityMetadata: types.DenomAuthorityMetadata{Admin: admin},
+			},
+			{
+				Denom:             "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/litecoin",
+				AuthorityMetadata: types.DenomAuthorityMetadata{Admin: ""},
+			},
+		},
+	})
+
+	migrator := keeper.NewMigrator(suite.App.TokenFactoryKeeper)
+	suite.Require().NoError(migrator.Migrate1to2(suite.Ctx))
+
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/bitcoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewDenomAuthorityMetadata(admin), authorityMetadata)
+
+	// Denoms without admin have no roles
+	authorityMetadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/litecoin")
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{}, authorityMetadata)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// setMaxSupply stores the immutable max supply of a denom
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdkmath.Int) error {
+	if _, found := k.GetMaxSupply(ctx, denom); found {
+		return types.ErrMaxSupplyAlreadySet.Wrapf("denom: %s", denom)
+	}
+
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply must be positive (%s)", maxSupply)
+	}
+
+	// The cap can't be under what is already minted
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if maxSupply.LT(supply.Amount) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply %s is lower than the current supply %s", maxSupply, supply.Amount)
+	}
+
+	bz, err := maxSupply.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	store.Set([]byte(types.MaxSupplyKey), bz)
+	return nil
+}
+
+// GetMaxSupply returns the max supply of a denom, if it is capped
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (sdkmath.Int, bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+
+	bz := store.Get([]byte(types.MaxSupplyKey))
+	if bz == nil {
+		return sdkmath.ZeroInt(), false
+	}
+
+	var maxSupply sdkmath.Int
+	if err := maxSupply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return maxSupply, true
+}
+
+// checkMaxSupply returns an error if minting the amount would exceed the max supply of the denom
+func (k Keeper) checkMaxSupply(ctx sdk.Context, amount sdk.Coin) error {
+	maxSupply, found := k.GetMaxSupply(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if supply.Amount.Add(amount.Amount).GT(maxSupply) {
+		return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "supply %s, max supply %s, minting %s", supply.Amount, maxSupply, amount.Amount)
+	}
+
+	return nil
+}
+
+// setMintAllowance stores the allowance of a delegated minter of a denom.
+// A zero allowance removes the minter
+func (k Keeper) setMintAllowance(ctx sdk.Context, denom string, minter string, allowance sdkmath.Int) error {
+	minterAddr, err := sdk.AccAddressFromBech32(minter)
+	if err != nil {
+		return err
+	}
+
+	if allowance.IsNil() || allowance.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowance can't be negative (%s)", allowance)
+	}
+
+	store := k.getMintersStore(ctx, denom)
+
+	if allowance.IsZero() {
+		store.Delete(minterAddr)
+		return nil
+	}
+
+	bz, err := allowance.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(minterAddr, bz)
+	return nil
+}
+
+// GetMintAllowance returns the remaining allowance of a delegated minter of a denom
+func (k Keeper) GetMintAllowance(ctx sdk.Context, denom string, minter sdk.AccAddress) (sdkmath.Int, bool) {
+	store := k.getMintersStore(ctx, denom)
+
+	bz := store.Get(minter)
+	if bz == nil {
+		return sdkmath.ZeroInt(), false
+	}
+
+	var allowance sdkmath.Int
+	if err := allowance.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return allowance, true
+}
+
+// GetMintAllowances returns all the delegated minters of a denom
+func (k Keeper) GetMintAllowances(ctx sdk.Context, denom string) []types.MintAllowance {
+	store := k.getMintersStore(ctx, denom)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	allowances := []types.MintAllowance{}
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance sdkmath.Int
+		if err := allowance.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		allowances = append(allowances, types.MintAllowance{
+			Minter:    sdk.AccAddress(iterator.Key()).String(),
+			Allowance: allowance,
+		})
+	}
+
+	return allowances
+}
+
+// spendMintAllowance decrements the allowance of a delegated minter by the minted amount
+func (k Keeper) spendMintAllowance(ctx sdk.Context, minter string, amount sdk.Coin) error {
+	minterAddr, err := sdk.AccAddressFromBech32(minter)
+	if err != nil {
+		return err
+	}
+
+	allowance, found := k.GetMintAllowance(ctx, amount.Denom, minterAddr)
+	if !found {
+		return types.ErrUnauthorized
+	}
+
+	if allowance.LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrMintAllowanceExceeded, "allowance %s, minting %s", allowance, amount.Amount)
+	}
+
+	return k.setMintAllowance(ctx, amount.Denom, minter, allowance.Sub(amount.Amount))
+}
+
+// getMintersStore returns the substore with the delegated minters of a denom
+func (k Keeper) getMintersStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetMintersPrefix())
+}
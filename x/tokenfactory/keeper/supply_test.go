@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TestCreateDenomWithMaxSupply tests the supply cap set on denom creation
+func (suite *KeeperTestSuite) TestCreateDenomWithMaxSupply() {
+	suite.SetupTest()
+	admin := suite.TestAccs[0].String()
+
+	msg := types.NewMsgCreateDenom(admin, "bitcoin")
+	msg.MaxSupply = sdkmath.NewInt(1000)
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	// Mint under the cap
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 600)))
+	suite.Require().NoError(err)
+
+	// Mint over the cap
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 500)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// The cap is immutable
+	_, err = suite.msgServer.SetMaxSupply(suite.Ctx, types.NewMsgSetMaxSupply(admin, denom, sdkmath.NewInt(2000)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyAlreadySet)
+
+	// Mint up to the cap
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 400)))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.DenomSupplyCap(suite.Ctx.Context(), &types.QueryDenomSupplyCapRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.HasMaxSupply)
+	suite.Require().Equal(sdkmath.NewInt(1000), queryRes.MaxSupply)
+	suite.Require().Equal(sdkmath.NewInt(1000), queryRes.Supply)
+	suite.Require().True(queryRes.Remaining.IsZero())
+}
+
+// TestSetMaxSupply tests setting the supply cap of an existing denom
+func (suite *KeeperTestSuite) TestSetMaxSupply() {
+	for _, tc := range []struct {
+		desc        string
+		sender      func() string
+		maxSupply   sdkmath.Int
+		expectedErr error
+	}{
+		{
+			desc:      "valid max supply",
+			sender:    func() string { return suite.TestAccs[0].String() },
+			maxSupply: sdkmath.NewInt(1000),
+		},
+		{
+			desc:      "max supply equal to the current supply",
+			sender:    func() string { return suite.TestAccs[0].String() },
+			maxSupply: sdkmath.NewInt(500),
+		},
+		{
+			desc:        "max supply lower than the current supply",
+			sender:      func() string { return suite.TestAccs[0].String() },
+			maxSupply:   sdkmath.NewInt(100),
+			expectedErr: types.ErrInvalidMaxSupply,
+		},
+		{
+			desc:        "sender is not the admin",
+			sender:      func() string { return suite.TestAccs[1].String() },
+			maxSupply:   sdkmath.NewInt(1000),
+			expectedErr: types.ErrUnauthorized,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			admin := suite.TestAccs[0].String()
+
+			// The denom is uncapped by default
+			queryRes, err := suite.queryClient.DenomSupplyCap(suite.Ctx.Context(), &types.QueryDenomSupplyCapRequest{Denom: suite.defaultDenom})
+			suite.Require().NoError(err)
+			suite.Require().False(queryRes.HasMaxSupply)
+
+			_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 500)))
+			suite.Require().NoError(err)
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.msgServer.SetMaxSupply(ctx, types.NewMsgSetMaxSupply(tc.sender(), suite.defaultDenom, tc.maxSupply))
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, 1)
+
+			queryRes, err = suite.queryClient.DenomSupplyCap(suite.Ctx.Context(), &types.QueryDenomSupplyCapRequest{Denom: suite.defaultDenom})
+			suite.Require().NoError(err)
+			suite.Require().True(queryRes.HasMaxSupply)
+			suite.Require().Equal(tc.maxSupply, queryRes.MaxSupply)
+			suite.Require().Equal(tc.maxSupply.SubRaw(500).String(), queryRes.Remaining.String())
+		})
+	}
+}
+
+// TestMintAllowance tests minting by delegated minters
+func (suite *KeeperTestSuite) TestMintAllowance() {
+	suite.SetupTest()
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+
+	// Only the admin can set minters
+	_, err := suite.msgServer.SetMinter(suite.Ctx, types.NewMsgSetMinter(minter, suite.defaultDenom, minter, sdkmath.NewInt(100)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.SetMinter(ctx, types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+	suite.AssertEventEmitted(ctx, types.TypeMsgSetMinter, 1)
+
+	// The minter spends its allowance
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 60)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(60), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+
+	allowanceRes, err := suite.queryClient.MintAllowance(suite.Ctx.Context(), &types.QueryMintAllowanceRequest{Denom: suite.defaultDenom, Minter: minter})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(40), allowanceRes.Allowance)
+
+	allowancesRes, err := suite.queryClient.MintAllowances(suite.Ctx.Context(), &types.QueryMintAllowancesRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.MintAllowance{{Minter: minter, Allowance: sdkmath.NewInt(40)}}, allowancesRes.Minters)
+
+	// The minter can't mint over its allowance
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 50)))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	// Other accounts can't mint
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[2].String(), sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// The minter is removed once the allowance is spent
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 40)))
+	suite.Require().NoError(err)
+
+	allowancesRes, err = suite.queryClient.MintAllowances(suite.Ctx.Context(), &types.QueryMintAllowancesRequest{Denom: suite.defaultDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(allowancesRes.Minters)
+
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Minters are also bound by the max supply
+	_, err = suite.msgServer.SetMaxSupply(suite.Ctx, types.NewMsgSetMaxSupply(admin, suite.defaultDenom, sdkmath.NewInt(150)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetMinter(suite.Ctx, types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 51)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// A zero allowance removes the minter
+	_, err = suite.msgServer.SetMinter(suite.Ctx, types.NewMsgSetMinter(admin, suite.defaultDenom, minter, sdkmath.ZeroInt()))
+	suite.Require().NoError(err)
+	allowanceRes, err = suite.queryClient.MintAllowance(suite.Ctx.Context(), &types.QueryMintAllowanceRequest{Denom: suite.defaultDenom, Minter: minter})
+	suite.Require().NoError(err)
+	suite.Require().True(allowanceRes.Allowance.IsZero())
+}
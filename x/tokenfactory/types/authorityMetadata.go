@@ -1,15 +1,164 @@
 package types
 
 import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// RoleAdmin manages the denom and its roles, it's held by the admin and changed with MsgChangeAdmin
+	RoleAdmin = "admin"
+	// RoleMinter can mint the denom
+	RoleMinter = "minter"
+	// RoleBurner can burn the denom
+	RoleBurner = "burner"
+	// RoleMetadata can set the bank metadata of the denom
+	RoleMetadata = "metadata"
+	// RoleForceTransfer can force transfers of the denom
+	RoleForceTransfer = "force_transfer"
+)
+
+// GrantableRoles are the roles that the admin can grant and revoke
+var GrantableRoles = []string{RoleMinter, RoleBurner, RoleMetadata, RoleForceTransfer}
+
+// NewDenomAuthorityMetadata returns the authority metadata of a new denom, where the admin holds all the roles
+func NewDenomAuthorityMetadata(admin string) DenomAuthorityMetadata {
+	metadata := DenomAuthorityMetadata{Admin: admin}
+	for _, role := range GrantableRoles {
+		holders := metadata.roleHolders(role)
+		*holders = append(*holders, admin)
+	}
+	return metadata
+}
+
 func (metadata DenomAuthorityMetadata) Validate() error {
+	// Immutable denoms have no admin nor roles
+	if metadata.Immutable {
+		if metadata.Admin != "" {
+			return errorsmod.Wrap(ErrInvalidAuthorityMetadata, "immutable denom can't have an admin")
+		}
+		for _, role := range GrantableRoles {
+			if len(*metadata.roleHolders(role)) > 0 {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "immutable denom can't have %s role holders", role)
+			}
+		}
+	}
+
 	if metadata.Admin != "" {
 		_, err := sdk.AccAddressFromBech32(metadata.Admin)
 		if err != nil {
 			return err
 		}
 	}
+
+	for _, role := range GrantableRoles {
+		seen := map[string]bool{}
+		for _, holder := range *metadata.roleHolders(role) {
+			if seen[holder] {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "duplicate %s role holder %s", role, holder)
+			}
+			seen[holder] = true
+
+			_, err := sdk.AccAddressFromBech32(holder)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
+
+// HasRole returns if the address holds the role
+func (metadata DenomAuthorityMetadata) HasRole(role string, address string) bool {
+	if address == "" {
+		return false
+	}
+
+	if role == RoleAdmin {
+		return metadata.Admin == address
+	}
+
+	holders := metadata.roleHolders(role)
+	if holders == nil {
+		return false
+	}
+
+	return slices.Contains(*holders, address)
+}
+
+// GrantRole grants a role to an address
+func (metadata *DenomAuthorityMetadata) GrantRole(role string, address string) error {
+	holders := metadata.roleHolders(role)
+	if holders == nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "role %s can't be granted", role)
+	}
+
+	if slices.Contains(*holders, address) {
+		return errorsmod.Wrapf(ErrInvalidRole, "role %s is already granted to %s", role, address)
+	}
+
+	*holders = append(*holders, address)
+	return nil
+}
+
+// RevokeRole revokes a role from an address
+func (metadata *DenomAuthorityMetadata) RevokeRole(role string, address string) error {
+	holders := metadata.roleHolders(role)
+	if holders == nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "role %s can't be revoked", role)
+	}
+
+	index := slices.Index(*holders, address)
+	if index == -1 {
+		return errorsmod.Wrapf(ErrInvalidRole, "role %s is not granted to %s", role, address)
+	}
+
+	*holders = slices.Delete(*holders, index, index+1)
+	return nil
+}
+
+// TransferRoles moves the roles held by an address to another one. Roles are
+// removed if the new address is empty
+func (metadata *DenomAuthorityMetadata) TransferRoles(from string, to string) {
+	for _, role := range GrantableRoles {
+		holders := metadata.roleHolders(role)
+
+		index := slices.Index(*holders, from)
+		if index == -1 {
+			continue
+		}
+
+		*holders = slices.Delete(*holders, index, index+1)
+		if to != "" && !slices.Contains(*holders, to) {
+			*holders = append(*holders, to)
+		}
+	}
+}
+
+// Renounce removes the admin and all the roles, making the denom immutable
+func (metadata *DenomAuthorityMetadata) Renounce() {
+	metadata.Admin = ""
+	for _, role := range GrantableRoles {
+		*metadata.roleHolders(role) = nil
+	}
+	metadata.Immutable = true
+}
+
+// roleHolders returns the holders list of a grantable role, or nil for other roles
+func (metadata *DenomAuthorityMetadata) roleHolders(role string) *[]string {
+	switch role {
+	case RoleMinter:
+		return &metadata.Minters
+	case RoleBurner:
+		return &metadata.Burners
+	case RoleMetadata:
+		return &metadata.MetadataManagers
+	case RoleForceTransfer:
+		return &metadata.ForceTransferrers
+	default:
+		return nil
+	}
+}
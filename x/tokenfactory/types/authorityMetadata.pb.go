@@ -25,11 +25,23 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin manages the denom and
+// grants the operational roles, which can be held by different addresses.
+// Renouncing the admin makes the denom immutable.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid kii address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// minters can mint the denom
+	Minters []string `protobuf:"bytes,2,rep,name=minters,proto3" json:"minters,omitempty" yaml:"minters"`
+	// burners can burn the denom
+	Burners []string `protobuf:"bytes,3,rep,name=burners,proto3" json:"burners,omitempty" yaml:"burners"`
+	// metadata_managers can set the bank metadata of the denom
+	MetadataManagers []string `protobuf:"bytes,4,rep,name=metadata_managers,json=metadataManagers,proto3" json:"metadata_managers,omitempty" yaml:"metadata_managers"`
+	// force_transferrers can force transfers of the denom
+	ForceTransferrers []string `protobuf:"bytes,5,rep,name=force_transferrers,json=forceTransferrers,proto3" json:"force_transferrers,omitempty" yaml:"force_transferrers"`
+	// immutable is set once the admin is renounced, the denom can't be managed
+	// anymore
+	Immutable bool `protobuf:"varint,6,opt,name=immutable,proto3" json:"immutable,omitempty" yaml:"immutable"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,6 +84,41 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetBurners() []string {
+	if m != nil {
+		return m.Burners
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManagers() []string {
+	if m != nil {
+		return m.MetadataManagers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferrers() []string {
+	if m != nil {
+		return m.ForceTransferrers
+	}
+	return nil
+}
+
+func (m *DenomAuthorityMetadata) GetImmutable() bool {
+	if m != nil {
+		return m.Immutable
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "kiichain.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
@@ -81,22 +128,31 @@ func init() {
 }
 
 var fileDescriptor_f97282583f218d1c = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x85, 0x69, 0xd3, 0x43, 0xd6, 0xa6, 0x07, 0xd5, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0xc9, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17,
-	0xeb, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x6d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71,
-	0x89, 0xb9, 0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xa2, 0x5b, 0x2a, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92,
-	0x9b, 0x99, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c,
-	0xa3, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa6, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x3d, 0x0e, 0x67, 0x54, 0xa0, 0x86,
-	0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x6d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x8b, 0x29, 0x04, 0x29, 0x01, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xbd, 0x6a, 0xe3, 0x40,
+	0x14, 0x85, 0x2d, 0xff, 0xed, 0x5a, 0x2c, 0x8b, 0x3d, 0x98, 0x45, 0x6b, 0xd6, 0x92, 0x99, 0x62,
+	0x71, 0xb1, 0x48, 0x78, 0x97, 0x6d, 0xdc, 0x45, 0xa4, 0x09, 0x89, 0x1b, 0x91, 0x2a, 0x8d, 0x19,
+	0xc9, 0x63, 0x79, 0xb0, 0x67, 0xc6, 0x8c, 0x46, 0x21, 0x7a, 0x8b, 0x3c, 0x42, 0x1e, 0x27, 0xa5,
+	0xcb, 0x54, 0x22, 0xd8, 0x4d, 0xba, 0x80, 0x9e, 0x20, 0xe8, 0x37, 0x8e, 0xd3, 0x5d, 0xce, 0xf9,
+	0xce, 0xb9, 0x03, 0x73, 0xd5, 0xff, 0x6b, 0x42, 0xbc, 0x15, 0x22, 0xcc, 0x92, 0x7c, 0x8d, 0xd9,
+	0x12, 0x79, 0x92, 0x8b, 0xc8, 0xba, 0x9d, 0xb8, 0x58, 0xa2, 0x89, 0x85, 0x42, 0xb9, 0xe2, 0x82,
+	0xc8, 0x68, 0x86, 0x25, 0x5a, 0x20, 0x89, 0xcc, 0xad, 0xe0, 0x92, 0x83, 0x61, 0x19, 0x33, 0x8f,
+	0x63, 0x66, 0x11, 0x1b, 0xf4, 0x7d, 0xee, 0xf3, 0x8c, 0xb4, 0xd2, 0x29, 0x0f, 0x0d, 0x74, 0x8f,
+	0x07, 0x94, 0x07, 0x96, 0x8b, 0x02, 0x5c, 0x6d, 0xf0, 0x38, 0x61, 0xb9, 0x0f, 0x5f, 0xeb, 0xea,
+	0x8f, 0x73, 0xcc, 0x38, 0x3d, 0x3b, 0xdd, 0x0a, 0x7e, 0xab, 0x2d, 0xb4, 0xa0, 0x84, 0x69, 0xca,
+	0x48, 0x19, 0x77, 0xec, 0x6e, 0x12, 0x1b, 0xdf, 0x22, 0x44, 0x37, 0x53, 0x98, 0xc9, 0xd0, 0xc9,
+	0x6d, 0xf0, 0x47, 0xfd, 0x42, 0x09, 0x93, 0x58, 0x04, 0x5a, 0x7d, 0xd4, 0x18, 0x77, 0x6c, 0x90,
+	0xc4, 0xc6, 0xf7, 0x9c, 0x2c, 0x0c, 0xe8, 0x94, 0x48, 0x4a, 0xbb, 0xa1, 0x60, 0x29, 0xdd, 0x38,
+	0xa5, 0x0b, 0x03, 0x3a, 0x25, 0x02, 0x2e, 0xd4, 0x1e, 0x2d, 0xde, 0x33, 0xa7, 0x88, 0x21, 0x3f,
+	0xcd, 0x35, 0xb3, 0xdc, 0xaf, 0x24, 0x36, 0xb4, 0x62, 0xcb, 0x29, 0x02, 0x9d, 0x6e, 0xa9, 0xcd,
+	0x0a, 0x09, 0x5c, 0xa9, 0x60, 0xc9, 0x85, 0x87, 0xe7, 0x52, 0x20, 0x16, 0x2c, 0xb1, 0x10, 0x69,
+	0x57, 0x2b, 0xeb, 0x1a, 0x26, 0xb1, 0xf1, 0x33, 0xef, 0xfa, 0xcc, 0x40, 0xa7, 0x97, 0x89, 0xd7,
+	0x47, 0x1a, 0xf8, 0xab, 0x76, 0x08, 0xa5, 0xa1, 0x44, 0xee, 0x06, 0x6b, 0xed, 0x91, 0x32, 0xfe,
+	0x6a, 0xf7, 0x93, 0xd8, 0xe8, 0xe6, 0x25, 0x95, 0x05, 0x9d, 0x77, 0x6c, 0xda, 0x7c, 0x79, 0x30,
+	0x14, 0xfb, 0xf2, 0x71, 0xaf, 0x2b, 0xbb, 0xbd, 0xae, 0x3c, 0xef, 0x75, 0xe5, 0xfe, 0xa0, 0xd7,
+	0x76, 0x07, 0xbd, 0xf6, 0x74, 0xd0, 0x6b, 0x37, 0x13, 0x9f, 0xc8, 0x55, 0xe8, 0x9a, 0x1e, 0xa7,
+	0x56, 0x75, 0x22, 0xd5, 0x70, 0xf7, 0xf1, 0x5a, 0x64, 0xb4, 0xc5, 0x81, 0xdb, 0xce, 0x7e, 0xf1,
+	0xdf, 0xdb, 0x00, 0xa8, 0xbd, 0xed, 0x35, 0x53, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +177,41 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if this.Minters[i] != that1.Minters[i] {
+			return false
+		}
+	}
+	if len(this.Burners) != len(that1.Burners) {
+		return false
+	}
+	for i := range this.Burners {
+		if this.Burners[i] != that1.Burners[i] {
+			return false
+		}
+	}
+	if len(this.MetadataManagers) != len(that1.MetadataManagers) {
+		return false
+	}
+	for i := range this.MetadataManagers {
+		if this.MetadataManagers[i] != that1.MetadataManagers[i] {
+			return false
+		}
+	}
+	if len(this.ForceTransferrers) != len(that1.ForceTransferrers) {
+		return false
+	}
+	for i := range this.ForceTransferrers {
+		if this.ForceTransferrers[i] != that1.ForceTransferrers[i] {
+			return false
+		}
+	}
+	if this.Immutable != that1.Immutable {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +234,52 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Immutable {
+		i--
+		if m.Immutable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for iNdEx := len(m.ForceTransferrers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceTransferrers[iNdEx])
+			copy(dAtA[i:], m.ForceTransferrers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ForceTransferrers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for iNdEx := len(m.MetadataManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataManagers[iNdEx])
+			copy(dAtA[i:], m.MetadataManagers[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burners) > 0 {
+		for iNdEx := len(m.Burners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Burners[iNdEx])
+			copy(dAtA[i:], m.Burners[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +311,33 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.Burners) > 0 {
+		for _, s := range m.Burners {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.MetadataManagers) > 0 {
+		for _, s := range m.MetadataManagers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if len(m.ForceTransferrers) > 0 {
+		for _, s := range m.ForceTransferrers {
+			l = len(s)
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	if m.Immutable {
+		n += 2
+	}
 	return n
 }
 
@@ -244,6 +408,154 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burners = append(m.Burners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManagers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManagers = append(m.MetadataManagers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferrers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceTransferrers = append(m.ForceTransferrers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Immutable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Immutable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// BeforeSendHookGasLimit is the gas limit for a single call to a before send hook contract
+const BeforeSendHookGasLimit = 500_000
+
+// BlockBeforeSendMsg is the message sent to the before send hook contract on each transfer
+type BlockBeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// BlockBeforeSendSudoMsg is the sudo message wrapper for the block before send hook
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BlockBeforeSendMsg `json:"block_before_send"`
+}
@@ -29,6 +29,16 @@ const (
 	burnTFDenom          = "tokenfactory/burn"
 	forceTransferTFDenom = "tokenfactory/force-transfer"
 	changeAdminTFDenom   = "tokenfactory/change-admin"
+	setBeforeSendHookTF  = "tokenfactory/set-before-send-hook"
+	setMaxSupplyTF       = "tokenfactory/set-max-supply"
+	setMinterTF          = "tokenfactory/set-minter"
+	freezeAccountTF      = "tokenfactory/freeze-account"
+	unfreezeAccountTF    = "tokenfactory/unfreeze-account"
+	pauseDenomTF         = "tokenfactory/pause-denom"
+	grantRoleTF          = "tokenfactory/grant-role"
+	revokeRoleTF         = "tokenfactory/revoke-role"
+	renounceAdminTF      = "tokenfactory/renounce-admin"
+	multiMintTF          = "tokenfactory/multi-mint"
 	updateTFparams       = "tokenfactory/msg-update-params"
 )
 
@@ -56,6 +66,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetBeforeSendHook{},
+		&MsgSetMaxSupply{},
+		&MsgSetMinter{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgPauseDenom{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgRenounceAdmin{},
+		&MsgMultiMint{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,5 +87,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, burnTFDenom, nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, forceTransferTFDenom, nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, setBeforeSendHookTF, nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, setMaxSupplyTF, nil)
+	cdc.RegisterConcrete(&MsgSetMinter{}, setMinterTF, nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, freezeAccountTF, nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, unfreezeAccountTF, nil)
+	cdc.RegisterConcrete(&MsgPauseDenom{}, pauseDenomTF, nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, grantRoleTF, nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, revokeRoleTF, nil)
+	cdc.RegisterConcrete(&MsgRenounceAdmin{}, renounceAdminTF, nil)
+	cdc.RegisterConcrete(&MsgMultiMint{}, multiMintTF, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateTFparams, nil)
 }
@@ -23,7 +23,7 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(7, len(impls))
+	suite.Require().Equal(17, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/kiichain.tokenfactory.v1beta1.MsgCreateDenom",
 		"/kiichain.tokenfactory.v1beta1.MsgMint",
@@ -31,6 +31,16 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 		"/kiichain.tokenfactory.v1beta1.MsgChangeAdmin",
 		"/kiichain.tokenfactory.v1beta1.MsgSetDenomMetadata",
 		"/kiichain.tokenfactory.v1beta1.MsgForceTransfer",
+		"/kiichain.tokenfactory.v1beta1.MsgSetBeforeSendHook",
+		"/kiichain.tokenfactory.v1beta1.MsgSetMaxSupply",
+		"/kiichain.tokenfactory.v1beta1.MsgSetMinter",
+		"/kiichain.tokenfactory.v1beta1.MsgFreezeAccount",
+		"/kiichain.tokenfactory.v1beta1.MsgUnfreezeAccount",
+		"/kiichain.tokenfactory.v1beta1.MsgPauseDenom",
+		"/kiichain.tokenfactory.v1beta1.MsgGrantRole",
+		"/kiichain.tokenfactory.v1beta1.MsgRevokeRole",
+		"/kiichain.tokenfactory.v1beta1.MsgRenounceAdmin",
+		"/kiichain.tokenfactory.v1beta1.MsgMultiMint",
 		"/kiichain.tokenfactory.v1beta1.MsgUpdateParams",
 	}, impls)
 }
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetERC20Decimals returns the ERC20 decimals of a denom, the exponent of its display unit
+func GetERC20Decimals(metadata banktypes.Metadata) (uint32, bool) {
+	for i := len(metadata.DenomUnits) - 1; i >= 0; i-- {
+		if metadata.DenomUnits[i].Denom == metadata.Display {
+			return metadata.DenomUnits[i].Exponent, true
+		}
+	}
+
+	return 0, false
+}
+
+// ValidateERC20Metadata checks that the ERC20 precompile can read the name, symbol and decimals of a denom
+func ValidateERC20Metadata(metadata banktypes.Metadata) error {
+	if metadata.Name == "" || metadata.Symbol == "" {
+		return errorsmod.Wrap(ErrInvalidERC20Metadata, "name and symbol can't be empty")
+	}
+
+	decimals, found := GetERC20Decimals(metadata)
+	if !found {
+		return errorsmod.Wrapf(ErrInvalidERC20Metadata, "display denom %s not found in the denom units", metadata.Display)
+	}
+	if decimals > math.MaxUint8 {
+		return errorsmod.Wrapf(ErrInvalidERC20Metadata, "display exponent %d overflows the uint8 decimals", decimals)
+	}
+
+	return nil
+}
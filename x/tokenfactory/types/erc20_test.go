@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+// TestValidateERC20Metadata tests the validation of the metadata read by the ERC20 token pairs
+func TestValidateERC20Metadata(t *testing.T) {
+	denom := "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/bitcoin"
+	baseMetadata := func() banktypes.Metadata {
+		return banktypes.Metadata{
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: denom, Exponent: 0},
+				{Denom: "BTC", Exponent: 8},
+			},
+			Base:    denom,
+			Display: "BTC",
+			Name:    "Bitcoin",
+			Symbol:  "BTC",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		metadata   func() banktypes.Metadata
+		expectPass bool
+	}{
+		{
+			name:       "valid metadata",
+			metadata:   baseMetadata,
+			expectPass: true,
+		},
+		{
+			name: "empty name",
+			metadata: func() banktypes.Metadata {
+				metadata := baseMetadata()
+				metadata.Name = ""
+				return metadata
+			},
+			expectPass: false,
+		},
+		{
+			name: "display not in denom units",
+			metadata: func() banktypes.Metadata {
+				metadata := baseMetadata()
+				metadata.Display = "ETH"
+				return metadata
+			},
+			expectPass: false,
+		},
+		{
+			name: "decimals overflow",
+			metadata: func() banktypes.Metadata {
+				metadata := baseMetadata()
+				metadata.DenomUnits[1].Exponent = 256
+				return metadata
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		err := types.ValidateERC20Metadata(test.metadata())
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidERC20Metadata, "test: %v", test.name)
+		}
+	}
+}
@@ -20,4 +20,17 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
+	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 12, fmt.Sprintf("gas meter hit maximum limit while calling before send hook, limit is %d", BeforeSendHookGasLimit))
+	ErrERC20NotEnabled          = errorsmod.Register(ModuleName, 13, "erc20 auto registration is not enabled")
+	ErrInvalidERC20Metadata     = errorsmod.Register(ModuleName, 14, "invalid erc20 metadata")
+	ErrInvalidMaxSupply         = errorsmod.Register(ModuleName, 15, "invalid max supply")
+	ErrMaxSupplyAlreadySet      = errorsmod.Register(ModuleName, 16, "max supply is already set and is immutable")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 17, "mint would exceed the max supply of the denom")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 18, "mint exceeds the minter allowance")
+	ErrAccountFrozen            = errorsmod.Register(ModuleName, 19, "account is frozen for the denom")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 20, "transfers of the denom are paused")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 21, "invalid denom role")
+	ErrDenomImmutable           = errorsmod.Register(ModuleName, 22, "denom is immutable")
+	ErrInvalidRecipients        = errorsmod.Register(ModuleName, 23, "invalid mint recipients")
+	ErrBeforeSendHookPanic      = errorsmod.Register(ModuleName, 24, "before send hook panicked")
 )
@@ -15,4 +15,20 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeBeforeSendHook      = "before_send_hook"
+	AttributeERC20Address        = "erc20_address"
+	AttributeName                = "name"
+	AttributeSymbol              = "symbol"
+	AttributeDecimals            = "decimals"
+	AttributeMaxSupply           = "max_supply"
+	AttributeMinter              = "minter"
+	AttributeAllowance           = "allowance"
+	AttributeAccount             = "account"
+	AttributePaused              = "paused"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeRecipients          = "recipients"
 )
+
+// EventTypeERC20MetadataUpdated is emitted when the metadata of a denom with an ERC20 token pair changes
+const EventTypeERC20MetadataUpdated = "erc20_metadata_updated"
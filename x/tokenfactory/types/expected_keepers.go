@@ -3,8 +3,14 @@ package types
 import (
 	context "context"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 )
 
 type BankKeeper interface {
@@ -12,6 +18,7 @@ type BankKeeper interface {
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 	HasSupply(ctx context.Context, denom string) bool
 	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
 
@@ -21,6 +28,7 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error
 	HasBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -35,7 +43,27 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
 
+// ContractKeeper defines the contract needed to call the before send hook contracts.
+type ContractKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
 // CommunityPoolKeeper defines the contract needed to be fulfilled for community pool interactions.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// ERC20Keeper defines the contract needed to register denoms as ERC20 token pairs.
+type ERC20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	SetToken(ctx sdk.Context, pair erc20types.TokenPair)
+	EnableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
+}
+
+// OracleKeeper defines the expected interface needed to price the denom creation fee
+type OracleKeeper interface {
+	GetRecentTwaps(ctx sdk.Context, denoms []string, maxPriceAgeSeconds, lookBackSeconds uint64) (map[string]sdkmath.LegacyDec, error)
+}
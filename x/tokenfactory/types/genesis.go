@@ -46,6 +46,47 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.BeforeSendHookAddress != "" {
+			_, err = sdk.AccAddressFromBech32(denom.BeforeSendHookAddress)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid before send hook address (%s)", err)
+			}
+		}
+
+		if !denom.MaxSupply.IsNil() && denom.MaxSupply.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidMaxSupply, "negative max supply for denom %s", denom.GetDenom())
+		}
+
+		seenMinters := map[string]bool{}
+		for _, minter := range denom.GetMinters() {
+			if seenMinters[minter.GetMinter()] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate minter %s for denom %s", minter.GetMinter(), denom.GetDenom())
+			}
+			seenMinters[minter.GetMinter()] = true
+
+			_, err = sdk.AccAddressFromBech32(minter.GetMinter())
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid minter address (%s)", err)
+			}
+
+			if minter.Allowance.IsNil() || !minter.Allowance.IsPositive() {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "minter %s must have a positive allowance", minter.GetMinter())
+			}
+		}
+
+		seenFrozen := map[string]bool{}
+		for _, account := range denom.GetFrozenAccounts() {
+			if seenFrozen[account] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen account %s for denom %s", account, denom.GetDenom())
+			}
+			seenFrozen[account] = true
+
+			_, err = sdk.AccAddressFromBech32(account)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid frozen account address (%s)", err)
+			}
+		}
 	}
 
 	return nil
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -79,10 +81,16 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the contract registered as the before send hook, the supply
+// cap, the delegated minters and the freeze state.
 type GenesisDenom struct {
-	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	Denom                 string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	AuthorityMetadata     DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	BeforeSendHookAddress string                 `protobuf:"bytes,3,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	MaxSupply             cosmossdk_io_math.Int  `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	Minters               []MintAllowance        `protobuf:"bytes,5,rep,name=minters,proto3" json:"minters" yaml:"minters"`
+	Paused                bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	FrozenAccounts        []string               `protobuf:"bytes,7,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty" yaml:"frozen_accounts"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +140,34 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetMinters() []MintAllowance {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "kiichain.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +178,44 @@ func init() {
 }
 
 var fileDescriptor_10d9942a48aa4f88 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x29, 0xd6, 0x43, 0x56, 0xac, 0x07,
-	0x55, 0x2c, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0x99,
-	0xe2, 0xb7, 0x21, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31,
-	0x25, 0xb1, 0x24, 0x11, 0xaa, 0x4d, 0x0b, 0xbf, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xbb,
-	0x94, 0x8e, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0x5c, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xcc,
-	0xc5, 0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xd7, 0xe5, 0x7a,
-	0x01, 0x60, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x0a, 0x15, 0x72, 0xf1,
-	0x41, 0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b,
-	0x69, 0x13, 0x30, 0x0c, 0xea, 0x12, 0x17, 0x90, 0x1e, 0x27, 0x59, 0x90, 0x91, 0x9f, 0xee, 0xc9,
-	0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x1a, 0xa8, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81,
-	0xf0, 0x91, 0x3c, 0x02, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x83, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x3b, 0x23,
-	0x97, 0x10, 0x3c, 0x24, 0xe3, 0x73, 0xa1, 0x41, 0x29, 0xc1, 0x04, 0xf6, 0xbd, 0x29, 0x01, 0x07,
-	0x83, 0xad, 0x72, 0x44, 0x8f, 0x07, 0x27, 0x45, 0xa8, 0xd3, 0x25, 0x21, 0x16, 0x62, 0x1a, 0xaf,
-	0x14, 0x24, 0x88, 0x11, 0x7b, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x79, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0x3c, 0x8a, 0xe1, 0x8c, 0x0a, 0xd4, 0xd8, 0x2e, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0xc7, 0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xfd, 0x2b, 0xaf, 0xac,
-	0x02, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0x4d, 0xc9, 0xb6, 0x0d, 0xc4, 0x22, 0x95, 0x1b, 0xa9, 0x76, 0x30, 0x02,
+	0x05, 0x0a, 0xb6, 0x52, 0xd4, 0x4b, 0x4f, 0xc4, 0xad, 0x04, 0x15, 0xaa, 0x84, 0x9c, 0x1b, 0x42,
+	0x58, 0x1b, 0x7b, 0x93, 0x58, 0x89, 0x77, 0x83, 0x77, 0x03, 0x09, 0x2f, 0xc0, 0x95, 0x47, 0xe0,
+	0x21, 0x78, 0x06, 0xd4, 0x63, 0x85, 0x84, 0x84, 0x38, 0x58, 0x28, 0xb9, 0x70, 0xf6, 0x13, 0xa0,
+	0xec, 0x6e, 0x03, 0xa1, 0x22, 0xe1, 0xb6, 0x3b, 0xf3, 0xcd, 0x3f, 0x33, 0x3b, 0xb3, 0x60, 0xbf,
+	0x17, 0x86, 0x7e, 0x17, 0x86, 0xd8, 0x66, 0xa4, 0x87, 0x70, 0x1b, 0xfa, 0x8c, 0xc4, 0x63, 0xfb,
+	0x4d, 0xbd, 0x85, 0x18, 0xac, 0xdb, 0x1d, 0x84, 0x11, 0x0d, 0xa9, 0x35, 0x88, 0x09, 0x23, 0xea,
+	0xde, 0x25, 0x6c, 0xfd, 0x09, 0x5b, 0x12, 0xae, 0xdc, 0xec, 0x90, 0x0e, 0xe1, 0xa4, 0x3d, 0x3b,
+	0x89, 0xa0, 0xca, 0xe1, 0xf2, 0x0c, 0x70, 0xc8, 0xba, 0x24, 0x0e, 0xd9, 0xf8, 0x0c, 0x31, 0x18,
+	0x40, 0x06, 0x65, 0xd8, 0xfd, 0xe5, 0x61, 0x03, 0x18, 0xc3, 0x88, 0xfe, 0x1f, 0x4b, 0x87, 0x83,
+	0x41, 0x7f, 0x2c, 0xd9, 0x5d, 0x9f, 0xd0, 0x88, 0x50, 0x4f, 0xd4, 0x29, 0x2e, 0xc2, 0x65, 0x7e,
+	0x56, 0xc0, 0xd6, 0x13, 0xd1, 0x70, 0x93, 0x41, 0x86, 0xd4, 0x63, 0x90, 0x17, 0x79, 0x34, 0xa5,
+	0xaa, 0xd4, 0x36, 0x0f, 0xee, 0x58, 0x4b, 0x1f, 0xc0, 0x7a, 0xce, 0x61, 0x27, 0x77, 0x9e, 0x18,
+	0x19, 0x57, 0x86, 0xaa, 0xaf, 0x41, 0x51, 0x72, 0x5e, 0x80, 0x30, 0x89, 0xa8, 0xb6, 0x56, 0xcd,
+	0xd6, 0x36, 0x0f, 0xf6, 0x57, 0x88, 0xc9, 0x4a, 0x4e, 0x66, 0x31, 0xce, 0xde, 0x4c, 0x32, 0x4d,
+	0x8c, 0xf2, 0x18, 0x46, 0xfd, 0x23, 0x73, 0x51, 0xd0, 0x74, 0xb7, 0xa5, 0xe1, 0x44, 0xdc, 0xbf,
+	0xe6, 0xe6, 0x8d, 0x70, 0x8b, 0x7a, 0x17, 0xac, 0x73, 0x94, 0xf7, 0x51, 0x70, 0x6e, 0xa4, 0x89,
+	0xb1, 0x25, 0x94, 0xb8, 0xd9, 0x74, 0x85, 0x5b, 0x7d, 0xaf, 0x00, 0x75, 0x3e, 0x10, 0x2f, 0x92,
+	0x13, 0xd1, 0xd6, 0x78, 0xf7, 0x87, 0x2b, 0x0a, 0xe6, 0xa9, 0x1a, 0x7f, 0x8f, 0xd3, 0xb9, 0x25,
+	0x4b, 0xdf, 0x15, 0x09, 0xaf, 0xca, 0x9b, 0x6e, 0xe9, 0xca, 0x12, 0xa8, 0x2f, 0x81, 0xd6, 0x42,
+	0x6d, 0x12, 0x23, 0x8f, 0x22, 0x1c, 0x78, 0x5d, 0x42, 0x7a, 0x1e, 0x0c, 0x82, 0x18, 0x51, 0xaa,
+	0x65, 0x79, 0x13, 0xb7, 0xd3, 0xc4, 0x30, 0x84, 0xe6, 0xbf, 0x48, 0xd3, 0x2d, 0x0b, 0x57, 0x13,
+	0xe1, 0xe0, 0x29, 0x21, 0xbd, 0x86, 0xb0, 0xab, 0x1e, 0x00, 0x11, 0x1c, 0x79, 0x62, 0x31, 0xb4,
+	0x1c, 0xd7, 0x7b, 0x3c, 0xab, 0xf3, 0x7b, 0x62, 0x94, 0xc5, 0x4e, 0xd0, 0xa0, 0x67, 0x85, 0xc4,
+	0x8e, 0x20, 0xeb, 0x5a, 0xa7, 0x98, 0xa5, 0x89, 0x51, 0x12, 0xc9, 0x7e, 0x07, 0x9a, 0x5f, 0x3e,
+	0x3d, 0x04, 0x72, 0x83, 0x4e, 0x31, 0x73, 0x0b, 0x11, 0x1c, 0x35, 0xb9, 0x47, 0x7d, 0x05, 0x36,
+	0xa2, 0x10, 0x33, 0x14, 0x53, 0x6d, 0x9d, 0x4f, 0xfb, 0xc1, 0x8a, 0xc7, 0x3b, 0x0b, 0x31, 0x6b,
+	0xf4, 0xfb, 0xe4, 0x2d, 0xc4, 0x3e, 0x72, 0x76, 0xe4, 0x9b, 0x15, 0x65, 0x4a, 0x21, 0x65, 0xba,
+	0x97, 0xa2, 0xea, 0xbd, 0xd9, 0x66, 0x0e, 0x29, 0x0a, 0xb4, 0x7c, 0x55, 0xa9, 0x5d, 0x73, 0x4a,
+	0x69, 0x62, 0x6c, 0x0b, 0x58, 0xd8, 0x4d, 0x57, 0x02, 0xea, 0x31, 0xb8, 0xde, 0x8e, 0xc9, 0x3b,
+	0x84, 0x3d, 0xe8, 0xfb, 0x64, 0x88, 0x19, 0xd5, 0x36, 0xaa, 0xd9, 0x5a, 0xc1, 0xa9, 0xa4, 0x89,
+	0xb1, 0x23, 0xf7, 0x69, 0x11, 0x30, 0xdd, 0xa2, 0xb0, 0x34, 0xa4, 0xe1, 0x28, 0xf7, 0xf3, 0xa3,
+	0xa1, 0x38, 0xcf, 0xce, 0x27, 0xba, 0x72, 0x31, 0xd1, 0x95, 0x1f, 0x13, 0x5d, 0xf9, 0x30, 0xd5,
+	0x33, 0x17, 0x53, 0x3d, 0xf3, 0x6d, 0xaa, 0x67, 0x5e, 0xd4, 0x3b, 0x21, 0xeb, 0x0e, 0x5b, 0x96,
+	0x4f, 0x22, 0x7b, 0xfe, 0x19, 0xe7, 0x87, 0xd1, 0xe2, 0xbf, 0x64, 0xe3, 0x01, 0xa2, 0xad, 0x3c,
+	0xff, 0x74, 0x8f, 0x7e, 0x0d, 0x00, 0x2d, 0xf8, 0x47, 0x1f, 0x82, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +243,31 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if len(this.Minters) != len(that1.Minters) {
+		return false
+	}
+	for i := range this.Minters {
+		if !this.Minters[i].Equal(&that1.Minters[i]) {
+			return false
+		}
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAccounts) != len(that1.FrozenAccounts) {
+		return false
+	}
+	for i := range this.FrozenAccounts {
+		if this.FrozenAccounts[i] != that1.FrozenAccounts[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +337,56 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +447,27 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +688,158 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, MintAllowance{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
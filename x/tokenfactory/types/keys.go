@@ -31,6 +31,11 @@ var (
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
+	BeforeSendHookAddressKey  = "beforesendhook"
+	MaxSupplyKey              = "maxsupply"
+	MinterPrefixKey           = "minter"
+	FrozenPrefixKey           = "frozen"
+	PausedKey                 = "paused"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -49,3 +54,13 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetMintersPrefix returns the prefix, within a denom store, where the delegated minters are stored
+func GetMintersPrefix() []byte {
+	return []byte(strings.Join([]string{MinterPrefixKey, ""}, KeySeparator))
+}
+
+// GetFrozenAccountsPrefix returns the prefix, within a denom store, where the frozen accounts are stored
+func GetFrozenAccountsPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenPrefixKey, ""}, KeySeparator))
+}
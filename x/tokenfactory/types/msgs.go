@@ -1,6 +1,8 @@
 package types
 
 import (
+	"slices"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -10,14 +12,27 @@ import (
 )
 
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom       = "create_denom"
+	TypeMsgMint              = "tf_mint"
+	TypeMsgBurn              = "tf_burn"
+	TypeMsgForceTransfer     = "force_transfer"
+	TypeMsgChangeAdmin       = "change_admin"
+	TypeMsgSetDenomMetadata  = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetMaxSupply      = "set_max_supply"
+	TypeMsgSetMinter         = "set_minter"
+	TypeMsgFreezeAccount     = "freeze_account"
+	TypeMsgUnfreezeAccount   = "unfreeze_account"
+	TypeMsgPauseDenom        = "pause_denom"
+	TypeMsgGrantRole         = "grant_role"
+	TypeMsgRevokeRole        = "revoke_role"
+	TypeMsgRenounceAdmin     = "renounce_admin"
+	TypeMsgMultiMint         = "multi_mint"
 )
 
+// MaxMultiMintRecipients bounds the recipients of a single MsgMultiMint
+const MaxMultiMintRecipients = 100
+
 var _ sdk.Msg = &MsgCreateDenom{}
 
 // NewMsgCreateDenom creates a msg to create a new denom
@@ -41,6 +56,11 @@ func (m MsgCreateDenom) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
 	}
 
+	// The max supply is optional, a nil or zero value means no cap
+	if !m.MaxSupply.IsNil() && m.MaxSupply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply can't be negative (%s)", m.MaxSupply)
+	}
+
 	return nil
 }
 
@@ -278,6 +298,450 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set a new before send hook
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// An empty address removes the hook
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to set the max supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply sdkmath.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply must be positive (%s)", m.MaxSupply)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMinter{}
+
+// NewMsgSetMinter creates a message to set the mint allowance of a delegated minter
+func NewMsgSetMinter(sender, denom, minter string, allowance sdkmath.Int) *MsgSetMinter {
+	return &MsgSetMinter{
+		Sender:    sender,
+		Denom:     denom,
+		Minter:    minter,
+		Allowance: allowance,
+	}
+}
+
+func (m MsgSetMinter) Route() string { return RouterKey }
+func (m MsgSetMinter) Type() string  { return TypeMsgSetMinter }
+func (m MsgSetMinter) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", err)
+	}
+
+	// A zero allowance removes the minter
+	if m.Allowance.IsNil() || m.Allowance.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "allowance can't be negative (%s)", m.Allowance)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetMinter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMinter) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFreezeAccount{}
+
+// NewMsgFreezeAccount creates a message to freeze an account for a denom
+func NewMsgFreezeAccount(sender, denom, account string) *MsgFreezeAccount {
+	return &MsgFreezeAccount{
+		Sender:  sender,
+		Denom:   denom,
+		Account: account,
+	}
+}
+
+func (m MsgFreezeAccount) Route() string { return RouterKey }
+func (m MsgFreezeAccount) Type() string  { return TypeMsgFreezeAccount }
+func (m MsgFreezeAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid account address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnfreezeAccount{}
+
+// NewMsgUnfreezeAccount creates a message to unfreeze an account for a denom
+func NewMsgUnfreezeAccount(sender, denom, account string) *MsgUnfreezeAccount {
+	return &MsgUnfreezeAccount{
+		Sender:  sender,
+		Denom:   denom,
+		Account: account,
+	}
+}
+
+func (m MsgUnfreezeAccount) Route() string { return RouterKey }
+func (m MsgUnfreezeAccount) Type() string  { return TypeMsgUnfreezeAccount }
+func (m MsgUnfreezeAccount) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid account address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPauseDenom{}
+
+// NewMsgPauseDenom creates a message to pause or unpause the transfers of a denom
+func NewMsgPauseDenom(sender, denom string, paused bool) *MsgPauseDenom {
+	return &MsgPauseDenom{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgPauseDenom) Route() string { return RouterKey }
+func (m MsgPauseDenom) Type() string  { return TypeMsgPauseDenom }
+func (m MsgPauseDenom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgPauseDenom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPauseDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a denom role to an address
+func NewMsgGrantRole(sender, denom, role, address string) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+	}
+
+	// The admin role is changed with MsgChangeAdmin
+	if !slices.Contains(GrantableRoles, m.Role) {
+		return errorsmod.Wrapf(ErrInvalidRole, "role must be one of %v, got %s", GrantableRoles, m.Role)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a denom role from an address
+func NewMsgRevokeRole(sender, denom, role, address string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+	}
+
+	// The admin role is changed with MsgChangeAdmin
+	if !slices.Contains(GrantableRoles, m.Role) {
+		return errorsmod.Wrapf(ErrInvalidRole, "role must be one of %v, got %s", GrantableRoles, m.Role)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceAdmin{}
+
+// NewMsgRenounceAdmin creates a message to permanently renounce the admin of a denom
+func NewMsgRenounceAdmin(sender, denom string) *MsgRenounceAdmin {
+	return &MsgRenounceAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgRenounceAdmin) Route() string { return RouterKey }
+func (m MsgRenounceAdmin) Type() string  { return TypeMsgRenounceAdmin }
+func (m MsgRenounceAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgRenounceAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRenounceAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMultiMint{}
+
+// NewMsgMultiMint creates a message to mint a denom to many recipients
+func NewMsgMultiMint(sender, denom string, recipients []MintRecipient) *MsgMultiMint {
+	return &MsgMultiMint{
+		Sender:     sender,
+		Denom:      denom,
+		Recipients: recipients,
+	}
+}
+
+func (m MsgMultiMint) Route() string { return RouterKey }
+func (m MsgMultiMint) Type() string  { return TypeMsgMultiMint }
+func (m MsgMultiMint) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if len(m.Recipients) == 0 || len(m.Recipients) > MaxMultiMintRecipients {
+		return errorsmod.Wrapf(ErrInvalidRecipients, "must have between 1 and %d recipients, got %d", MaxMultiMintRecipients, len(m.Recipients))
+	}
+
+	seen := map[string]bool{}
+	for _, recipient := range m.Recipients {
+		_, err = sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+		}
+
+		if seen[recipient.Address] {
+			return errorsmod.Wrapf(ErrInvalidRecipients, "duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Amount.IsNil() || !recipient.Amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "recipient %s amount must be positive", recipient.Address)
+		}
+	}
+
+	return nil
+}
+
+// TotalAmount returns the sum of the amounts minted to the recipients
+func (m MsgMultiMint) TotalAmount() sdk.Coin {
+	total := sdkmath.ZeroInt()
+	for _, recipient := range m.Recipients {
+		total = total.Add(recipient.Amount)
+	}
+	return sdk.NewCoin(m.Denom, total)
+}
+
+func (m MsgMultiMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMultiMint) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
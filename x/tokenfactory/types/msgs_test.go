@@ -452,3 +452,771 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty cosmwasm address removes the hook",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid cosmwasm address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMaxSupply tests if valid/invalid set max supply messages are properly validated/invalidated
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(
+		addr1.String(),
+		tokenFactoryDenom,
+		sdkmath.NewInt(21_000_000),
+	)
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdkmath.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdkmath.Int{}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetMinter tests if valid/invalid set minter messages are properly validated/invalidated
+func TestMsgSetMinter(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMinter message
+	baseMsg := types.NewMsgSetMinter(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		sdkmath.NewInt(1000),
+	)
+
+	// validate setMinter message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_minter")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMinter
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "zero allowance removes the minter",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Allowance = sdkmath.ZeroInt()
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Allowance = sdkmath.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid minter",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Minter = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMinter {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgFreezeAccount tests if valid/invalid freeze account messages are properly validated/invalidated
+func TestMsgFreezeAccount(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper freezeAccount message
+	baseMsg := types.NewMsgFreezeAccount(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate freezeAccount message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "freeze_account")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgFreezeAccount
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgFreezeAccount {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgFreezeAccount {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid account",
+			msg: func() *types.MsgFreezeAccount {
+				msg := *baseMsg
+				msg.Account = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgFreezeAccount {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgPauseDenom tests if valid/invalid pause denom messages are properly validated/invalidated
+func TestMsgPauseDenom(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper pauseDenom message
+	baseMsg := types.NewMsgPauseDenom(
+		addr1.String(),
+		tokenFactoryDenom,
+		true,
+	)
+
+	// validate pauseDenom message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "pause_denom")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgPauseDenom
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgPauseDenom {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unpause",
+			msg: func() *types.MsgPauseDenom {
+				msg := *baseMsg
+				msg.Paused = false
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgPauseDenom {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgPauseDenom {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgGrantRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper grantRole message
+	baseMsg := types.NewMsgGrantRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleMinter,
+		addr2.String(),
+	)
+
+	// validate grantRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "grant_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgGrantRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Address = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "admin role",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = types.RoleAdmin
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = "owner"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRevokeRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper revokeRole message
+	baseMsg := types.NewMsgRevokeRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleMinter,
+		addr2.String(),
+	)
+
+	// validate revokeRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "revoke_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRevokeRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Address = "invalid"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "admin role",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Role = types.RoleAdmin
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Role = "owner"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRenounceAdmin(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper renounceAdmin message
+	baseMsg := types.NewMsgRenounceAdmin(
+		addr1.String(),
+		tokenFactoryDenom,
+	)
+
+	// validate renounceAdmin message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "renounce_admin")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRenounceAdmin
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRenounceAdmin {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgRenounceAdmin {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgRenounceAdmin {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgMultiMint(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper multiMint message
+	baseMsg := types.NewMsgMultiMint(
+		addr1.String(),
+		tokenFactoryDenom,
+		[]types.MintRecipient{
+			{Address: addr1.String(), Amount: sdkmath.NewInt(100)},
+			{Address: addr2.String(), Amount: sdkmath.NewInt(200)},
+		},
+	)
+
+	// validate multiMint message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "multi_mint")
+	require.Equal(t, sdk.NewInt64Coin(tokenFactoryDenom, 300).String(), baseMsg.TotalAmount().String())
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgMultiMint
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no recipients",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = nil
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "too many recipients",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = []types.MintRecipient{}
+				for i := 0; i <= types.MaxMultiMintRecipients; i++ {
+					addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+					msg.Recipients = append(msg.Recipients, types.MintRecipient{Address: addr.String(), Amount: sdkmath.NewInt(1)})
+				}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid recipient address",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = []types.MintRecipient{{Address: "invalid", Amount: sdkmath.NewInt(1)}}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate recipient",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = []types.MintRecipient{
+					{Address: addr2.String(), Amount: sdkmath.NewInt(1)},
+					{Address: addr2.String(), Amount: sdkmath.NewInt(2)},
+				}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = []types.MintRecipient{{Address: addr2.String(), Amount: sdkmath.ZeroInt()}}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil amount",
+			msg: func() *types.MsgMultiMint {
+				msg := *baseMsg
+				msg.Recipients = []types.MintRecipient{{Address: addr2.String()}}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
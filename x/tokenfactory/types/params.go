@@ -3,9 +3,14 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxFeeDenomExponent bounds the exponent of the quote fee denom
+const MaxFeeDenomExponent = 18
+
 func NewParams(denomCreationFee sdk.Coins) Params {
 	return Params{
 		DenomCreationFee: denomCreationFee,
@@ -17,14 +22,58 @@ func DefaultParams() Params {
 	return Params{
 		DenomCreationFee:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
 		DenomCreationGasConsume: 2_000_000,
+		DenomCreationQuoteFee: DenomCreationQuoteFee{
+			QuoteAmount: sdkmath.LegacyZeroDec(),
+		},
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
 	err := validateDenomCreationFee(p.DenomCreationFee)
+	if err != nil {
+		return err
+	}
+
+	return p.DenomCreationQuoteFee.Validate()
+}
+
+// IsEnabled returns if the quote fee is set
+func (f DenomCreationQuoteFee) IsEnabled() bool {
+	return !f.QuoteAmount.IsNil() && f.QuoteAmount.IsPositive()
+}
+
+// Validate validates the quote fee, a disabled quote fee is always valid
+func (f DenomCreationQuoteFee) Validate() error {
+	if f.QuoteAmount.IsNil() || f.QuoteAmount.IsZero() {
+		return nil
+	}
+
+	if f.QuoteAmount.IsNegative() {
+		return fmt.Errorf("denom creation quote amount can't be negative: %s", f.QuoteAmount)
+	}
 
-	return err
+	if err := sdk.ValidateDenom(f.FeeDenom); err != nil {
+		return fmt.Errorf("invalid denom creation quote fee denom: %w", err)
+	}
+
+	if f.FeeDenomExponent > MaxFeeDenomExponent {
+		return fmt.Errorf("denom creation quote fee exponent must be at most %d, got %d", MaxFeeDenomExponent, f.FeeDenomExponent)
+	}
+
+	if f.OracleDenom == "" {
+		return fmt.Errorf("denom creation quote fee oracle denom can't be empty")
+	}
+
+	if f.TwapLookbackSeconds == 0 {
+		return fmt.Errorf("denom creation quote fee twap lookback must be positive")
+	}
+
+	if f.MaxPriceAgeSeconds == 0 {
+		return fmt.Errorf("denom creation quote fee max price age must be positive")
+	}
+
+	return nil
 }
 
 func validateDenomCreationFee(i interface{}) error {
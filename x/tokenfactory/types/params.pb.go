@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +34,13 @@ type Params struct {
 	// more gas consumption to the base cost.
 	// https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// enable_erc20_auto_registration allows denoms to be registered as native
+	// ERC20 token pairs on x/erc20 when created with register_erc20 set.
+	EnableErc20AutoRegistration bool `protobuf:"varint,3,opt,name=enable_erc20_auto_registration,json=enableErc20AutoRegistration,proto3" json:"enable_erc20_auto_registration,omitempty" yaml:"enable_erc20_auto_registration"`
+	// denom_creation_quote_fee optionally sets the denom creation fee as a value
+	// in a quote asset, converted with the oracle TWAP at charge time.
+	// denom_creation_fee is charged instead when the oracle price is stale.
+	DenomCreationQuoteFee DenomCreationQuoteFee `protobuf:"bytes,4,opt,name=denom_creation_quote_fee,json=denomCreationQuoteFee,proto3" json:"denom_creation_quote_fee" yaml:"denom_creation_quote_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,8 +90,111 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableErc20AutoRegistration() bool {
+	if m != nil {
+		return m.EnableErc20AutoRegistration
+	}
+	return false
+}
+
+func (m *Params) GetDenomCreationQuoteFee() DenomCreationQuoteFee {
+	if m != nil {
+		return m.DenomCreationQuoteFee
+	}
+	return DenomCreationQuoteFee{}
+}
+
+// DenomCreationQuoteFee defines a denom creation fee set as a value in the
+// quote asset of the oracle, for example 10 USD.
+type DenomCreationQuoteFee struct {
+	// quote_amount is the value of the fee in the quote asset. Zero disables
+	// the quote fee.
+	QuoteAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=quote_amount,json=quoteAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_amount" yaml:"quote_amount"`
+	// fee_denom is the denom charged, for example akii
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// fee_denom_exponent is the exponent between the unit priced by the oracle
+	// and fee_denom, for example 18 for kii and akii
+	FeeDenomExponent uint32 `protobuf:"varint,3,opt,name=fee_denom_exponent,json=feeDenomExponent,proto3" json:"fee_denom_exponent,omitempty" yaml:"fee_denom_exponent"`
+	// oracle_denom is the oracle denom that prices a unit of the fee denom
+	OracleDenom string `protobuf:"bytes,4,opt,name=oracle_denom,json=oracleDenom,proto3" json:"oracle_denom,omitempty" yaml:"oracle_denom"`
+	// twap_lookback_seconds is the lookback used for the oracle TWAP
+	TwapLookbackSeconds uint64 `protobuf:"varint,5,opt,name=twap_lookback_seconds,json=twapLookbackSeconds,proto3" json:"twap_lookback_seconds,omitempty" yaml:"twap_lookback_seconds"`
+	// max_price_age_seconds is how old the last oracle price can be before
+	// falling back to denom_creation_fee
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,6,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty" yaml:"max_price_age_seconds"`
+}
+
+func (m *DenomCreationQuoteFee) Reset()         { *m = DenomCreationQuoteFee{} }
+func (m *DenomCreationQuoteFee) String() string { return proto.CompactTextString(m) }
+func (*DenomCreationQuoteFee) ProtoMessage()    {}
+func (*DenomCreationQuoteFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd947e39c135a1a3, []int{1}
+}
+func (m *DenomCreationQuoteFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCreationQuoteFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCreationQuoteFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCreationQuoteFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCreationQuoteFee.Merge(m, src)
+}
+func (m *DenomCreationQuoteFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCreationQuoteFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCreationQuoteFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCreationQuoteFee proto.InternalMessageInfo
+
+func (m *DenomCreationQuoteFee) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *DenomCreationQuoteFee) GetFeeDenomExponent() uint32 {
+	if m != nil {
+		return m.FeeDenomExponent
+	}
+	return 0
+}
+
+func (m *DenomCreationQuoteFee) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
+	}
+	return ""
+}
+
+func (m *DenomCreationQuoteFee) GetTwapLookbackSeconds() uint64 {
+	if m != nil {
+		return m.TwapLookbackSeconds
+	}
+	return 0
+}
+
+func (m *DenomCreationQuoteFee) GetMaxPriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.tokenfactory.v1beta1.Params")
+	proto.RegisterType((*DenomCreationQuoteFee)(nil), "kiichain.tokenfactory.v1beta1.DenomCreationQuoteFee")
 }
 
 func init() {
@@ -91,29 +202,49 @@ func init() {
 }
 
 var fileDescriptor_bd947e39c135a1a3 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4f, 0xea, 0x40,
-	0x10, 0xc7, 0xbb, 0xbc, 0x17, 0x0e, 0x7d, 0x97, 0x97, 0xe6, 0x25, 0x0f, 0x48, 0xdc, 0x62, 0x4f,
-	0x68, 0x62, 0x1b, 0x34, 0x5e, 0x3c, 0x42, 0xa2, 0x07, 0x43, 0x62, 0x38, 0x7a, 0x69, 0xa6, 0xcb,
-	0x52, 0x36, 0xd8, 0x1d, 0xd2, 0x5d, 0x8c, 0xfd, 0x08, 0xde, 0x3c, 0xf9, 0x21, 0xfc, 0x24, 0x1c,
-	0x39, 0x7a, 0xaa, 0x06, 0xbe, 0x01, 0x9f, 0xc0, 0xd0, 0x2e, 0x04, 0xd4, 0x78, 0xda, 0x99, 0xcc,
-	0x7f, 0x7e, 0xf3, 0x9f, 0x1d, 0xfb, 0x78, 0x2c, 0x04, 0x1b, 0x81, 0x90, 0x81, 0xc6, 0x31, 0x97,
-	0x43, 0x60, 0x1a, 0xd3, 0x2c, 0xb8, 0x6f, 0x47, 0x5c, 0x43, 0x3b, 0x98, 0x40, 0x0a, 0x89, 0xf2,
-	0x27, 0x29, 0x6a, 0x74, 0x0e, 0x36, 0x5a, 0x7f, 0x57, 0xeb, 0x1b, 0x6d, 0xe3, 0x5f, 0x8c, 0x31,
-	0x16, 0xca, 0x60, 0x1d, 0x95, 0x4d, 0x8d, 0xf3, 0x9f, 0x07, 0xc0, 0x54, 0x8f, 0x30, 0x15, 0x3a,
-	0xeb, 0x71, 0x0d, 0x03, 0xd0, 0x60, 0xda, 0xea, 0x0c, 0x55, 0x82, 0x2a, 0x2c, 0x79, 0x65, 0x62,
-	0x4a, 0xb4, 0xcc, 0x82, 0x08, 0x14, 0xdf, 0x72, 0x18, 0x0a, 0x59, 0xd6, 0xbd, 0xc7, 0x8a, 0x5d,
-	0xbd, 0x29, 0x7c, 0x3b, 0xcf, 0xc4, 0x76, 0x06, 0x5c, 0x62, 0x12, 0xb2, 0x94, 0x83, 0x16, 0x28,
-	0xc3, 0x21, 0xe7, 0x35, 0xd2, 0xfc, 0xd5, 0xfa, 0x73, 0x5a, 0xf7, 0x0d, 0x76, 0x0d, 0xda, 0x6c,
-	0xe1, 0x77, 0x51, 0xc8, 0x4e, 0x6f, 0x96, 0xbb, 0xd6, 0x2a, 0x77, 0xeb, 0x19, 0x24, 0x77, 0x17,
-	0xde, 0x57, 0x84, 0xf7, 0xf2, 0xe6, 0xb6, 0x62, 0xa1, 0x47, 0xd3, 0xc8, 0x67, 0x98, 0x18, 0x83,
-	0xe6, 0x39, 0x51, 0x83, 0x71, 0xa0, 0xb3, 0x09, 0x57, 0x05, 0x4d, 0xf5, 0xff, 0x16, 0x80, 0xae,
-	0xe9, 0xbf, 0xe4, 0xdc, 0x19, 0xda, 0x8d, 0x4f, 0xd0, 0x18, 0x54, 0xc8, 0x50, 0xaa, 0x69, 0xc2,
-	0x6b, 0x95, 0x26, 0x69, 0xfd, 0xee, 0x1c, 0xcd, 0x72, 0x97, 0xac, 0x72, 0xf7, 0xf0, 0x5b, 0x13,
-	0x3b, 0x7a, 0xaf, 0xff, 0x7f, 0x6f, 0xc0, 0x15, 0xa8, 0x6e, 0x59, 0xe9, 0x5c, 0xcf, 0x16, 0x94,
-	0xcc, 0x17, 0x94, 0xbc, 0x2f, 0x28, 0x79, 0x5a, 0x52, 0x6b, 0xbe, 0xa4, 0xd6, 0xeb, 0x92, 0x5a,
-	0xb7, 0xed, 0x1d, 0xf7, 0xdb, 0x13, 0x6d, 0x83, 0x87, 0xfd, 0x6b, 0x15, 0xcb, 0x44, 0xd5, 0xe2,
-	0x7f, 0xcf, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x85, 0x1d, 0x09, 0x9e, 0x34, 0x02, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x8e, 0x4b, 0x8a, 0xc0, 0xa1, 0x12, 0x32, 0x44, 0x04, 0x28, 0x76, 0x6a, 0xa9, 0x6a, 0xa8,
+	0x84, 0xd3, 0xd0, 0xf6, 0xc2, 0x0d, 0xf3, 0xd3, 0x4a, 0x80, 0x44, 0x4d, 0x4f, 0xbd, 0x58, 0x9b,
+	0xcd, 0xc4, 0xb1, 0x1c, 0xef, 0xa6, 0xde, 0x75, 0x9b, 0xbc, 0x45, 0xd5, 0x43, 0x1f, 0xa2, 0xe7,
+	0x3e, 0x04, 0x47, 0xd4, 0x53, 0xd5, 0x83, 0x5b, 0xc1, 0xb1, 0xb7, 0x3c, 0x41, 0xe5, 0x5d, 0xc7,
+	0x18, 0x88, 0x38, 0xd9, 0x3b, 0xdf, 0x37, 0xdf, 0x7c, 0xbb, 0x33, 0xbb, 0xea, 0xf3, 0xc0, 0xf7,
+	0x71, 0x0f, 0xf9, 0xa4, 0xc9, 0x69, 0x00, 0xa4, 0x8b, 0x30, 0xa7, 0xd1, 0xa8, 0xf9, 0xa9, 0xd5,
+	0x06, 0x8e, 0x5a, 0xcd, 0x01, 0x8a, 0x50, 0xc8, 0xac, 0x41, 0x44, 0x39, 0xd5, 0x36, 0x26, 0x5c,
+	0xab, 0xc8, 0xb5, 0x32, 0xee, 0xda, 0xb2, 0x47, 0x3d, 0x2a, 0x98, 0xcd, 0xf4, 0x4f, 0x26, 0xad,
+	0xbd, 0xbe, 0xbf, 0x00, 0x8a, 0x79, 0x8f, 0x46, 0x3e, 0x1f, 0x9d, 0x00, 0x47, 0x1d, 0xc4, 0x51,
+	0x96, 0xb6, 0x8a, 0x29, 0x0b, 0x29, 0x73, 0xa5, 0x9e, 0x5c, 0x64, 0x90, 0x2e, 0x57, 0xcd, 0x36,
+	0x62, 0x90, 0xeb, 0x60, 0xea, 0x13, 0x89, 0x9b, 0x5f, 0xcb, 0xea, 0xec, 0xa9, 0xf0, 0xad, 0x7d,
+	0x53, 0x54, 0xad, 0x03, 0x84, 0x86, 0x2e, 0x8e, 0x00, 0x71, 0x9f, 0x12, 0xb7, 0x0b, 0x50, 0x53,
+	0xea, 0x33, 0x8d, 0xca, 0xf6, 0xaa, 0x95, 0xc9, 0xa6, 0x42, 0x93, 0x5d, 0x58, 0x7b, 0xd4, 0x27,
+	0xf6, 0xc9, 0x79, 0x62, 0x94, 0xc6, 0x89, 0xb1, 0x3a, 0x42, 0x61, 0x7f, 0xc7, 0xbc, 0x2b, 0x61,
+	0x7e, 0xff, 0x63, 0x34, 0x3c, 0x9f, 0xf7, 0xe2, 0xb6, 0x85, 0x69, 0x98, 0x19, 0xcc, 0x3e, 0x5b,
+	0xac, 0x13, 0x34, 0xf9, 0x68, 0x00, 0x4c, 0xa8, 0x31, 0x67, 0x51, 0x08, 0xec, 0x65, 0xf9, 0x87,
+	0x00, 0x5a, 0x57, 0x5d, 0xbb, 0x25, 0xea, 0x21, 0xe6, 0x62, 0x4a, 0x58, 0x1c, 0x42, 0xed, 0x41,
+	0x5d, 0x69, 0x94, 0xed, 0xcd, 0xf3, 0xc4, 0x50, 0xc6, 0x89, 0xf1, 0x64, 0xaa, 0x89, 0x02, 0xdf,
+	0x74, 0x56, 0x6e, 0x14, 0x78, 0x83, 0xd8, 0x9e, 0x44, 0x34, 0xa2, 0xea, 0x40, 0x50, 0xbb, 0x0f,
+	0x2e, 0x44, 0x78, 0xfb, 0x85, 0x8b, 0x62, 0x4e, 0xdd, 0x08, 0x3c, 0x9f, 0xf1, 0x48, 0x70, 0x6b,
+	0x33, 0x75, 0xa5, 0x31, 0x67, 0x6f, 0x8e, 0x13, 0xe3, 0xa9, 0xac, 0x73, 0x3f, 0xdf, 0x74, 0xd6,
+	0x25, 0xe1, 0x20, 0xc5, 0x77, 0x63, 0x4e, 0x9d, 0x02, 0x9a, 0x1e, 0x78, 0xed, 0x96, 0xd1, 0x8f,
+	0x31, 0xe5, 0x20, 0x8e, 0xbd, 0x5c, 0x57, 0x1a, 0x95, 0xed, 0x57, 0xd6, 0xbd, 0x63, 0x64, 0xed,
+	0x17, 0xb7, 0xf2, 0x2e, 0x4d, 0x3e, 0x04, 0xb0, 0x9f, 0x65, 0x1d, 0x31, 0xa6, 0x1e, 0x46, 0x5e,
+	0xc3, 0x74, 0xaa, 0x9d, 0x69, 0xf9, 0xe6, 0xbf, 0x19, 0xb5, 0x3a, 0x55, 0x59, 0x0b, 0xd4, 0x05,
+	0x99, 0x8e, 0x42, 0x1a, 0x13, 0x5e, 0x53, 0xea, 0x4a, 0x63, 0xde, 0x7e, 0x9b, 0xd6, 0xfb, 0x9d,
+	0x18, 0xeb, 0xb2, 0xa5, 0xac, 0x13, 0x58, 0x3e, 0x6d, 0x86, 0x88, 0xf7, 0xac, 0x63, 0xf0, 0x10,
+	0x1e, 0xed, 0x03, 0x1e, 0x27, 0xc6, 0x92, 0xb4, 0x53, 0x14, 0x30, 0x7f, 0xfe, 0xd8, 0x52, 0xb3,
+	0xc9, 0xda, 0x07, 0xec, 0x54, 0x04, 0xb8, 0x2b, 0x30, 0xad, 0xa5, 0xce, 0x77, 0x01, 0x5c, 0xe1,
+	0x51, 0xb4, 0x79, 0xde, 0x5e, 0x1e, 0x27, 0xc6, 0xa2, 0x94, 0xc9, 0x21, 0xd3, 0x99, 0xeb, 0x02,
+	0x08, 0xbf, 0xda, 0x91, 0xaa, 0xe5, 0x71, 0x17, 0x86, 0x03, 0x4a, 0x80, 0x70, 0xd1, 0xb6, 0x47,
+	0xf6, 0xc6, 0xf5, 0x8c, 0xde, 0xe5, 0x98, 0xce, 0xe2, 0x44, 0xe4, 0x20, 0x0b, 0x69, 0x3b, 0xea,
+	0x02, 0x8d, 0x10, 0xee, 0x4f, 0x2c, 0x94, 0x85, 0x85, 0x95, 0xeb, 0x9d, 0x14, 0x51, 0xd3, 0xa9,
+	0xc8, 0xa5, 0x34, 0xf2, 0x5e, 0xad, 0xf2, 0xcf, 0x68, 0xe0, 0xf6, 0x29, 0x0d, 0xda, 0x08, 0x07,
+	0x2e, 0x03, 0x4c, 0x49, 0x87, 0xd5, 0x1e, 0x8a, 0x71, 0xad, 0x8f, 0x13, 0xe3, 0xb1, 0x14, 0x99,
+	0x4a, 0x33, 0x9d, 0xa5, 0x34, 0x7e, 0x9c, 0x85, 0xcf, 0x64, 0x54, 0x3b, 0x53, 0xab, 0x21, 0x1a,
+	0xba, 0x83, 0xc8, 0xc7, 0xe0, 0x22, 0x0f, 0x72, 0xd5, 0xd9, 0xdb, 0xaa, 0x53, 0x69, 0xa6, 0xa3,
+	0x85, 0x68, 0x78, 0x9a, 0x86, 0x77, 0x3d, 0xc8, 0x44, 0xed, 0xa3, 0xf3, 0x4b, 0x5d, 0xb9, 0xb8,
+	0xd4, 0x95, 0xbf, 0x97, 0xba, 0xf2, 0xe5, 0x4a, 0x2f, 0x5d, 0x5c, 0xe9, 0xa5, 0x5f, 0x57, 0x7a,
+	0xe9, 0x43, 0xab, 0x70, 0x69, 0xf3, 0x97, 0x29, 0xff, 0x19, 0xde, 0x7c, 0xa4, 0xc4, 0x1d, 0x6e,
+	0xcf, 0x8a, 0x67, 0xe5, 0xe5, 0xff, 0x01, 0x00, 0xe5, 0x4e, 0x36, 0x16, 0x2b, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +267,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DenomCreationQuoteFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EnableErc20AutoRegistration {
+		i--
+		if m.EnableErc20AutoRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -158,6 +309,68 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomCreationQuoteFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCreationQuoteFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCreationQuoteFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriceAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAgeSeconds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TwapLookbackSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TwapLookbackSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FeeDenomExponent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeDenomExponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.QuoteAmount.Size()
+		i -= size
+		if _, err := m.QuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -184,6 +397,39 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.EnableErc20AutoRegistration {
+		n += 2
+	}
+	l = m.DenomCreationQuoteFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DenomCreationQuoteFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QuoteAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.FeeDenomExponent != 0 {
+		n += 1 + sovParams(uint64(m.FeeDenomExponent))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TwapLookbackSeconds != 0 {
+		n += 1 + sovParams(uint64(m.TwapLookbackSeconds))
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAgeSeconds))
+	}
 	return n
 }
 
@@ -275,6 +521,264 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20AutoRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20AutoRegistration = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationQuoteFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomCreationQuoteFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomCreationQuoteFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCreationQuoteFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCreationQuoteFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomExponent", wireType)
+			}
+			m.FeeDenomExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDenomExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackSeconds", wireType)
+			}
+			m.TwapLookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapLookbackSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeSeconds", wireType)
+			}
+			m.MaxPriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v3/x/tokenfactory/types"
+)
+
+func TestDenomCreationQuoteFeeValidate(t *testing.T) {
+	validFee := types.DenomCreationQuoteFee{
+		QuoteAmount:         sdkmath.LegacyNewDec(10),
+		FeeDenom:            "akii",
+		FeeDenomExponent:    18,
+		OracleDenom:         "kii",
+		TwapLookbackSeconds: 600,
+		MaxPriceAgeSeconds:  300,
+	}
+
+	tests := []struct {
+		name       string
+		fee        func() types.DenomCreationQuoteFee
+		expectPass bool
+	}{
+		{
+			name:       "valid quote fee",
+			fee:        func() types.DenomCreationQuoteFee { return validFee },
+			expectPass: true,
+		},
+		{
+			name:       "disabled quote fee",
+			fee:        func() types.DenomCreationQuoteFee { return types.DenomCreationQuoteFee{} },
+			expectPass: true,
+		},
+		{
+			name: "negative quote amount",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.QuoteAmount = sdkmath.LegacyNewDec(-1)
+				return fee
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid fee denom",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.FeeDenom = "1"
+				return fee
+			},
+			expectPass: false,
+		},
+		{
+			name: "exponent too large",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.FeeDenomExponent = types.MaxFeeDenomExponent + 1
+				return fee
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty oracle denom",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.OracleDenom = ""
+				return fee
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero twap lookback",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.TwapLookbackSeconds = 0
+				return fee
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max price age",
+			fee: func() types.DenomCreationQuoteFee {
+				fee := validFee
+				fee.MaxPriceAgeSeconds = 0
+				return fee
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.fee().Validate(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.fee().Validate(), "test: %v", test.name)
+		}
+	}
+}
@@ -37,4 +37,10 @@ var (
 
 	// ErrInvalidExpeditedProposal is used when an expedite proposal is submitted for an unsupported proposal type.
 	ErrInvalidExpeditedProposal = errorsmod.Register(codespace, 10, "unsupported expedited proposal type")
+
+	// ErrAuthzPolicy is used when a message is rejected by the governance authz policy.
+	ErrAuthzPolicy = errorsmod.Register(codespace, 11, "message rejected by the authz policy")
+
+	// ErrCommissionTooLow is used when a validator commission rate is below the governance minimum.
+	ErrCommissionTooLow = errorsmod.Register(codespace, 12, "commission rate below the minimum")
 )